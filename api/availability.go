@@ -0,0 +1,299 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/gin-gonic/gin"
+)
+
+type availabilityWindowRequest struct {
+	Days  []string `json:"days" binding:"required,min=1,max=7"`
+	Start string   `json:"start" binding:"required"`
+	End   string   `json:"end" binding:"required"`
+}
+
+type availabilityExceptionRequest struct {
+	Date      string `json:"date" binding:"required,datetime=2006-01-02"`
+	Available *bool  `json:"available" binding:"required"`
+}
+
+type scheduleRequest struct {
+	Windows    []availabilityWindowRequest    `json:"windows" binding:"max=50,dive"`
+	Exceptions []availabilityExceptionRequest `json:"exceptions" binding:"max=366,unique=Date,dive"`
+}
+
+type availabilityWindowResponse struct {
+	ID    int64    `json:"id"`
+	Days  []string `json:"days"`
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+}
+
+type availabilityExceptionResponse struct {
+	ID        int64  `json:"id"`
+	Date      string `json:"date"`
+	Available bool   `json:"available"`
+}
+
+type scheduleResponse struct {
+	Timezone   string                          `json:"timezone"`
+	Windows    []availabilityWindowResponse    `json:"windows"`
+	Exceptions []availabilityExceptionResponse `json:"exceptions"`
+}
+
+func (server *Server) newScheduleResponse(schedule db.Schedule) scheduleResponse {
+	response := scheduleResponse{
+		Timezone:   server.location.String(),
+		Windows:    make([]availabilityWindowResponse, 0, len(schedule.Windows)),
+		Exceptions: make([]availabilityExceptionResponse, 0, len(schedule.Exceptions)),
+	}
+	for _, window := range schedule.Windows {
+		response.Windows = append(response.Windows, availabilityWindowResponse{
+			ID:    window.ID,
+			Days:  db.WeekdayNames(window.DaysOfWeek),
+			Start: db.FormatClock(window.StartMinute),
+			End:   db.FormatClock(window.EndMinute),
+		})
+	}
+	for _, exception := range schedule.Exceptions {
+		response.Exceptions = append(response.Exceptions, availabilityExceptionResponse{
+			ID:        exception.ID,
+			Date:      exception.ExceptionDate.Format(time.DateOnly),
+			Available: exception.Available,
+		})
+	}
+	return response
+}
+
+func newReplaceScheduleParams(req scheduleRequest) (db.ReplaceScheduleTxParams, error) {
+	var arg db.ReplaceScheduleTxParams
+	for _, window := range req.Windows {
+		newWindow, err := db.ParseWindow(window.Days, window.Start, window.End)
+		if err != nil {
+			return arg, err
+		}
+		arg.Windows = append(arg.Windows, newWindow)
+	}
+	for _, exception := range req.Exceptions {
+		date, err := time.Parse(time.DateOnly, exception.Date)
+		if err != nil {
+			return arg, err
+		}
+		arg.Exceptions = append(arg.Exceptions, db.NewAvailabilityException{Date: date, Available: *exception.Available})
+	}
+	return arg, nil
+}
+
+// listAvailability loads the schedules of the given menus and categories.
+func (server *Server) listAvailability(ctx *gin.Context, menuIDs []int64, categoryIDs []int64) (db.Availability, error) {
+	windows, err := server.store.ListAvailabilityWindows(ctx, db.ListAvailabilityWindowsParams{
+		MenuIds:     menuIDs,
+		CategoryIds: categoryIDs,
+	})
+	if err != nil {
+		return db.Availability{}, err
+	}
+
+	exceptions, err := server.store.ListAvailabilityExceptions(ctx, db.ListAvailabilityExceptionsParams{
+		MenuIds:     menuIDs,
+		CategoryIds: categoryIDs,
+	})
+	if err != nil {
+		return db.Availability{}, err
+	}
+
+	return db.NewAvailability(windows, exceptions), nil
+}
+
+func (server *Server) getMenuSchedule(ctx *gin.Context) {
+	var req menuIDUriRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetMenu(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	availability, err := server.listAvailability(ctx, []int64{req.ID}, nil)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, server.newScheduleResponse(availability.MenuSchedule(req.ID)))
+}
+
+func (server *Server) replaceMenuSchedule(ctx *gin.Context) {
+	var reqUri menuIDUriRequest
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetMenu(ctx, reqUri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	server.replaceSchedule(ctx, sql.NullInt64{Int64: reqUri.ID, Valid: true}, sql.NullInt64{})
+}
+
+type categoryIDUriRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) getCategorySchedule(ctx *gin.Context) {
+	var req categoryIDUriRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetCategory(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	availability, err := server.listAvailability(ctx, nil, []int64{req.ID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, server.newScheduleResponse(availability.CategorySchedule(req.ID)))
+}
+
+func (server *Server) replaceCategorySchedule(ctx *gin.Context) {
+	var reqUri categoryIDUriRequest
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetCategory(ctx, reqUri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	server.replaceSchedule(ctx, sql.NullInt64{}, sql.NullInt64{Int64: reqUri.ID, Valid: true})
+}
+
+func (server *Server) replaceSchedule(ctx *gin.Context, menuID sql.NullInt64, categoryID sql.NullInt64) {
+	var reqJson scheduleRequest
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg, err := newReplaceScheduleParams(reqJson)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	arg.MenuID = menuID
+	arg.CategoryID = categoryID
+
+	result, err := server.store.ReplaceScheduleTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, server.newScheduleResponse(result.Schedule))
+}
+
+type availableMenuResponse struct {
+	ID           int64                 `json:"id"`
+	Name         string                `json:"name"`
+	Price        money.Amount          `json:"price"`
+	CategoryID   int64                 `json:"category_id"`
+	CategoryName string                `json:"category_name"`
+	Variants     []menuVariantResponse `json:"variants"`
+	OptionGroups []optionGroupResponse `json:"option_groups"`
+}
+
+// listAvailableMenuResponses returns the active menus whose schedules and
+// categories' schedules allow ordering them now, with what can be chosen for
+// them.
+func (server *Server) listAvailableMenuResponses(ctx *gin.Context) ([]availableMenuResponse, error) {
+	activeMenus, err := server.store.ListActiveMenu(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	menuIDs := make([]int64, 0, len(activeMenus))
+	categoryIDs := make([]int64, 0, len(activeMenus))
+	for _, menu := range activeMenus {
+		menuIDs = append(menuIDs, menu.ID)
+		categoryIDs = append(categoryIDs, menu.CategoryID)
+	}
+	availability, err := server.listAvailability(ctx, menuIDs, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := server.now()
+	menus := make([]db.ListActiveMenuRow, 0, len(activeMenus))
+	menuIDs = menuIDs[:0]
+	for _, menu := range activeMenus {
+		if availability.MenuAvailableAt(menu.ID, menu.CategoryID, now) {
+			menus = append(menus, menu)
+			menuIDs = append(menuIDs, menu.ID)
+		}
+	}
+
+	variants, err := server.store.ListMenuVariantsByMenuIDs(ctx, menuIDs)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := server.listMenuOptionGroups(ctx, menuIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	menusResponse := make([]availableMenuResponse, 0, len(menus))
+	for _, menu := range menus {
+		response := availableMenuResponse{
+			ID:           menu.ID,
+			Name:         menu.Name,
+			Price:        menu.Price,
+			CategoryID:   menu.CategoryID,
+			CategoryName: menu.CategoryName,
+			Variants:     make([]menuVariantResponse, 0),
+			OptionGroups: make([]optionGroupResponse, 0),
+		}
+		for _, variant := range variants {
+			if variant.MenuID == menu.ID && variant.Available {
+				response.Variants = append(response.Variants, newMenuVariantResponse(variant))
+			}
+		}
+		for _, group := range groups {
+			if group.MenuID == menu.ID {
+				response.OptionGroups = append(response.OptionGroups, group)
+			}
+		}
+		menusResponse = append(menusResponse, response)
+	}
+	return menusResponse, nil
+}
+
+func (server *Server) listAvailableMenus(ctx *gin.Context) {
+	menus, err := server.listAvailableMenuResponses(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, menus)
+}
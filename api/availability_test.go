@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/stretchr/testify/require"
+)
+
+type availabilityStore struct {
+	stubStore
+	today    time.Time
+	replaced []db.ReplaceScheduleTxParams
+}
+
+func (store *availabilityStore) GetMenu(ctx context.Context, id int64) (db.Menu, error) {
+	return db.Menu{ID: id, CategoryID: 1}, nil
+}
+
+func (store *availabilityStore) ListActiveMenu(ctx context.Context) ([]db.ListActiveMenuRow, error) {
+	return []db.ListActiveMenuRow{
+		{ID: 1, Name: "Pho", Price: money.MustParse("45000"), CategoryID: 1},
+		{ID: 2, Name: "Bun", Price: money.MustParse("40000"), CategoryID: 1},
+		{ID: 3, Name: "Beer", Price: money.MustParse("20000"), CategoryID: 2},
+	}, nil
+}
+
+func (store *availabilityStore) ListAvailabilityWindows(ctx context.Context, arg db.ListAvailabilityWindowsParams) ([]db.AvailabilityWindow, error) {
+	return []db.AvailabilityWindow{
+		{CategoryID: sql.NullInt64{Int64: 1, Valid: true}, DaysOfWeek: 127, StartMinute: 0, EndMinute: db.MinutesPerDay},
+	}, nil
+}
+
+func (store *availabilityStore) ListAvailabilityExceptions(ctx context.Context, arg db.ListAvailabilityExceptionsParams) ([]db.AvailabilityException, error) {
+	return []db.AvailabilityException{
+		{MenuID: sql.NullInt64{Int64: 2, Valid: true}, ExceptionDate: store.today, Available: false},
+		{CategoryID: sql.NullInt64{Int64: 2, Valid: true}, ExceptionDate: store.today, Available: false},
+	}, nil
+}
+
+func (store *availabilityStore) ListMenuVariantsByMenuIDs(ctx context.Context, menuIDs []int64) ([]db.MenuVariant, error) {
+	return []db.MenuVariant{}, nil
+}
+
+func (store *availabilityStore) ListOptionGroupsByMenuIDs(ctx context.Context, menuIDs []int64) ([]db.OptionGroup, error) {
+	return []db.OptionGroup{}, nil
+}
+
+func (store *availabilityStore) ListMenuOptionsByGroupIDs(ctx context.Context, groupIDs []int64) ([]db.MenuOption, error) {
+	return []db.MenuOption{}, nil
+}
+
+func (store *availabilityStore) ReplaceScheduleTx(ctx context.Context, arg db.ReplaceScheduleTxParams) (db.ReplaceScheduleTxResult, error) {
+	store.replaced = append(store.replaced, arg)
+	return db.ReplaceScheduleTxResult{}, nil
+}
+
+func TestListAvailableMenus(t *testing.T) {
+	year, month, day := time.Now().UTC().Date()
+	store := &availabilityStore{today: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+	server := newTestServer(t, store)
+
+	recorder := sendWithToken(t, server, http.MethodGet, "/menus/available", "", rbac.RoleManager)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var menus []availableMenuResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &menus))
+	require.Len(t, menus, 1)
+	require.Equal(t, "Pho", menus[0].Name)
+}
+
+func TestReplaceMenuSchedule(t *testing.T) {
+	store := &availabilityStore{}
+	server := newTestServer(t, store)
+
+	body := `{"windows":[{"days":["sat","sun"],"start":"22:00","end":"02:00"}],"exceptions":[{"date":"2026-12-25","available":false}]}`
+	recorder := sendWithToken(t, server, http.MethodPut, "/menus/2/schedule", body, rbac.RoleManager)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	require.Equal(t, db.ReplaceScheduleTxParams{
+		MenuID:     sql.NullInt64{Int64: 2, Valid: true},
+		Windows:    []db.NewAvailabilityWindow{{DaysOfWeek: db.WeekdayMask(time.Saturday, time.Sunday), StartMinute: 22 * 60, EndMinute: 2 * 60}},
+		Exceptions: []db.NewAvailabilityException{{Date: time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), Available: false}},
+	}, store.replaced[0])
+
+	for _, body := range []string{
+		`{"windows":[{"days":["someday"],"start":"08:00","end":"10:00"}]}`,
+		`{"windows":[{"days":["mon"],"start":"08:00","end":"08:00"}]}`,
+		`{"exceptions":[{"date":"25/12/2026","available":false}]}`,
+		`{"exceptions":[{"date":"2026-12-25","available":false},{"date":"2026-12-25","available":true}]}`,
+	} {
+		recorder = sendWithToken(t, server, http.MethodPut, "/menus/2/schedule", body, rbac.RoleManager)
+		require.Equal(t, http.StatusBadRequest, recorder.Code, body)
+	}
+	require.Len(t, store.replaced, 1)
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	lastEventIDHeaderKey = "Last-Event-ID"
+	sseKeepAliveInterval = 15 * time.Second
+)
+
+type streamOrderEventsRequest struct {
+	TableID     int64  `form:"table_id" binding:"omitempty,min=1"`
+	Status      string `form:"status" binding:"max=20"`
+	LastEventID uint64 `form:"last_event_id"`
+}
+
+func (server *Server) streamOrderEvents(ctx *gin.Context) {
+	var req streamOrderEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if header := ctx.GetHeader(lastEventIDHeaderKey); header != "" {
+		lastEventID, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		req.LastEventID = lastEventID
+	}
+
+	sub, err := server.eventBus.Subscribe(events.Filter{
+		TableID: req.TableID,
+		Status:  req.Status,
+	}, req.LastEventID)
+	if err != nil {
+		if errors.Is(err, events.ErrEventExpired) {
+			ctx.JSON(http.StatusGone, errorResponse(err))
+			return
+		}
+		if errors.Is(err, events.ErrBusClosed) {
+			ctx.JSON(http.StatusServiceUnavailable, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	defer sub.Close()
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Request.Context().Done():
+			return false
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return false
+			}
+			ctx.Render(-1, sse.Event{
+				Id:    strconv.FormatUint(event.ID, 10),
+				Event: event.Type,
+				Data:  event,
+			})
+			return true
+		}
+	})
+}
+
+// orderTableID looks up the table of an order so item and payment events can be
+// filtered by table. Events are best effort, so a failed lookup yields zero.
+func (server *Server) orderTableID(ctx *gin.Context, orderID int64) int64 {
+	order, err := server.store.GetOrder(ctx, orderID)
+	if err != nil {
+		return 0
+	}
+	return order.TableID
+}
+
+func (server *Server) publishOrderEvent(eventType string, order db.Order) {
+	server.eventBus.Publish(events.Event{
+		Type:     eventType,
+		EntityID: order.ID,
+		OrderID:  order.ID,
+		TableID:  order.TableID,
+		Status:   order.Status,
+	})
+}
+
+func (server *Server) publishOrderItemEvent(eventType string, item db.OrderItem, tableID int64) {
+	server.eventBus.Publish(events.Event{
+		Type:     eventType,
+		EntityID: item.ID,
+		OrderID:  item.OrderID,
+		TableID:  tableID,
+		Status:   item.Status,
+	})
+}
+
+func (server *Server) publishPaymentEvent(eventType string, payment db.Payment, tableID int64) {
+	server.eventBus.Publish(events.Event{
+		Type:     eventType,
+		EntityID: payment.ID,
+		OrderID:  payment.OrderID,
+		TableID:  tableID,
+		Status:   payment.Status,
+	})
+}
+
+func (server *Server) publishTableEvent(eventType string, table db.Table) {
+	server.eventBus.Publish(events.Event{
+		Type:     eventType,
+		EntityID: table.ID,
+		TableID:  table.ID,
+		Status:   table.Status,
+	})
+}
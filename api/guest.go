@@ -0,0 +1,170 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/tableqr"
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/gin-gonic/gin"
+)
+
+type createGuestSessionRequest struct {
+	QRToken string `json:"qr_token" binding:"required"`
+}
+
+type guestSessionResponse struct {
+	AccessToken          string    `json:"access_token"`
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	TableID              int64     `json:"table_id"`
+	TableName            string    `json:"table_name"`
+}
+
+func (server *Server) createGuestSession(ctx *gin.Context) {
+	var req createGuestSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	table, err := tableqr.VerifyToken(ctx, server.store, server.config.TokenSymmetricKey, req.QRToken)
+	if err != nil {
+		if err == tableqr.ErrInvalidToken || err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(tableqr.ErrInvalidToken))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	accessToken, payload, err := server.tokenMaker.CreateTableToken(table.ID, server.config.GuestTokenDuration)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, guestSessionResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: payload.ExpiredAt,
+		TableID:              table.ID,
+		TableName:            table.Name,
+	})
+}
+
+type createGuestOrderRequest struct {
+	Items []createOrderItemCartRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+func (server *Server) createGuestOrder(ctx *gin.Context) {
+	var req createGuestOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	result, err := server.store.PlaceOrderTx(ctx, db.PlaceOrderTxParams{
+		TableID:   authPayload.TableID,
+		Items:     newPlaceOrderItems(req.Items),
+		OrderedAt: server.now(),
+	})
+	if err != nil {
+		if orderItemSelectionError(ctx, err) {
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "menu not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	order := result.Order
+	server.publishOrderEvent(events.TypeOrderCreated, order)
+	for _, item := range result.Items {
+		server.publishOrderItemEvent(events.TypeOrderItemCreated, item, order.TableID)
+	}
+
+	ctx.JSON(http.StatusOK, newGuestOrderResponse(order, result.Items, result.Options))
+}
+
+type guestOrderResponse struct {
+	ID         int64               `json:"id"`
+	TotalPrice money.Amount        `json:"total_price"`
+	Status     string              `json:"status"`
+	CreatedAt  time.Time           `json:"created_at"`
+	Items      []orderItemResponse `json:"items"`
+}
+
+func newGuestOrderResponse(order db.Order, items []db.OrderItem, options []db.OrderItemOption) guestOrderResponse {
+	response := guestOrderResponse{
+		ID:         order.ID,
+		TotalPrice: order.TotalPrice,
+		Status:     order.Status,
+		CreatedAt:  order.CreatedAt,
+		Items:      make([]orderItemResponse, 0),
+	}
+	for _, item := range items {
+		if item.OrderID != order.ID {
+			continue
+		}
+		response.Items = append(response.Items, newOrderItemResponse(item, options))
+	}
+	return response
+}
+
+type guestBillResponse struct {
+	TableID    int64                `json:"table_id"`
+	TotalPrice money.Amount         `json:"total_price"`
+	Orders     []guestOrderResponse `json:"orders"`
+}
+
+func (server *Server) getGuestBill(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	orders, err := server.store.ListOpenOrdersByTable(ctx, authPayload.TableID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	orderIDs := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.ID)
+	}
+
+	items, err := server.store.ListOrderItemsByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	options, err := server.listOrderItemOptions(ctx, items...)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	total, err := server.store.GetOpenOrdersTotalByTable(ctx, authPayload.TableID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	billResponse := guestBillResponse{
+		TableID:    authPayload.TableID,
+		TotalPrice: total,
+		Orders:     make([]guestOrderResponse, 0, len(orders)),
+	}
+	for _, order := range orders {
+		billResponse.Orders = append(billResponse.Orders, newGuestOrderResponse(order, items, options))
+	}
+
+	ctx.JSON(http.StatusOK, billResponse)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/gin-gonic/gin"
+)
+
+type listKitchenItemsRequest struct {
+	CategoryID int64 `form:"category_id" binding:"omitempty,min=1"`
+}
+
+type kitchenItemResponse struct {
+	ID          int64                     `json:"id"`
+	OrderID     int64                     `json:"order_id"`
+	TableID     int64                     `json:"table_id"`
+	MenuID      int64                     `json:"menu_id"`
+	MenuName    string                    `json:"menu_name"`
+	VariantName string                    `json:"variant_name"`
+	Quantity    int32                     `json:"quantity"`
+	Options     []orderItemOptionResponse `json:"options"`
+	NoteItem    string                    `json:"note_item"`
+	Status      string                    `json:"status"`
+	CreatedAt   time.Time                 `json:"created_at"`
+}
+
+type kitchenStationResponse struct {
+	CategoryID   int64                 `json:"category_id"`
+	CategoryName string                `json:"category_name"`
+	Items        []kitchenItemResponse `json:"items"`
+}
+
+func (server *Server) listKitchenItems(ctx *gin.Context) {
+	var req listKitchenItemsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	items, err := server.store.ListKitchenOrderItems(ctx, sql.NullInt64{
+		Int64: req.CategoryID,
+		Valid: req.CategoryID > 0,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	itemIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ID)
+	}
+	options, err := server.store.ListOrderItemOptionsByOrderItemIDs(ctx, itemIDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	stationsResponse := make([]kitchenStationResponse, 0)
+	for _, item := range items {
+		n := len(stationsResponse)
+		if n == 0 || stationsResponse[n-1].CategoryID != item.CategoryID {
+			stationsResponse = append(stationsResponse, kitchenStationResponse{
+				CategoryID:   item.CategoryID,
+				CategoryName: item.CategoryName,
+				Items:        make([]kitchenItemResponse, 0),
+			})
+			n++
+		}
+		stationsResponse[n-1].Items = append(stationsResponse[n-1].Items, kitchenItemResponse{
+			ID:          item.ID,
+			OrderID:     item.OrderID,
+			TableID:     item.TableID,
+			MenuID:      item.MenuID,
+			MenuName:    item.MenuName,
+			VariantName: item.VariantName,
+			Quantity:    item.Quantity,
+			Options:     newOrderItemOptionResponses(item.ID, options),
+			NoteItem:    item.NoteItem,
+			Status:      item.Status,
+			CreatedAt:   item.CreatedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, stationsResponse)
+}
+
+type updateKitchenItemStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=cooking ready served"`
+}
+
+type updateKitchenItemStatusResponse struct {
+	Item        orderItemResponse `json:"item"`
+	OrderStatus string            `json:"order_status"`
+}
+
+func (server *Server) updateKitchenItemStatus(ctx *gin.Context) {
+	var reqUri updateOrderItemIDUriRequest
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var reqJson updateKitchenItemStatusRequest
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := server.store.UpdateOrderItemStatusTx(ctx, db.UpdateOrderItemStatusTxParams{
+		OrderItemID: reqUri.ID,
+		Status:      reqJson.Status,
+		ChangedBy:   authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidOrderItemStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	orderItem := result.OrderItem
+	server.publishOrderItemEvent(events.TypeOrderItemStatusChanged, orderItem, result.Order.TableID)
+	if len(result.History) > 0 {
+		server.publishOrderEvent(events.TypeOrderStatusChanged, result.Order)
+	}
+
+	options, err := server.listOrderItemOptions(ctx, orderItem)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	response := updateKitchenItemStatusResponse{
+		Item:        newOrderItemResponse(orderItem, options),
+		OrderStatus: result.Order.Status,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
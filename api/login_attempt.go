@@ -0,0 +1,115 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/lockout"
+	"github.com/gin-gonic/gin"
+)
+
+// checkLoginAllowed answers 429 with a Retry-After header while the username
+// or the client IP has to wait before trying again.
+func (server *Server) checkLoginAllowed(ctx *gin.Context, username string) bool {
+	err := server.loginGuard.Check(ctx, username, ctx.ClientIP())
+	if err == nil {
+		return true
+	}
+
+	var blocked *lockout.BlockedError
+	if !errors.As(err, &blocked) {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+	if err := server.recordLoginAttempt(ctx, username, nil, db.LoginAttemptBlocked); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+	ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(blocked.RetryAfter.Seconds()))))
+	ctx.JSON(http.StatusTooManyRequests, errorResponse(blocked))
+	return false
+}
+
+// loginFailed counts a failed login against the username and the client IP.
+// user is nil when the username does not exist.
+func (server *Server) loginFailed(ctx *gin.Context, username string, user *db.User, result string) error {
+	locked, err := server.loginGuard.Fail(ctx, username, ctx.ClientIP())
+	if err != nil {
+		return err
+	}
+	if locked && user != nil {
+		_, err = server.store.CreateSecurityEvent(ctx, db.CreateSecurityEventParams{
+			UserID:    user.ID,
+			EventType: db.SecurityEventAccountLocked,
+			ClientIp:  ctx.ClientIP(),
+			UserAgent: ctx.Request.UserAgent(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return server.recordLoginAttempt(ctx, username, user, result)
+}
+
+func (server *Server) recordLoginAttempt(ctx *gin.Context, username string, user *db.User, result string) error {
+	arg := db.CreateLoginAttemptParams{
+		Username:  username,
+		Result:    result,
+		ClientIp:  ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	}
+	if user != nil {
+		arg.UserID = sql.NullInt64{Int64: user.ID, Valid: true}
+	}
+	_, err := server.store.CreateLoginAttempt(ctx, arg)
+	return err
+}
+
+type unlockUserRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type unlockUserResponse struct {
+	Username string `json:"username"`
+}
+
+// unlockUser lets an administrator lift a login lockout before it runs out.
+func (server *Server) unlockUser(ctx *gin.Context) {
+	var req unlockUserRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	user, err := server.store.GetUserByID(ctx, req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if err := server.loginGuard.Unlock(ctx, user.Username); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.store.CreateSecurityEvent(ctx, db.CreateSecurityEventParams{
+		UserID:    user.ID,
+		EventType: db.SecurityEventAccountUnlocked,
+		ClientIp:  ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, unlockUserResponse{Username: user.Username})
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/lockout"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type loginStore struct {
+	stubStore
+	user     db.User
+	attempts []db.CreateLoginAttemptParams
+	events   []db.CreateSecurityEventParams
+}
+
+func (store *loginStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	if username != store.user.Username {
+		return db.User{}, sql.ErrNoRows
+	}
+	return store.user, nil
+}
+
+func (store *loginStore) GetUserByID(ctx context.Context, id int64) (db.User, error) {
+	if id != store.user.ID {
+		return db.User{}, sql.ErrNoRows
+	}
+	return store.user, nil
+}
+
+func (store *loginStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+	return db.Session{ID: arg.ID, UserID: arg.UserID}, nil
+}
+
+func (store *loginStore) CreateLoginAttempt(ctx context.Context, arg db.CreateLoginAttemptParams) (db.LoginAttempt, error) {
+	store.attempts = append(store.attempts, arg)
+	return db.LoginAttempt{Username: arg.Username, Result: arg.Result}, nil
+}
+
+func (store *loginStore) CreateSecurityEvent(ctx context.Context, arg db.CreateSecurityEventParams) (db.SecurityEvent, error) {
+	store.events = append(store.events, arg)
+	return db.SecurityEvent{UserID: arg.UserID, EventType: arg.EventType}, nil
+}
+
+func newLoginStore(t *testing.T, password string) *loginStore {
+	hashedPassword, err := utils.HashPassword(password)
+	require.NoError(t, err)
+
+	return &loginStore{user: db.User{
+		ID:           1,
+		Username:     utils.RandomString(8),
+		HashPassword: hashedPassword,
+		Role:         rbac.RoleWaiter,
+		TokenVersion: 1,
+	}}
+}
+
+func login(t *testing.T, server *Server, username string, password string) *httptest.ResponseRecorder {
+	body, err := json.Marshal(loginUserRequest{Username: username, Password: password})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/users/login", bytes.NewReader(body))
+	require.NoError(t, err)
+	request.RemoteAddr = "192.0.2.1:1234"
+	// Untrusted clients cannot pick the IP their failures count against.
+	request.Header.Set("X-Forwarded-For", fmt.Sprintf("203.0.113.%d", rand.Intn(256)))
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestLoginLockout(t *testing.T) {
+	store := newLoginStore(t, "secret123")
+	server := newTestServer(t, store)
+	server.loginGuard = lockout.NewGuard(lockout.NewMemoryStore(),
+		lockout.Policy{MaxFailures: 3, LockDuration: time.Minute, Window: time.Hour},
+		lockout.Policy{MaxFailures: 100, LockDuration: time.Minute, Window: time.Hour},
+	)
+
+	require.Equal(t, http.StatusOK, login(t, server, store.user.Username, "secret123").Code)
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusUnauthorized, login(t, server, store.user.Username, "wrong-password").Code)
+	}
+	require.Len(t, store.events, 1)
+	require.Equal(t, db.SecurityEventAccountLocked, store.events[0].EventType)
+
+	recorder := login(t, server, store.user.Username, "secret123")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code, "a locked account rejects the correct password")
+	require.Equal(t, "60", recorder.Header().Get("Retry-After"))
+
+	var results []string
+	for _, attempt := range store.attempts {
+		results = append(results, attempt.Result)
+	}
+	require.Equal(t, []string{
+		db.LoginAttemptSucceeded,
+		db.LoginAttemptInvalidPassword,
+		db.LoginAttemptInvalidPassword,
+		db.LoginAttemptInvalidPassword,
+		db.LoginAttemptBlocked,
+	}, results)
+
+	recorder = serveWithToken(t, server, http.MethodPost, "/users/1/unlock", rbac.RoleAdmin)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, db.SecurityEventAccountUnlocked, store.events[1].EventType)
+
+	require.Equal(t, http.StatusOK, login(t, server, store.user.Username, "secret123").Code)
+}
+
+func TestLoginProgressiveDelay(t *testing.T) {
+	store := newLoginStore(t, "secret123")
+	server := newTestServer(t, store)
+	server.loginGuard = lockout.NewGuard(lockout.NewMemoryStore(),
+		lockout.Policy{MaxFailures: 100, LockDuration: time.Minute, Window: time.Hour},
+		lockout.Policy{DelayAfter: 2, BaseDelay: time.Minute, MaxDelay: time.Hour, MaxFailures: 100, LockDuration: time.Minute, Window: time.Hour},
+	)
+
+	username := utils.RandomString(8)
+	for i := 0; i < 2; i++ {
+		require.Equal(t, http.StatusNotFound, login(t, server, username, "secret123").Code)
+	}
+
+	recorder := login(t, server, store.user.Username, "secret123")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code, "failures from the same client IP delay other usernames")
+	require.Equal(t, "60", recorder.Header().Get("Retry-After"))
+	require.Empty(t, store.events)
+	require.Equal(t, db.LoginAttemptUnknownUser, store.attempts[0].Result)
+	require.False(t, store.attempts[0].UserID.Valid)
+}
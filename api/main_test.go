@@ -0,0 +1,54 @@
+package api
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/lockout"
+	"github.com/datmaithanh/orderfood/ratelimit"
+	"github.com/datmaithanh/orderfood/revocation"
+	"github.com/datmaithanh/orderfood/storage"
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	gin.DefaultWriter = io.Discard
+	gin.DefaultErrorWriter = io.Discard
+
+	os.Exit(m.Run())
+}
+
+func newTestServer(t *testing.T, store db.Store) *Server {
+	config := utils.DefaultConfig()
+	config.TokenSymmetricKey = utils.RandomString(32)
+
+	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+	require.NoError(t, err)
+
+	server := &Server{
+		config:     config,
+		store:      store,
+		tokenMaker: tokenMaker,
+		eventBus:   events.NewMemoryBus(16),
+		imageStore: storage.NewFakeStore(),
+		resetLimiters: ratelimit.PasswordResetLimiters{
+			Email: ratelimit.NewMemoryLimiter(config.PasswordResetEmailLimit, config.PasswordResetWindow),
+			IP:    ratelimit.NewMemoryLimiter(config.PasswordResetIPLimit, config.PasswordResetWindow),
+		},
+		tokenChecker: newTokenChecker(config, store, revocation.NewMemoryList()),
+		loginGuard:   lockout.NewGuard(lockout.NewMemoryStore(), lockout.Policy{}, lockout.Policy{}),
+		location:     time.UTC,
+	}
+	server.setupRouter()
+	require.NoError(t, server.router.SetTrustedProxies(nil))
+
+	return server
+}
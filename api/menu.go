@@ -5,22 +5,23 @@ import (
 	"time"
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
 	"github.com/gin-gonic/gin"
 )
 
 type createMenuRequest struct {
-	Name       string `json:"name" binding:"required"`
-	Price      string `json:"price" binding:"required,number"`
-	CategoryID int64  `json:"category_id" binding:"required"`
+	Name       string        `json:"name" binding:"required"`
+	Price      *money.Amount `json:"price" binding:"required,price"`
+	CategoryID int64         `json:"category_id" binding:"required"`
 }
 
 type menuResponse struct {
-	ID         int64     `json:"id"`
-	Name       string    `json:"name"`
-	Price      string    `json:"price"`
-	CategoryID int64     `json:"category_id"`
-	Status     bool      `json:"status"`
-	CreatedAt  time.Time `json:"created_at"`
+	ID         int64        `json:"id"`
+	Name       string       `json:"name"`
+	Price      money.Amount `json:"price"`
+	CategoryID int64        `json:"category_id"`
+	Status     bool         `json:"status"`
+	CreatedAt  time.Time    `json:"created_at"`
 }
 
 func (server *Server) createMenu(ctx *gin.Context) {
@@ -32,7 +33,7 @@ func (server *Server) createMenu(ctx *gin.Context) {
 
 	menu, err := server.store.CreateMenu(ctx, db.CreateMenuParams{
 		Name:       req.Name,
-		Price:      req.Price,
+		Price:      *req.Price,
 		CategoryID: req.CategoryID,
 	})
 	if err != nil {
@@ -124,9 +125,10 @@ type updateMenuUriIDRequest struct {
 }
 
 type updateMenuJSONRequest struct {
-	Name       string `json:"name" binding:"required"`
-	Price      string `json:"price" binding:"required,number"`
-	CategoryID int64  `json:"category_id" binding:"required"`
+	Name       string        `json:"name" binding:"required"`
+	Price      *money.Amount `json:"price" binding:"required,price"`
+	CategoryID int64         `json:"category_id" binding:"required"`
+	Status     *bool         `json:"status"`
 }
 
 func (server *Server) updateMenu(ctx *gin.Context) {
@@ -142,17 +144,23 @@ func (server *Server) updateMenu(ctx *gin.Context) {
 		return
 	}
 
-	_, err := server.store.GetMenu(ctx, reqUriID.ID)
+	menu, err := server.store.GetMenu(ctx, reqUriID.ID)
 	if err != nil {
 		ctx.JSON(http.StatusNotFound, errorResponse(err))
 		return
 	}
 
-	menu, err := server.store.UpdateMenu(ctx, db.UpdateMenuParams{
+	status := menu.Status
+	if reqJson.Status != nil {
+		status = *reqJson.Status
+	}
+
+	menu, err = server.store.UpdateMenu(ctx, db.UpdateMenuParams{
 		ID:         reqUriID.ID,
 		Name:       reqJson.Name,
-		Price:      reqJson.Price,
+		Price:      *reqJson.Price,
 		CategoryID: reqJson.CategoryID,
+		Status:     status,
 	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
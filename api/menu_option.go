@@ -0,0 +1,250 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/gin-gonic/gin"
+)
+
+type menuOptionRequest struct {
+	Name  string        `json:"name" binding:"required,max=100"`
+	Price *money.Amount `json:"price" binding:"required,price"`
+}
+
+type createOptionGroupRequest struct {
+	Name          string              `json:"name" binding:"required,max=100"`
+	MinSelections int32               `json:"min_selections" binding:"min=0"`
+	MaxSelections int32               `json:"max_selections" binding:"required,min=1,gtefield=MinSelections"`
+	Options       []menuOptionRequest `json:"options" binding:"required,min=1,dive"`
+}
+
+type optionGroupResponse struct {
+	ID            int64                `json:"id"`
+	MenuID        int64                `json:"menu_id"`
+	Name          string               `json:"name"`
+	Required      bool                 `json:"required"`
+	MinSelections int32                `json:"min_selections"`
+	MaxSelections int32                `json:"max_selections"`
+	Options       []menuOptionResponse `json:"options"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
+
+type menuOptionResponse struct {
+	ID            int64        `json:"id"`
+	OptionGroupID int64        `json:"option_group_id"`
+	Name          string       `json:"name"`
+	Price         money.Amount `json:"price"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+func newMenuOptionResponse(option db.MenuOption) menuOptionResponse {
+	return menuOptionResponse{
+		ID:            option.ID,
+		OptionGroupID: option.OptionGroupID,
+		Name:          option.Name,
+		Price:         option.Price,
+		CreatedAt:     option.CreatedAt,
+	}
+}
+
+// newOptionGroupResponses pairs each group with its options. Both lists may
+// span several menus.
+func newOptionGroupResponses(groups []db.OptionGroup, options []db.MenuOption) []optionGroupResponse {
+	responses := make([]optionGroupResponse, 0, len(groups))
+	for _, group := range groups {
+		response := optionGroupResponse{
+			ID:            group.ID,
+			MenuID:        group.MenuID,
+			Name:          group.Name,
+			Required:      group.Required(),
+			MinSelections: group.MinSelections,
+			MaxSelections: group.MaxSelections,
+			Options:       make([]menuOptionResponse, 0),
+			CreatedAt:     group.CreatedAt,
+		}
+		for _, option := range options {
+			if option.OptionGroupID == group.ID {
+				response.Options = append(response.Options, newMenuOptionResponse(option))
+			}
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// listMenuOptionGroups loads the option groups of the given menus together
+// with their options.
+func (server *Server) listMenuOptionGroups(ctx *gin.Context, menuIDs []int64) ([]optionGroupResponse, error) {
+	groups, err := server.store.ListOptionGroupsByMenuIDs(ctx, menuIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := make([]int64, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+	options, err := server.store.ListMenuOptionsByGroupIDs(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOptionGroupResponses(groups, options), nil
+}
+
+type menuIDUriRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) createOptionGroup(ctx *gin.Context) {
+	var reqUri menuIDUriRequest
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var reqJson createOptionGroupRequest
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if int(reqJson.MinSelections) > len(reqJson.Options) {
+		err := fmt.Errorf("min_selections cannot exceed the %d options given", len(reqJson.Options))
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	options := make([]db.NewMenuOption, 0, len(reqJson.Options))
+	for _, option := range reqJson.Options {
+		options = append(options, db.NewMenuOption{Name: option.Name, Price: *option.Price})
+	}
+
+	result, err := server.store.CreateOptionGroupTx(ctx, db.CreateOptionGroupTxParams{
+		CreateOptionGroupParams: db.CreateOptionGroupParams{
+			MenuID:        reqUri.ID,
+			Name:          reqJson.Name,
+			MinSelections: reqJson.MinSelections,
+			MaxSelections: reqJson.MaxSelections,
+		},
+		Options: options,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	groups := newOptionGroupResponses([]db.OptionGroup{result.OptionGroup}, result.Options)
+	ctx.JSON(http.StatusOK, groups[0])
+}
+
+func (server *Server) listOptionGroups(ctx *gin.Context) {
+	var req menuIDUriRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	groups, err := server.listMenuOptionGroups(ctx, []int64{req.ID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, groups)
+}
+
+type optionGroupIDUriRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) deleteOptionGroup(ctx *gin.Context) {
+	var req optionGroupIDUriRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetOptionGroup(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	err = server.store.DeleteOptionGroup(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "option group deleted successfully"})
+}
+
+func (server *Server) createMenuOption(ctx *gin.Context) {
+	var reqUri optionGroupIDUriRequest
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var reqJson menuOptionRequest
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetOptionGroup(ctx, reqUri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	option, err := server.store.CreateMenuOption(ctx, db.CreateMenuOptionParams{
+		OptionGroupID: reqUri.ID,
+		Name:          reqJson.Name,
+		Price:         *reqJson.Price,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newMenuOptionResponse(option))
+}
+
+type deleteMenuOptionRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) deleteMenuOption(ctx *gin.Context) {
+	var req deleteMenuOptionRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := server.store.DeleteMenuOptionTx(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrOptionRequired) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "menu option deleted successfully"})
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/stretchr/testify/require"
+)
+
+type menuStore struct {
+	stubStore
+	created []db.CreateMenuParams
+}
+
+func (store *menuStore) CreateMenu(ctx context.Context, arg db.CreateMenuParams) (db.Menu, error) {
+	store.created = append(store.created, arg)
+	return db.Menu{ID: 1, Name: arg.Name, Price: arg.Price, CategoryID: arg.CategoryID}, nil
+}
+
+func TestCreateMenuPrice(t *testing.T) {
+	testCases := []struct {
+		name       string
+		price      string
+		wantStatus int
+		wantPrice  money.Amount
+	}{
+		{"string", `"15000.50"`, http.StatusOK, money.MustParse("15000.50")},
+		{"number", `20000.25`, http.StatusOK, money.MustParse("20000.25")},
+		{"free", `0`, http.StatusOK, 0},
+		{"missing", ``, http.StatusBadRequest, 0},
+		{"negative", `"-1"`, http.StatusBadRequest, 0},
+		{"too precise", `"1.005"`, http.StatusBadRequest, 0},
+		{"exponent", `1e3`, http.StatusBadRequest, 0},
+		{"too large", `"100000000"`, http.StatusBadRequest, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &menuStore{}
+			server := newTestServer(t, store)
+
+			body := `{"name":"pho","category_id":1}`
+			if tc.price != "" {
+				body = fmt.Sprintf(`{"name":"pho","category_id":1,"price":%s}`, tc.price)
+			}
+			recorder := sendWithToken(t, server, http.MethodPost, "/menus", body, rbac.RoleAdmin)
+			require.Equal(t, tc.wantStatus, recorder.Code, recorder.Body.String())
+			if tc.wantStatus != http.StatusOK {
+				require.Empty(t, store.created)
+				return
+			}
+
+			require.Equal(t, tc.wantPrice, store.created[0].Price)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			require.Equal(t, tc.wantPrice.String(), response["price"])
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/gin-gonic/gin"
+)
+
+type menuVariantRequest struct {
+	Name      string        `json:"name" binding:"required,max=100"`
+	Price     *money.Amount `json:"price" binding:"required,price"`
+	Available *bool         `json:"available"`
+}
+
+type menuVariantResponse struct {
+	ID        int64        `json:"id"`
+	MenuID    int64        `json:"menu_id"`
+	Name      string       `json:"name"`
+	Price     money.Amount `json:"price"`
+	Available bool         `json:"available"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+func newMenuVariantResponse(variant db.MenuVariant) menuVariantResponse {
+	return menuVariantResponse{
+		ID:        variant.ID,
+		MenuID:    variant.MenuID,
+		Name:      variant.Name,
+		Price:     variant.Price,
+		Available: variant.Available,
+		CreatedAt: variant.CreatedAt,
+	}
+}
+
+func (server *Server) createMenuVariant(ctx *gin.Context) {
+	var reqUri menuIDUriRequest
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var reqJson menuVariantRequest
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetMenu(ctx, reqUri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	variant, err := server.store.CreateMenuVariant(ctx, db.CreateMenuVariantParams{
+		MenuID:    reqUri.ID,
+		Name:      reqJson.Name,
+		Price:     *reqJson.Price,
+		Available: reqJson.Available == nil || *reqJson.Available,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newMenuVariantResponse(variant))
+}
+
+func (server *Server) listMenuVariants(ctx *gin.Context) {
+	var req menuIDUriRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	variants, err := server.store.ListMenuVariantsByMenuIDs(ctx, []int64{req.ID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	variantsResponse := make([]menuVariantResponse, 0, len(variants))
+	for _, variant := range variants {
+		variantsResponse = append(variantsResponse, newMenuVariantResponse(variant))
+	}
+
+	ctx.JSON(http.StatusOK, variantsResponse)
+}
+
+type menuVariantIDUriRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) updateMenuVariant(ctx *gin.Context) {
+	var reqUri menuVariantIDUriRequest
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var reqJson menuVariantRequest
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	variant, err := server.store.GetMenuVariant(ctx, reqUri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	available := variant.Available
+	if reqJson.Available != nil {
+		available = *reqJson.Available
+	}
+
+	variant, err = server.store.UpdateMenuVariant(ctx, db.UpdateMenuVariantParams{
+		ID:        reqUri.ID,
+		Name:      reqJson.Name,
+		Price:     *reqJson.Price,
+		Available: available,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newMenuVariantResponse(variant))
+}
+
+func (server *Server) deleteMenuVariant(ctx *gin.Context) {
+	var req menuVariantIDUriRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetMenuVariant(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	err = server.store.DeleteMenuVariant(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "menu variant deleted successfully"})
+}
@@ -6,6 +6,10 @@ import (
 	"net/http"
 	"strings"
 
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/revocation"
+	"github.com/datmaithanh/orderfood/tableqr"
 	"github.com/datmaithanh/orderfood/token"
 	"github.com/gin-gonic/gin"
 )
@@ -14,9 +18,10 @@ const (
 	authorizationHeaderKey  = "authorization"
 	authorizationTypeBearer = "bearer"
 	authorizationPayloadKey = "authorization_payload"
+	tableTokenHeaderKey     = "X-Table-Token"
 )
 
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+func authMiddleware(tokenMaker token.Maker, tokenChecker *revocation.Checker) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
 
@@ -47,7 +52,71 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 			return
 		}
 
+		if payload.Role == token.GuestRole {
+			err := errors.New("guest sessions cannot access staff routes")
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		err = tokenChecker.Check(ctx, payload)
+		if err != nil {
+			if errors.Is(err, revocation.ErrTokenRevoked) || errors.Is(err, revocation.ErrTokenOutdated) {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+				return
+			}
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.Set(authorizationPayloadKey, payload)
+		ctx.Next()
+	}
+}
+
+func guestMiddleware(tokenMaker token.Maker, store db.Store, qrKey string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
+
+		fields := strings.Fields(authorizationHeader)
+		if len(fields) < 2 || strings.ToLower(fields[0]) != authorizationTypeBearer {
+			err := errors.New("table session token is not provided")
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+
+		payload, err := tokenMaker.VerifyToken(fields[1])
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+
+		if payload.Role != token.GuestRole || payload.TableID <= 0 {
+			err := errors.New("token is not a table session")
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		table, err := tableqr.VerifyToken(ctx, store, qrKey, ctx.GetHeader(tableTokenHeaderKey))
+		if err != nil || table.ID != payload.TableID {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(tableqr.ErrInvalidToken))
+			return
+		}
+
 		ctx.Set(authorizationPayloadKey, payload)
 		ctx.Next()
 	}
 }
+
+// permissionMiddleware must run after authMiddleware; it rejects staff whose
+// role is not granted the permission declared for the route.
+func permissionMiddleware(permission rbac.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		if !rbac.Can(payload.Role, permission) {
+			err := fmt.Errorf("role %q is not allowed to %s", payload.Role, permission)
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		ctx.Next()
+	}
+}
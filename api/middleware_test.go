@@ -0,0 +1,311 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// stubStore lets requests that pass authorization reach the handler; any store
+// call panics and is turned into a 500 by gin's recovery middleware.
+type stubStore struct {
+	db.Store
+}
+
+func (store stubStore) GetUserTokenVersion(ctx context.Context, username string) (int64, error) {
+	return 1, nil
+}
+
+type protectedRoute struct {
+	method     string
+	path       string
+	permission rbac.Permission
+}
+
+var protectedRoutes = map[string][]protectedRoute{
+	"users": {
+		{http.MethodGet, "/users/1", rbac.PermUserRead},
+		{http.MethodGet, "/users", rbac.PermUserRead},
+		{http.MethodDelete, "/users/1", rbac.PermUserManage},
+		{http.MethodPut, "/users/1", rbac.PermUserManage},
+		{http.MethodPatch, "/users/password/1", rbac.PermUserManage},
+		{http.MethodGet, "/users/1/sessions", rbac.PermUserManage},
+		{http.MethodDelete, "/users/1/sessions/8f2b1c3e-7a4d-4e0f-9b6a-2d5c8e1f3a7b", rbac.PermUserManage},
+		{http.MethodDelete, "/users/1/sessions", rbac.PermUserManage},
+		{http.MethodPost, "/users/1/unlock", rbac.PermUserManage},
+	},
+	"sessions": {
+		{http.MethodPost, "/users/logout", rbac.PermProfileUpdate},
+		{http.MethodGet, "/sessions", rbac.PermProfileUpdate},
+		{http.MethodDelete, "/sessions/8f2b1c3e-7a4d-4e0f-9b6a-2d5c8e1f3a7b", rbac.PermProfileUpdate},
+		{http.MethodDelete, "/sessions", rbac.PermProfileUpdate},
+	},
+	"customers": {
+		{http.MethodGet, "/customers", rbac.PermCustomerRead},
+		{http.MethodDelete, "/customers/1", rbac.PermCustomerManage},
+	},
+	"categories": {
+		{http.MethodPost, "/categories", rbac.PermMenuManage},
+		{http.MethodGet, "/categories/1", rbac.PermMenuRead},
+		{http.MethodGet, "/categories", rbac.PermMenuRead},
+		{http.MethodDelete, "/categories/1", rbac.PermMenuManage},
+		{http.MethodPatch, "/categories/1", rbac.PermMenuManage},
+		{http.MethodGet, "/categories/1/schedule", rbac.PermMenuRead},
+		{http.MethodPut, "/categories/1/schedule", rbac.PermMenuManage},
+	},
+	"menus": {
+		{http.MethodPost, "/menus", rbac.PermMenuManage},
+		{http.MethodGet, "/menus/1", rbac.PermMenuRead},
+		{http.MethodGet, "/menus", rbac.PermMenuRead},
+		{http.MethodGet, "/menus/available", rbac.PermMenuRead},
+		{http.MethodDelete, "/menus/1", rbac.PermMenuManage},
+		{http.MethodPatch, "/menus/1", rbac.PermMenuManage},
+		{http.MethodPost, "/menus/1/option_groups", rbac.PermMenuManage},
+		{http.MethodGet, "/menus/1/option_groups", rbac.PermMenuRead},
+		{http.MethodDelete, "/option_groups/1", rbac.PermMenuManage},
+		{http.MethodPost, "/option_groups/1/options", rbac.PermMenuManage},
+		{http.MethodDelete, "/menu_options/1", rbac.PermMenuManage},
+		{http.MethodPost, "/menus/1/variants", rbac.PermMenuManage},
+		{http.MethodGet, "/menus/1/variants", rbac.PermMenuRead},
+		{http.MethodPatch, "/menu_variants/1", rbac.PermMenuManage},
+		{http.MethodDelete, "/menu_variants/1", rbac.PermMenuManage},
+		{http.MethodGet, "/menus/1/schedule", rbac.PermMenuRead},
+		{http.MethodPut, "/menus/1/schedule", rbac.PermMenuManage},
+	},
+	"tables": {
+		{http.MethodPost, "/tables", rbac.PermTableManage},
+		{http.MethodGet, "/tables/1", rbac.PermTableRead},
+		{http.MethodGet, "/tables", rbac.PermTableRead},
+		{http.MethodPatch, "/tables/1", rbac.PermTableStatus},
+		{http.MethodDelete, "/tables/1", rbac.PermTableManage},
+		{http.MethodPost, "/tables/1/qr/rotate", rbac.PermTableManage},
+		{http.MethodGet, "/tables/qr/export", rbac.PermTableManage},
+	},
+	"orders": {
+		{http.MethodPost, "/orders", rbac.PermOrderWrite},
+		{http.MethodGet, "/orders/1", rbac.PermOrderRead},
+		{http.MethodGet, "/orders", rbac.PermOrderRead},
+		{http.MethodDelete, "/orders/1", rbac.PermOrderDelete},
+		{http.MethodPut, "/orders/1", rbac.PermOrderWrite},
+		{http.MethodPatch, "/orders/status/1", rbac.PermOrderStatus},
+		{http.MethodGet, "/orders/history/1", rbac.PermOrderRead},
+	},
+	"order items": {
+		{http.MethodPost, "/orderitems", rbac.PermOrderWrite},
+		{http.MethodGet, "/orderitems/1", rbac.PermOrderRead},
+		{http.MethodGet, "/orderitems", rbac.PermOrderRead},
+		{http.MethodDelete, "/orderitems/1", rbac.PermOrderDelete},
+		{http.MethodPut, "/order_items/1", rbac.PermOrderWrite},
+	},
+	"kitchen": {
+		{http.MethodGet, "/kitchen/items", rbac.PermKitchen},
+		{http.MethodPatch, "/kitchen/items/1/status", rbac.PermKitchen},
+	},
+	"events": {
+		{http.MethodGet, "/events/orders", rbac.PermEventsRead},
+	},
+	"payments": {
+		{http.MethodPost, "/payments", rbac.PermPaymentWrite},
+		{http.MethodGet, "/payments/1", rbac.PermPaymentRead},
+		{http.MethodGet, "/payments", rbac.PermPaymentRead},
+		{http.MethodDelete, "/payments/1", rbac.PermPaymentDelete},
+		{http.MethodPatch, "/payments/status/1", rbac.PermPaymentWrite},
+	},
+	"reports": {
+		{http.MethodGet, "/reports/sales", rbac.PermReportRead},
+	},
+}
+
+var publicRoutes = map[string]bool{
+	"POST /users":                    true,
+	"POST /users/login":              true,
+	"POST /users/token/renew_access": true,
+	"GET /users/verify_email":        true,
+	"POST /users/forgot_password":    true,
+	"POST /users/reset_password":     true,
+	"GET /.well-known/jwks.json":     true,
+	"POST /customers":                true,
+	"GET /customers/:id":             true,
+	"POST /guest/session":            true,
+	"GET /guest/menus":               true,
+	"POST /guest/orders":             true,
+	"GET /guest/bill":                true,
+}
+
+func serveWithToken(t *testing.T, server *Server, method string, path string, role string) *httptest.ResponseRecorder {
+	return sendWithToken(t, server, method, path, "{}", role)
+}
+
+// sendWithToken serves a JSON request carrying an access token for role, or no
+// token when role is empty. The request is cancelled after a short while so
+// that streaming routes return.
+func sendWithToken(t *testing.T, server *Server, method string, path string, body string, role string) *httptest.ResponseRecorder {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, method, path, strings.NewReader(body))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	if role != "" {
+		accessToken, _, err := server.tokenMaker.CreateToken(utils.RandomString(6), role, 1, time.Minute)
+		require.NoError(t, err)
+		request.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken))
+	}
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestPermissionMiddleware(t *testing.T) {
+	server := newTestServer(t, stubStore{})
+
+	for group, routes := range protectedRoutes {
+		for _, role := range rbac.Roles {
+			t.Run(fmt.Sprintf("%s/%s", group, role), func(t *testing.T) {
+				for _, route := range routes {
+					recorder := serveWithToken(t, server, route.method, route.path, role)
+
+					if rbac.Can(role, route.permission) {
+						require.NotEqual(t, http.StatusForbidden, recorder.Code, "%s %s", route.method, route.path)
+					} else {
+						require.Equal(t, http.StatusForbidden, recorder.Code, "%s %s", route.method, route.path)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestPermissionMiddlewareRejectsUnknownRoles(t *testing.T) {
+	server := newTestServer(t, stubStore{})
+
+	for _, routes := range protectedRoutes {
+		for _, route := range routes {
+			recorder := serveWithToken(t, server, route.method, route.path, "")
+			require.Equal(t, http.StatusUnauthorized, recorder.Code, "%s %s", route.method, route.path)
+
+			recorder = serveWithToken(t, server, route.method, route.path, "staff")
+			require.Equal(t, http.StatusForbidden, recorder.Code, "%s %s", route.method, route.path)
+
+			recorder = serveWithToken(t, server, route.method, route.path, token.GuestRole)
+			require.Equal(t, http.StatusForbidden, recorder.Code, "%s %s", route.method, route.path)
+		}
+	}
+}
+
+// Self-registered users are pending until an admin assigns them a role.
+func TestPermissionMiddlewareRejectsPendingUsers(t *testing.T) {
+	server := newTestServer(t, stubStore{})
+
+	for _, routes := range protectedRoutes {
+		for _, route := range routes {
+			recorder := serveWithToken(t, server, route.method, route.path, rbac.RolePending)
+			require.Equal(t, http.StatusForbidden, recorder.Code, "%s %s", route.method, route.path)
+		}
+	}
+}
+
+// matchRoute returns the pattern of the route that serves a concrete path,
+// preferring static segments over parameters the way gin does.
+func matchRoute(routes gin.RoutesInfo, method string, path string) (string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	best, bestScore := "", -1
+	for _, info := range routes {
+		if info.Method != method {
+			continue
+		}
+		pattern := strings.Split(strings.Trim(info.Path, "/"), "/")
+		if len(pattern) != len(segments) {
+			continue
+		}
+
+		score := 0
+		for i, segment := range pattern {
+			if strings.HasPrefix(segment, ":") {
+				continue
+			}
+			if segment != segments[i] {
+				score = -1
+				break
+			}
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = info.Path, score
+		}
+	}
+	return best, bestScore >= 0
+}
+
+func TestEveryRouteDeclaresPermission(t *testing.T) {
+	server := newTestServer(t, stubStore{})
+	routes := server.router.Routes()
+
+	registered := map[string]bool{}
+	for _, info := range routes {
+		registered[info.Method+" "+info.Path] = true
+	}
+	for key := range publicRoutes {
+		require.True(t, registered[key], "public route %s is not registered", key)
+	}
+
+	declared := map[string]bool{}
+	for group, list := range protectedRoutes {
+		for _, route := range list {
+			pattern, ok := matchRoute(routes, route.method, route.path)
+			require.True(t, ok, "%s: no route serves %s %s", group, route.method, route.path)
+
+			key := route.method + " " + pattern
+			require.False(t, publicRoutes[key], "%s is listed as both public and protected", key)
+			require.False(t, declared[key], "%s is listed twice in protectedRoutes", key)
+			declared[key] = true
+		}
+	}
+
+	for _, info := range routes {
+		key := info.Method + " " + info.Path
+		if publicRoutes[key] {
+			continue
+		}
+		require.True(t, declared[key], "%s must be listed in protectedRoutes", key)
+	}
+}
+
+func TestAuthMiddlewareRejectsRevokedAndOutdatedTokens(t *testing.T) {
+	server := newTestServer(t, stubStore{})
+
+	serve := func(accessToken string) int {
+		request, err := http.NewRequest(http.MethodGet, "/sessions", nil)
+		require.NoError(t, err)
+		request.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken))
+
+		recorder := httptest.NewRecorder()
+		server.router.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	accessToken, payload, err := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 1, time.Minute)
+	require.NoError(t, err)
+	require.NotEqual(t, http.StatusUnauthorized, serve(accessToken))
+
+	require.NoError(t, server.tokenChecker.Revoke(context.Background(), payload))
+	require.Equal(t, http.StatusUnauthorized, serve(accessToken))
+
+	outdatedToken, _, err := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 0, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, serve(outdatedToken))
+}
@@ -1,28 +1,60 @@
 package api
 
 import (
+	"database/sql"
+	"errors"
 	"net/http"
 	"time"
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/token"
 	"github.com/gin-gonic/gin"
 )
 
+type createOrderItemCartRequest struct {
+	MenuID    int64   `json:"menu_id" binding:"required_without=VariantID,min=0"`
+	VariantID int64   `json:"variant_id" binding:"min=0"`
+	Quantity  int32   `json:"quantity" binding:"required,gt=0"`
+	NoteItem  string  `json:"note_item" binding:"max=255"`
+	OptionIDs []int64 `json:"option_ids" binding:"dive,min=1"`
+}
+
+func newPlaceOrderItems(cart []createOrderItemCartRequest) []db.PlaceOrderItem {
+	items := make([]db.PlaceOrderItem, 0, len(cart))
+	for _, item := range cart {
+		items = append(items, db.PlaceOrderItem{
+			MenuID:    item.MenuID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+			NoteItem:  item.NoteItem,
+			OptionIDs: item.OptionIDs,
+		})
+	}
+	return items
+}
+
 type createOrderRequest struct {
-	CustomerID int64  `json:"customer_id" binding:"required,min=1"`
-	UserID     int64  `json:"user_id" binding:"required,min=1"`
-	TableID    int64  `json:"table_id" binding:"required,min=1"`
-	TotalPrice string `json:"total_price" binding:"required,number"`
+	CustomerID int64                        `json:"customer_id" binding:"required,min=1"`
+	UserID     int64                        `json:"user_id" binding:"required,min=1"`
+	TableID    int64                        `json:"table_id" binding:"required,min=1"`
+	Items      []createOrderItemCartRequest `json:"items" binding:"required,min=1,dive"`
 }
 
 type orderResponse struct {
-	ID         int64     `json:"id"`
-	CustomerID int64     `json:"customer_id"`
-	UserID     int64     `json:"user_id"`
-	TableID    int64     `json:"table_id"`
-	TotalPrice string    `json:"total_price"`
-	Status     string    `json:"status"`
-	CreatedAt  time.Time `json:"created_at"`
+	ID         int64        `json:"id"`
+	CustomerID int64        `json:"customer_id"`
+	UserID     int64        `json:"user_id"`
+	TableID    int64        `json:"table_id"`
+	TotalPrice money.Amount `json:"total_price"`
+	Status     string       `json:"status"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type placeOrderResponse struct {
+	orderResponse
+	Items []orderItemResponse `json:"items"`
 }
 
 func (server *Server) createOrder(ctx *gin.Context) {
@@ -32,28 +64,48 @@ func (server *Server) createOrder(ctx *gin.Context) {
 		return
 	}
 
-	order, err := server.store.CreateOrder(ctx, db.CreateOrderParams{
-		CustomerID: req.CustomerID,
-		UserID:     req.UserID,
+	result, err := server.store.PlaceOrderTx(ctx, db.PlaceOrderTxParams{
+		CustomerID: sql.NullInt64{Int64: req.CustomerID, Valid: true},
+		UserID:     sql.NullInt64{Int64: req.UserID, Valid: true},
 		TableID:    req.TableID,
-		TotalPrice: req.TotalPrice,
+		Items:      newPlaceOrderItems(req.Items),
+		OrderedAt:  server.now(),
 	})
 	if err != nil {
+		if orderItemSelectionError(ctx, err) {
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "menu not found"})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	orderResponse := orderResponse{
-		ID:         order.ID,
-		CustomerID: order.CustomerID,
-		UserID:     order.UserID,
-		TableID:    order.TableID,
-		TotalPrice: order.TotalPrice,
-		Status:     order.Status,
-		CreatedAt:  order.CreatedAt,
+	order := result.Order
+	server.publishOrderEvent(events.TypeOrderCreated, order)
+	for _, item := range result.Items {
+		server.publishOrderItemEvent(events.TypeOrderItemCreated, item, order.TableID)
 	}
 
-	ctx.JSON(http.StatusOK, orderResponse)
+	placeOrderResponse := placeOrderResponse{
+		orderResponse: orderResponse{
+			ID:         order.ID,
+			CustomerID: order.CustomerID.Int64,
+			UserID:     order.UserID.Int64,
+			TableID:    order.TableID,
+			TotalPrice: order.TotalPrice,
+			Status:     order.Status,
+			CreatedAt:  order.CreatedAt,
+		},
+		Items: make([]orderItemResponse, 0, len(result.Items)),
+	}
+	for _, item := range result.Items {
+		placeOrderResponse.Items = append(placeOrderResponse.Items, newOrderItemResponse(item, result.Options))
+	}
+
+	ctx.JSON(http.StatusOK, placeOrderResponse)
 }
 
 type getOrderRequest struct {
@@ -75,8 +127,8 @@ func (server *Server) getOrder(ctx *gin.Context) {
 
 	orderResponse := orderResponse{
 		ID:         order.ID,
-		CustomerID: order.CustomerID,
-		UserID:     order.UserID,
+		CustomerID: order.CustomerID.Int64,
+		UserID:     order.UserID.Int64,
 		TableID:    order.TableID,
 		TotalPrice: order.TotalPrice,
 		Status:     order.Status,
@@ -111,8 +163,8 @@ func (server *Server) listOrders(ctx *gin.Context) {
 	for _, order := range orders {
 		orderResp := orderResponse{
 			ID:         order.ID,
-			CustomerID: order.CustomerID,
-			UserID:     order.UserID,
+			CustomerID: order.CustomerID.Int64,
+			UserID:     order.UserID.Int64,
 			TableID:    order.TableID,
 			TotalPrice: order.TotalPrice,
 			Status:     order.Status,
@@ -135,12 +187,23 @@ func (server *Server) deleteOrder(ctx *gin.Context) {
 		return
 	}
 
-	err := server.store.DeleteOrder(ctx, req.ID)
+	order, err := server.store.GetOrder(ctx, req.ID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	err = server.store.DeleteOrder(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.publishOrderEvent(events.TypeOrderDeleted, order)
+
 	ctx.JSON(http.StatusOK, gin.H{"message": "order deleted successfully"})
 }
 
@@ -151,9 +214,8 @@ type orderIDUriRequest struct {
 type orderUpdateRequest struct {
 	UserID     int64  `json:"user_id" binding:"required,min=1"`
 	TableID    int64  `json:"table_id" binding:"required,min=1"`
-	customerID int64  `json:"customer_id" binding:"required,min=1"`
-	TotalPrice string `json:"total_price" binding:"required,number"`
-	Status     string `json:"status" binding:"required"`
+	CustomerID int64  `json:"customer_id" binding:"required,min=1"`
+	Status     string `json:"status" binding:"omitempty,oneof=pending confirmed preparing served paid cancelled"`
 }
 
 func (server *Server) updateOrder(ctx *gin.Context) {
@@ -169,23 +231,40 @@ func (server *Server) updateOrder(ctx *gin.Context) {
 		return
 	}
 
-	order, err := server.store.UpdateOrder(ctx, db.UpdateOrderParams{
-		ID:         reqUri.ID,
-		UserID:     reqJson.UserID,
-		CustomerID: reqJson.customerID,
-		TableID:    reqJson.TableID,
-		TotalPrice: reqJson.TotalPrice,
-		Status:     reqJson.Status,
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := server.store.UpdateOrderTx(ctx, db.UpdateOrderTxParams{
+		UpdateOrderParams: db.UpdateOrderParams{
+			ID:         reqUri.ID,
+			UserID:     sql.NullInt64{Int64: reqJson.UserID, Valid: true},
+			CustomerID: sql.NullInt64{Int64: reqJson.CustomerID, Valid: true},
+			TableID:    reqJson.TableID,
+		},
+		Status:    reqJson.Status,
+		ChangedBy: authPayload.Username,
 	})
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidOrderStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	order := result.Order
+	if reqJson.Status != "" {
+		server.publishOrderEvent(events.TypeOrderStatusChanged, order)
+	}
+	server.publishOrderEvent(events.TypeOrderUpdated, order)
+
 	orderResponse := orderResponse{
 		ID:         order.ID,
-		CustomerID: order.CustomerID,
-		UserID:     order.UserID,
+		CustomerID: order.CustomerID.Int64,
+		UserID:     order.UserID.Int64,
 		TableID:    order.TableID,
 		TotalPrice: order.TotalPrice,
 		Status:     order.Status,
@@ -196,7 +275,7 @@ func (server *Server) updateOrder(ctx *gin.Context) {
 }
 
 type updateOrderStatusRequest struct {
-	Status string `json:"status" binding:"required"`
+	Status string `json:"status" binding:"required,oneof=pending confirmed preparing served paid cancelled"`
 }
 
 func (server *Server) updateOrderStatus(ctx *gin.Context) {
@@ -212,19 +291,32 @@ func (server *Server) updateOrderStatus(ctx *gin.Context) {
 		return
 	}
 
-	order, err := server.store.UpdateOrderStatus(ctx, db.UpdateOrderStatusParams{
-		ID:     reqUri.ID,
-		Status: reqJson.Status,
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := server.store.UpdateOrderStatusTx(ctx, db.UpdateOrderStatusTxParams{
+		OrderID:   reqUri.ID,
+		Status:    reqJson.Status,
+		ChangedBy: authPayload.Username,
 	})
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidOrderStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	order := result.Order
+	server.publishOrderEvent(events.TypeOrderStatusChanged, order)
+
 	orderResponse := orderResponse{
 		ID:         order.ID,
-		CustomerID: order.CustomerID,
-		UserID:     order.UserID,
+		CustomerID: order.CustomerID.Int64,
+		UserID:     order.UserID.Int64,
 		TableID:    order.TableID,
 		TotalPrice: order.TotalPrice,
 		Status:     order.Status,
@@ -233,3 +325,40 @@ func (server *Server) updateOrderStatus(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, orderResponse)
 }
+
+type orderStatusHistoryResponse struct {
+	ID         int64     `json:"id"`
+	OrderID    int64     `json:"order_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ChangedBy  string    `json:"changed_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (server *Server) listOrderStatusHistory(ctx *gin.Context) {
+	var reqUri orderIDUriRequest
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	histories, err := server.store.ListOrderStatusHistory(ctx, reqUri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	historiesResponse := make([]orderStatusHistoryResponse, 0)
+	for _, history := range histories {
+		historiesResponse = append(historiesResponse, orderStatusHistoryResponse{
+			ID:         history.ID,
+			OrderID:    history.OrderID,
+			FromStatus: history.FromStatus,
+			ToStatus:   history.ToStatus,
+			ChangedBy:  history.ChangedBy,
+			CreatedAt:  history.CreatedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, historiesResponse)
+}
@@ -1,98 +1,154 @@
 package api
 
 import (
-	"fmt"
+	"database/sql"
+	"errors"
 	"net/http"
-	"strconv"
 	"time"
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/token"
 	"github.com/gin-gonic/gin"
 )
 
 type createOrderItemRequest struct {
-	OrderID  int64  `json:"order_id" binding:"required,min=1"`
-	MenuID   int64  `json:"menu_id" binding:"required,min=1"`
-	Quantity int32  `json:"quantity" binding:"required,gt=0"`
+	OrderID   int64   `json:"order_id" binding:"required,min=1"`
+	MenuID    int64   `json:"menu_id" binding:"required_without=VariantID,min=0"`
+	VariantID int64   `json:"variant_id" binding:"min=0"`
+	Quantity  int32   `json:"quantity" binding:"required,gt=0"`
+	NoteItem  string  `json:"note_item" binding:"max=255"`
+	OptionIDs []int64 `json:"option_ids" binding:"dive,min=1"`
 }
 
 type orderItemResponse struct {
-	ID        int64     `json:"id"`
-	OrderID   int64     `json:"order_id"`
-	MenuID    int64     `json:"menu_id"`
-	Quantity  int32     `json:"quantity"`
-	Price     string    `json:"price"`
-	NoteItem  string    `json:"note_item"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
+	ID           int64                     `json:"id"`
+	OrderID      int64                     `json:"order_id"`
+	MenuID       int64                     `json:"menu_id"`
+	VariantID    int64                     `json:"variant_id"`
+	VariantName  string                    `json:"variant_name"`
+	Quantity     int32                     `json:"quantity"`
+	Price        money.Amount              `json:"price"`
+	OptionsPrice money.Amount              `json:"options_price"`
+	TotalPrice   money.Amount              `json:"total_price"`
+	Options      []orderItemOptionResponse `json:"options"`
+	NoteItem     string                    `json:"note_item"`
+	Status       string                    `json:"status"`
+	CreatedAt    time.Time                 `json:"created_at"`
 }
 
-func (server *Server) createOrderItem(ctx *gin.Context) {
-	var req createOrderItemRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
-		return
-	}
+type orderItemOptionResponse struct {
+	OptionID  int64        `json:"option_id"`
+	GroupName string       `json:"group_name"`
+	Name      string       `json:"name"`
+	Price     money.Amount `json:"price"`
+}
 
-	menu, err := server.store.GetMenu(ctx, req.MenuID)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+// newOrderItemResponse builds the response for an item from the options
+// chosen for it, which may be mixed with the options of other items.
+func newOrderItemResponse(item db.OrderItem, options []db.OrderItemOption) orderItemResponse {
+	return orderItemResponse{
+		ID:           item.ID,
+		OrderID:      item.OrderID,
+		MenuID:       item.MenuID,
+		VariantID:    item.VariantID.Int64,
+		VariantName:  item.VariantName,
+		Quantity:     item.Quantity,
+		Price:        item.Price,
+		OptionsPrice: item.OptionsPrice,
+		TotalPrice:   item.Total(),
+		Options:      newOrderItemOptionResponses(item.ID, options),
+		NoteItem:     item.NoteItem,
+		Status:       item.Status,
+		CreatedAt:    item.CreatedAt,
 	}
+}
 
-	orderItem, err := server.store.CreateOrderItem(ctx, db.CreateOrderItemParams{
-		OrderID:  req.OrderID,
-		MenuID:   req.MenuID,
-		Quantity: req.Quantity,
-		Price:    menu.Price,
-	})
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+func newOrderItemOptionResponses(itemID int64, options []db.OrderItemOption) []orderItemOptionResponse {
+	responses := make([]orderItemOptionResponse, 0)
+	for _, option := range options {
+		if option.OrderItemID != itemID {
+			continue
+		}
+		responses = append(responses, orderItemOptionResponse{
+			OptionID:  option.MenuOptionID.Int64,
+			GroupName: option.OptionGroupName,
+			Name:      option.OptionName,
+			Price:     option.Price,
+		})
 	}
+	return responses
+}
 
-	order, err := server.store.GetOrder(ctx, req.OrderID)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+func (server *Server) listOrderItemOptions(ctx *gin.Context, items ...db.OrderItem) ([]db.OrderItemOption, error) {
+	itemIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ID)
 	}
+	return server.store.ListOrderItemOptionsByOrderItemIDs(ctx, itemIDs)
+}
 
-	oldOrderPrice, err := strconv.ParseFloat(order.TotalPrice, 32)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+// orderItemSelectionError answers 400 when the chosen variant or options do
+// not fit the menu and 409 when the menu cannot be ordered at this time.
+func orderItemSelectionError(ctx *gin.Context, err error) bool {
+	if errors.Is(err, db.ErrMenuUnavailable) {
+		ctx.JSON(http.StatusConflict, errorResponse(err))
+		return true
 	}
-	priceNewItem, err := strconv.ParseFloat(orderItem.Price, 32)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	if errors.Is(err, db.ErrInvalidOptionSelection) || errors.Is(err, db.ErrInvalidVariant) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return true
+	}
+	return false
+}
+
+func (server *Server) createOrderItem(ctx *gin.Context) {
+	var req createOrderItemRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
-	newTotalOrderPrice := fmt.Sprintf("%.2f", oldOrderPrice+priceNewItem*float64(orderItem.Quantity))
 
-	_, err = server.store.UpdateOrderTotalPrice(ctx, db.UpdateOrderTotalPriceParams{
-		ID:         req.OrderID,
-		TotalPrice: newTotalOrderPrice,
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := server.store.AddOrderItemTx(ctx, db.AddOrderItemTxParams{
+		OrderID:   req.OrderID,
+		MenuID:    req.MenuID,
+		VariantID: req.VariantID,
+		Quantity:  req.Quantity,
+		NoteItem:  req.NoteItem,
+		OptionIDs: req.OptionIDs,
+		OrderedAt: server.now(),
+		ChangedBy: authPayload.Username,
 	})
 	if err != nil {
+		if orderItemSelectionError(ctx, err) {
+			return
+		}
+		if errors.Is(err, db.ErrInvalidOrderStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "order or menu not found"})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	orderItemResponse := orderItemResponse{
-		ID:        orderItem.ID,
-		OrderID:   orderItem.OrderID,
-		MenuID:    orderItem.MenuID,
-		Quantity:  orderItem.Quantity,
-		Price:     orderItem.Price,
-		NoteItem:  orderItem.NoteItem,
-		Status:    orderItem.Status,
-		CreatedAt: orderItem.CreatedAt,
+	orderItem := result.OrderItem
+	server.publishOrderItemEvent(events.TypeOrderItemCreated, orderItem, result.Order.TableID)
+	if len(result.History) > 0 {
+		server.publishOrderEvent(events.TypeOrderStatusChanged, result.Order)
 	}
+	server.publishOrderEvent(events.TypeOrderUpdated, result.Order)
+
+	orderItemResponse := newOrderItemResponse(orderItem, result.Options)
 
 	ctx.JSON(http.StatusOK, orderItemResponse)
 }
 
-
 type getOrderItemRequest struct {
 	ID int64 `uri:"id" binding:"required,min=1"`
 }
@@ -110,24 +166,19 @@ func (server *Server) getOrderItem(ctx *gin.Context) {
 		return
 	}
 
-	orderItemResponse := orderItemResponse{
-		ID:        orderItem.ID,
-		OrderID:   orderItem.OrderID,
-		MenuID:    orderItem.MenuID,
-		Quantity:  orderItem.Quantity,
-		Price:     orderItem.Price,
-		NoteItem:  orderItem.NoteItem,
-		Status:    orderItem.Status,
-		CreatedAt: orderItem.CreatedAt,
+	options, err := server.listOrderItemOptions(ctx, orderItem)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
 	}
 
+	orderItemResponse := newOrderItemResponse(orderItem, options)
+
 	ctx.JSON(http.StatusOK, orderItemResponse)
 }
 
-
-
 type listOrderItemsRequest struct {
-	PageID  int32 `form:"page_id" binding:"required,min=1"`
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
 	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
 }
 
@@ -149,25 +200,21 @@ func (server *Server) listOrderItems(ctx *gin.Context) {
 		return
 	}
 
+	options, err := server.listOrderItemOptions(ctx, items...)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
 	var itemsResponse = make([]orderItemResponse, 0)
 	for _, item := range items {
-		itemResp := orderItemResponse{
-			ID:        item.ID,
-			OrderID:   item.OrderID,
-			MenuID:    item.MenuID,
-			Quantity:  item.Quantity,
-			Price:     item.Price,
-			NoteItem:  item.NoteItem,
-			Status:    item.Status,
-			CreatedAt: item.CreatedAt,
-		}
+		itemResp := newOrderItemResponse(item, options)
 		itemsResponse = append(itemsResponse, itemResp)
 	}
 
 	ctx.JSON(http.StatusOK, itemsResponse)
 }
 
-
 type deleteOrderItemRequest struct {
 	ID int64 `uri:"id" binding:"required,min=1"`
 }
@@ -179,25 +226,31 @@ func (server *Server) deleteOrderItem(ctx *gin.Context) {
 		return
 	}
 
-	err := server.store.DeleteOrderItem(ctx, req.ID)
+	result, err := server.store.DeleteOrderItemTx(ctx, req.ID)
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidOrderStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	server.publishOrderItemEvent(events.TypeOrderItemDeleted, result.OrderItem, result.Order.TableID)
+	server.publishOrderEvent(events.TypeOrderUpdated, result.Order)
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "order item deleted successfully"})
 }
 
-
 type updateOrderItemIDUriRequest struct {
 	ID int64 `uri:"id" binding:"required,min=1"`
 }
 type updateOrderItemRequest struct {
-	OrderID  int64  `json:"order_id" binding:"required,min=1"`
-	MenuID   int64  `json:"menu_id" binding:"required,min=1"`
 	Quantity int32  `json:"quantity" binding:"required,gt=0"`
-	NoteItem string `json:"note_item"`
-	Status   string `json:"status"`
+	NoteItem string `json:"note_item" binding:"max=255"`
 }
 
 func (server *Server) updateOrderItem(ctx *gin.Context) {
@@ -212,29 +265,35 @@ func (server *Server) updateOrderItem(ctx *gin.Context) {
 		return
 	}
 
-	orderItem,  err := server.store.UpdateOrderItem(ctx, db.UpdateOrderItemParams{
-		ID:        reqUri.ID,
-		OrderID:   reqJson.OrderID,
-		MenuID:    reqJson.MenuID,
-		Quantity:  reqJson.Quantity,
-		NoteItem:  reqJson.NoteItem,
-		Status:    reqJson.Status,
+	result, err := server.store.UpdateOrderItemTx(ctx, db.UpdateOrderItemTxParams{
+		ID:       reqUri.ID,
+		Quantity: reqJson.Quantity,
+		NoteItem: reqJson.NoteItem,
 	})
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidOrderStatusTransition) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	orderItemResponse := orderItemResponse{
-		ID:        orderItem.ID,
-		OrderID:   orderItem.OrderID,
-		MenuID:    orderItem.MenuID,
-		Quantity:  orderItem.Quantity,
-		Price:     orderItem.Price,
-		NoteItem:  orderItem.NoteItem,
-		Status:    orderItem.Status,
-		CreatedAt: orderItem.CreatedAt,
+	orderItem := result.OrderItem
+	server.publishOrderItemEvent(events.TypeOrderItemUpdated, orderItem, result.Order.TableID)
+	server.publishOrderEvent(events.TypeOrderUpdated, result.Order)
+
+	options, err := server.listOrderItemOptions(ctx, orderItem)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
 	}
 
+	orderItemResponse := newOrderItemResponse(orderItem, options)
+
 	ctx.JSON(http.StatusOK, orderItemResponse)
-}
\ No newline at end of file
+}
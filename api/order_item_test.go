@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/stretchr/testify/require"
+)
+
+// orderItemStore stands in for menu 2, which needs a "Size" option, and menu 3,
+// which is only sold through its variant 4.
+type orderItemStore struct {
+	stubStore
+	added []db.AddOrderItemTxParams
+}
+
+func (store *orderItemStore) AddOrderItemTx(ctx context.Context, arg db.AddOrderItemTxParams) (db.AddOrderItemTxResult, error) {
+	store.added = append(store.added, arg)
+	if arg.VariantID == 4 {
+		return db.AddOrderItemTxResult{
+			Order: db.Order{ID: arg.OrderID},
+			OrderItem: db.OrderItem{
+				ID:          8,
+				OrderID:     arg.OrderID,
+				MenuID:      3,
+				VariantID:   sql.NullInt64{Int64: arg.VariantID, Valid: true},
+				VariantName: "Large",
+				Quantity:    arg.Quantity,
+				Price:       money.MustParse("55000"),
+			},
+		}, nil
+	}
+	if arg.VariantID != 0 || arg.MenuID == 3 {
+		return db.AddOrderItemTxResult{}, fmt.Errorf("%w: menu 3 must be ordered by one of its variants", db.ErrInvalidVariant)
+	}
+	if len(arg.OptionIDs) == 0 {
+		return db.AddOrderItemTxResult{}, fmt.Errorf("%w: \"Size\" needs at least 1 selections", db.ErrInvalidOptionSelection)
+	}
+
+	item := db.OrderItem{
+		ID:           7,
+		OrderID:      arg.OrderID,
+		MenuID:       arg.MenuID,
+		Quantity:     arg.Quantity,
+		Price:        money.MustParse("45000"),
+		OptionsPrice: money.MustParse("12000"),
+	}
+	return db.AddOrderItemTxResult{
+		Order:     db.Order{ID: arg.OrderID},
+		OrderItem: item,
+		Options: []db.OrderItemOption{
+			{OrderItemID: 7, MenuOptionID: sql.NullInt64{Int64: 3, Valid: true}, OptionGroupName: "Size", OptionName: "L", Price: money.MustParse("5000")},
+			{OrderItemID: 7, MenuOptionID: sql.NullInt64{Int64: 5, Valid: true}, OptionGroupName: "Toppings", OptionName: "Egg", Price: money.MustParse("7000")},
+		},
+	}, nil
+}
+
+func postOrderItem(t *testing.T, server *Server, body string) *httptest.ResponseRecorder {
+	return sendWithToken(t, server, http.MethodPost, "/orderitems", body, rbac.RoleWaiter)
+}
+
+func TestCreateOrderItemWithOptions(t *testing.T) {
+	store := &orderItemStore{}
+	server := newTestServer(t, store)
+
+	recorder := postOrderItem(t, server, `{"order_id":1,"menu_id":2,"quantity":2,"option_ids":[3,5]}`)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	require.Equal(t, []int64{3, 5}, store.added[0].OptionIDs)
+
+	var response orderItemResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Equal(t, money.MustParse("12000"), response.OptionsPrice)
+	require.Equal(t, money.MustParse("114000"), response.TotalPrice)
+	require.Equal(t, []orderItemOptionResponse{
+		{OptionID: 3, GroupName: "Size", Name: "L", Price: money.MustParse("5000")},
+		{OptionID: 5, GroupName: "Toppings", Name: "Egg", Price: money.MustParse("7000")},
+	}, response.Options)
+
+	recorder = postOrderItem(t, server, `{"order_id":1,"menu_id":2,"quantity":2}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "invalid option selection")
+
+	recorder = postOrderItem(t, server, `{"order_id":1,"menu_id":2,"quantity":2,"option_ids":[0]}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Len(t, store.added, 2)
+}
+
+func TestCreateOrderItemWithVariant(t *testing.T) {
+	store := &orderItemStore{}
+	server := newTestServer(t, store)
+
+	recorder := postOrderItem(t, server, `{"order_id":1,"variant_id":4,"quantity":2}`)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	require.Equal(t, int64(0), store.added[0].MenuID)
+	require.Equal(t, int64(4), store.added[0].VariantID)
+
+	var response orderItemResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Equal(t, int64(3), response.MenuID)
+	require.Equal(t, int64(4), response.VariantID)
+	require.Equal(t, "Large", response.VariantName)
+	require.Equal(t, money.MustParse("110000"), response.TotalPrice)
+
+	recorder = postOrderItem(t, server, `{"order_id":1,"menu_id":3,"quantity":2}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "invalid menu variant")
+
+	recorder = postOrderItem(t, server, `{"order_id":1,"quantity":2}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Len(t, store.added, 2)
+}
+
+type closedOrderStore struct {
+	stubStore
+}
+
+func (store closedOrderStore) AddOrderItemTx(ctx context.Context, arg db.AddOrderItemTxParams) (db.AddOrderItemTxResult, error) {
+	return db.AddOrderItemTxResult{}, fmt.Errorf("%w: cannot add items to a paid order", db.ErrInvalidOrderStatusTransition)
+}
+
+func TestCreateOrderItemClosedOrder(t *testing.T) {
+	server := newTestServer(t, closedOrderStore{})
+
+	recorder := postOrderItem(t, server, `{"order_id":1,"menu_id":2,"quantity":1}`)
+	require.Equal(t, http.StatusConflict, recorder.Code, recorder.Body.String())
+	require.Contains(t, recorder.Body.String(), "paid order")
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/stretchr/testify/require"
+)
+
+type orderStore struct {
+	stubStore
+	getErr error
+}
+
+func (store orderStore) GetOrder(ctx context.Context, id int64) (db.Order, error) {
+	return db.Order{}, store.getErr
+}
+
+func (store orderStore) UpdateOrderStatusTx(ctx context.Context, arg db.UpdateOrderStatusTxParams) (db.UpdateOrderStatusTxResult, error) {
+	return db.UpdateOrderStatusTxResult{Order: db.Order{ID: arg.OrderID, TableID: 3, Status: arg.Status}}, nil
+}
+
+func (store orderStore) UpdateOrderTx(ctx context.Context, arg db.UpdateOrderTxParams) (db.UpdateOrderTxResult, error) {
+	order := db.Order{ID: arg.ID, TableID: arg.TableID, Status: db.OrderStatusPending}
+	if arg.Status != "" {
+		order.Status = arg.Status
+	}
+	return db.UpdateOrderTxResult{Order: order}, nil
+}
+
+// receivedEvents returns the types of the events already delivered to sub.
+func receivedEvents(sub *events.Subscription) []string {
+	var types []string
+	for {
+		select {
+		case event := <-sub.Events():
+			types = append(types, event.Type)
+		default:
+			return types
+		}
+	}
+}
+
+func TestUpdateOrderStatusPublishesEvent(t *testing.T) {
+	server := newTestServer(t, orderStore{})
+	sub, err := server.eventBus.Subscribe(events.Filter{}, 0)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	recorder := sendWithToken(t, server, http.MethodPatch, "/orders/status/1", `{"status":"confirmed"}`, rbac.RoleWaiter)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	require.Equal(t, []string{events.TypeOrderStatusChanged}, receivedEvents(sub))
+}
+
+func TestUpdateOrderPublishesStatusChangeOnlyWithStatus(t *testing.T) {
+	server := newTestServer(t, orderStore{})
+	sub, err := server.eventBus.Subscribe(events.Filter{}, 0)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	body := `{"user_id":1,"table_id":3,"customer_id":2}`
+	recorder := sendWithToken(t, server, http.MethodPut, "/orders/1", body, rbac.RoleWaiter)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	require.Equal(t, []string{events.TypeOrderUpdated}, receivedEvents(sub))
+
+	body = `{"user_id":1,"table_id":3,"customer_id":2,"status":"confirmed"}`
+	recorder = sendWithToken(t, server, http.MethodPut, "/orders/1", body, rbac.RoleWaiter)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	require.Equal(t, []string{events.TypeOrderStatusChanged, events.TypeOrderUpdated}, receivedEvents(sub))
+}
+
+func TestDeleteOrderLookupErrors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"not found", sql.ErrNoRows, http.StatusNotFound},
+		{"database error", errors.New("connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, orderStore{getErr: tc.err})
+
+			recorder := sendWithToken(t, server, http.MethodDelete, "/orders/1", "", rbac.RoleAdmin)
+			require.Equal(t, tc.wantStatus, recorder.Code, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/ratelimit"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/datmaithanh/orderfood/worker"
+	"github.com/gin-gonic/gin"
+	"github.com/hibiken/asynq"
+)
+
+type forgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type passwordResetResponse struct {
+	Message string `json:"message"`
+}
+
+func (server *Server) forgotPassword(ctx *gin.Context) {
+	var req forgotPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	clientIP := ctx.ClientIP()
+	for _, check := range []struct {
+		limiter ratelimit.Limiter
+		key     string
+	}{
+		{server.resetLimiters.IP, clientIP},
+		{server.resetLimiters.Email, strings.ToLower(req.Email)},
+	} {
+		allowed, err := check.limiter.Allow(ctx, check.key)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if !allowed {
+			ctx.JSON(http.StatusTooManyRequests, errorResponse(errors.New("too many password reset requests, try again later")))
+			return
+		}
+	}
+
+	err := server.taskDistributor.DistributeTaskSendResetPassword(ctx, &worker.PayloadSendResetPassword{
+		Email:    req.Email,
+		ClientIP: clientIP,
+	}, asynq.MaxRetry(5), asynq.Queue(worker.QueueCritical))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, passwordResetResponse{
+		Message: "if the email belongs to an account, a reset link has been sent",
+	})
+}
+
+type resetPasswordRequest struct {
+	Token    string `json:"token" binding:"required,min=32,max=128"`
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+func (server *Server) resetPassword(ctx *gin.Context) {
+	var req resetPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	hashedPassword, err := utils.HashPassword(req.Password)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.store.ResetPasswordTx(ctx, db.ResetPasswordTxParams{
+		TokenHash:    utils.HashSecretCode(req.Token),
+		HashPassword: hashedPassword,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("reset link is invalid or has expired")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, passwordResetResponse{Message: "password has been reset, please log in again"})
+}
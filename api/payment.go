@@ -5,6 +5,8 @@ import (
 	"time"
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/money"
 	"github.com/gin-gonic/gin"
 )
 
@@ -14,12 +16,12 @@ type createPaymentRequest struct {
 }
 
 type paymentResponse struct {
-	ID            int64     `json:"id"`
-	OrderID       int64     `json:"order_id"`
-	PaymentMethod string    `json:"payment_method"`
-	Amount        string    `json:"amount"`
-	Status        string    `json:"status"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID            int64        `json:"id"`
+	OrderID       int64        `json:"order_id"`
+	PaymentMethod string       `json:"payment_method"`
+	Amount        money.Amount `json:"amount"`
+	Status        string       `json:"status"`
+	CreatedAt     time.Time    `json:"created_at"`
 }
 
 func (server *Server) createPayment(ctx *gin.Context) {
@@ -44,6 +46,7 @@ func (server *Server) createPayment(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	server.publishPaymentEvent(events.TypePaymentCreated, payment, order.TableID)
 
 	paymentResponse := paymentResponse{
 		ID:            payment.ID,
@@ -137,11 +140,18 @@ func (server *Server) deletePayment(ctx *gin.Context) {
 		return
 	}
 
-	err := server.store.DeletePayment(ctx, req.ID)
+	payment, err := server.store.GetPayment(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return
+	}
+
+	err = server.store.DeletePayment(ctx, req.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	server.publishPaymentEvent(events.TypePaymentDeleted, payment, server.orderTableID(ctx, payment.OrderID))
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "payment deleted successfully"})
 }
@@ -175,6 +185,7 @@ func (server *Server) updatePaymentStatus(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	server.publishPaymentEvent(events.TypePaymentStatusChanged, payment, server.orderTableID(ctx, payment.OrderID))
 
 	paymentResponse := paymentResponse{
 		ID:            payment.ID,
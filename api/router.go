@@ -1,79 +1,136 @@
 package api
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/storage"
+	"github.com/gin-gonic/gin"
+)
 
 func (server *Server) setupRouter() *gin.Engine {
 	router := gin.Default()
+	registerValidators()
 	
 	// Public routes
+	if localStore, ok := server.imageStore.(*storage.LocalStore); ok {
+		router.Static(storage.LocalURLPath, localStore.Dir())
+	}
 
 	// User routes
 	router.POST("/users", server.createUser)
 	router.POST("/users/login", server.loginUser)
 	router.POST("/users/token/renew_access", server.reNewAccessToken)
+	router.GET("/users/verify_email", server.verifyEmail)
+	router.POST("/users/forgot_password", server.forgotPassword)
+	router.POST("/users/reset_password", server.resetPassword)
+	router.GET("/.well-known/jwks.json", server.getTokenKeys)
 	
 
 	// Customer routes
 	router.POST("/customers", server.createCustomer)
 	router.GET("/customers/:id", server.getCustomer)
 
+	// Guest routes
+	router.POST("/guest/session", server.createGuestSession)
+
+	guestRouter := router.Group("/guest").Use(guestMiddleware(server.tokenMaker, server.store, server.config.TokenSymmetricKey))
+	guestRouter.GET("/menus", server.listAvailableMenus)
+	guestRouter.POST("/orders", server.createGuestOrder)
+	guestRouter.GET("/bill", server.getGuestBill)
+
 
 	// Protected routes
-	authRouter := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRouter := router.Group("/").Use(authMiddleware(server.tokenMaker, server.tokenChecker))
 
-	// Auth user routes 
-	authRouter.GET("/users/:id", server.getUser)
-	authRouter.GET("/users", server.listUsers)
-	authRouter.DELETE("/users/:id", server.deleteUser)
-	authRouter.PUT("users/:id", server.updateUser)
-	authRouter.PATCH("users/password/:id", server.updateUserWithPassword)
+	// Auth user routes
+	authRouter.GET("/users/:id", permissionMiddleware(rbac.PermUserRead), server.getUser)
+	authRouter.GET("/users", permissionMiddleware(rbac.PermUserRead), server.listUsers)
+	authRouter.DELETE("/users/:id", permissionMiddleware(rbac.PermUserManage), server.deleteUser)
+	authRouter.PUT("users/:id", permissionMiddleware(rbac.PermUserManage), server.updateUser)
+	authRouter.PATCH("users/password/:id", permissionMiddleware(rbac.PermUserManage), server.updateUserWithPassword)
+	authRouter.GET("/users/:id/sessions", permissionMiddleware(rbac.PermUserManage), server.listUserSessions)
+	authRouter.DELETE("/users/:id/sessions/:session_id", permissionMiddleware(rbac.PermUserManage), server.revokeUserSession)
+	authRouter.DELETE("/users/:id/sessions", permissionMiddleware(rbac.PermUserManage), server.revokeUserSessions)
+	authRouter.POST("/users/:id/unlock", permissionMiddleware(rbac.PermUserManage), server.unlockUser)
+
+	// Auth session routes
+	authRouter.POST("/users/logout", permissionMiddleware(rbac.PermProfileUpdate), server.logout)
+	authRouter.GET("/sessions", permissionMiddleware(rbac.PermProfileUpdate), server.listMySessions)
+	authRouter.DELETE("/sessions/:id", permissionMiddleware(rbac.PermProfileUpdate), server.revokeMySession)
+	authRouter.DELETE("/sessions", permissionMiddleware(rbac.PermProfileUpdate), server.revokeAllMySessions)
 
 	// Auth customer routes
-	authRouter.GET("/customers", server.listCustomer)
-	authRouter.DELETE("/customers/:id", server.deleteCustomer)
-	
+	authRouter.GET("/customers", permissionMiddleware(rbac.PermCustomerRead), server.listCustomer)
+	authRouter.DELETE("/customers/:id", permissionMiddleware(rbac.PermCustomerManage), server.deleteCustomer)
+
 	//Auth Category routes
-	authRouter.POST("/categories", server.createCategory)
-	authRouter.GET("/categories/:id", server.getCategory)
-	authRouter.GET("/categories", server.listCategory)
-	authRouter.DELETE("/categories/:id", server.deleteCategory)
-	authRouter.PATCH("/categories/:id", server.updateCategory)
+	authRouter.POST("/categories", permissionMiddleware(rbac.PermMenuManage), server.createCategory)
+	authRouter.GET("/categories/:id", permissionMiddleware(rbac.PermMenuRead), server.getCategory)
+	authRouter.GET("/categories", permissionMiddleware(rbac.PermMenuRead), server.listCategory)
+	authRouter.DELETE("/categories/:id", permissionMiddleware(rbac.PermMenuManage), server.deleteCategory)
+	authRouter.PATCH("/categories/:id", permissionMiddleware(rbac.PermMenuManage), server.updateCategory)
+	authRouter.GET("/categories/:id/schedule", permissionMiddleware(rbac.PermMenuRead), server.getCategorySchedule)
+	authRouter.PUT("/categories/:id/schedule", permissionMiddleware(rbac.PermMenuManage), server.replaceCategorySchedule)
 
 	//Auth Menu routes
-	authRouter.POST("/menus", server.createMenu)
-	authRouter.GET("/menus/:id", server.getMenu)
-	authRouter.GET("/menus", server.listMenu)
-	authRouter.DELETE("/menus/:id", server.deleteMenu)
-	authRouter.PATCH("/menus/:id", server.updateMenu)
+	authRouter.POST("/menus", permissionMiddleware(rbac.PermMenuManage), server.createMenu)
+	authRouter.GET("/menus/:id", permissionMiddleware(rbac.PermMenuRead), server.getMenu)
+	authRouter.GET("/menus", permissionMiddleware(rbac.PermMenuRead), server.listMenu)
+	authRouter.GET("/menus/available", permissionMiddleware(rbac.PermMenuRead), server.listAvailableMenus)
+	authRouter.DELETE("/menus/:id", permissionMiddleware(rbac.PermMenuManage), server.deleteMenu)
+	authRouter.PATCH("/menus/:id", permissionMiddleware(rbac.PermMenuManage), server.updateMenu)
+	authRouter.POST("/menus/:id/option_groups", permissionMiddleware(rbac.PermMenuManage), server.createOptionGroup)
+	authRouter.GET("/menus/:id/option_groups", permissionMiddleware(rbac.PermMenuRead), server.listOptionGroups)
+	authRouter.DELETE("/option_groups/:id", permissionMiddleware(rbac.PermMenuManage), server.deleteOptionGroup)
+	authRouter.POST("/option_groups/:id/options", permissionMiddleware(rbac.PermMenuManage), server.createMenuOption)
+	authRouter.DELETE("/menu_options/:id", permissionMiddleware(rbac.PermMenuManage), server.deleteMenuOption)
+	authRouter.POST("/menus/:id/variants", permissionMiddleware(rbac.PermMenuManage), server.createMenuVariant)
+	authRouter.GET("/menus/:id/variants", permissionMiddleware(rbac.PermMenuRead), server.listMenuVariants)
+	authRouter.PATCH("/menu_variants/:id", permissionMiddleware(rbac.PermMenuManage), server.updateMenuVariant)
+	authRouter.DELETE("/menu_variants/:id", permissionMiddleware(rbac.PermMenuManage), server.deleteMenuVariant)
+	authRouter.GET("/menus/:id/schedule", permissionMiddleware(rbac.PermMenuRead), server.getMenuSchedule)
+	authRouter.PUT("/menus/:id/schedule", permissionMiddleware(rbac.PermMenuManage), server.replaceMenuSchedule)
 
 	// Auth Table routes
-	authRouter.POST("/tables", server.createTable)
-	authRouter.GET("/tables/:id", server.getTable)
-	authRouter.GET("/tables", server.listTables)
-	authRouter.PATCH("/tables/:id", server.updateTableStatus)
-	authRouter.DELETE("/tables/:id", server.deleteTable)
+	authRouter.POST("/tables", permissionMiddleware(rbac.PermTableManage), server.createTable)
+	authRouter.GET("/tables/:id", permissionMiddleware(rbac.PermTableRead), server.getTable)
+	authRouter.GET("/tables", permissionMiddleware(rbac.PermTableRead), server.listTables)
+	authRouter.PATCH("/tables/:id", permissionMiddleware(rbac.PermTableStatus), server.updateTableStatus)
+	authRouter.DELETE("/tables/:id", permissionMiddleware(rbac.PermTableManage), server.deleteTable)
+	authRouter.POST("/tables/:id/qr/rotate", permissionMiddleware(rbac.PermTableManage), server.rotateTableQR)
+	authRouter.GET("/tables/qr/export", permissionMiddleware(rbac.PermTableManage), server.exportTableQR)
 
 	// Auth Order routes
-	authRouter.POST("/orders", server.createOrder)
-	authRouter.GET("/orders/:id", server.getOrder)
-	authRouter.GET("/orders", server.listOrders)
-	authRouter.DELETE("/orders/:id", server.deleteOrder)
-	authRouter.PUT("/orders/:id", server.updateOrder)
-	authRouter.PATCH("/orders/status/:id", server.updateOrderStatus)
+	authRouter.POST("/orders", permissionMiddleware(rbac.PermOrderWrite), server.createOrder)
+	authRouter.GET("/orders/:id", permissionMiddleware(rbac.PermOrderRead), server.getOrder)
+	authRouter.GET("/orders", permissionMiddleware(rbac.PermOrderRead), server.listOrders)
+	authRouter.DELETE("/orders/:id", permissionMiddleware(rbac.PermOrderDelete), server.deleteOrder)
+	authRouter.PUT("/orders/:id", permissionMiddleware(rbac.PermOrderWrite), server.updateOrder)
+	authRouter.PATCH("/orders/status/:id", permissionMiddleware(rbac.PermOrderStatus), server.updateOrderStatus)
+	authRouter.GET("/orders/history/:id", permissionMiddleware(rbac.PermOrderRead), server.listOrderStatusHistory)
 
 	// Auth Order Item routes
-	authRouter.POST("/orderitems", server.createOrderItem)
-	authRouter.GET("/orderitems/:id", server.getOrderItem)
-	authRouter.GET("/orderitems", server.listOrderItems)
-	authRouter.DELETE("/orderitems/:id", server.deleteOrderItem)
-	authRouter.PUT("/order_items/:id", server.updateOrderItem)
+	authRouter.POST("/orderitems", permissionMiddleware(rbac.PermOrderWrite), server.createOrderItem)
+	authRouter.GET("/orderitems/:id", permissionMiddleware(rbac.PermOrderRead), server.getOrderItem)
+	authRouter.GET("/orderitems", permissionMiddleware(rbac.PermOrderRead), server.listOrderItems)
+	authRouter.DELETE("/orderitems/:id", permissionMiddleware(rbac.PermOrderDelete), server.deleteOrderItem)
+	authRouter.PUT("/order_items/:id", permissionMiddleware(rbac.PermOrderWrite), server.updateOrderItem)
+
+	// Auth Kitchen routes
+	authRouter.GET("/kitchen/items", permissionMiddleware(rbac.PermKitchen), server.listKitchenItems)
+	authRouter.PATCH("/kitchen/items/:id/status", permissionMiddleware(rbac.PermKitchen), server.updateKitchenItemStatus)
+
+	// Auth Event stream routes
+	authRouter.GET("/events/orders", permissionMiddleware(rbac.PermEventsRead), server.streamOrderEvents)
 
 	// Auth Payment routes
-	authRouter.POST("/payments", server.createPayment)
-	authRouter.GET("/payments/:id", server.getPayment)
-	authRouter.GET("/payments", server.listPayments)
-	authRouter.DELETE("/payments/:id", server.deletePayment)
-	authRouter.PATCH("/payments/status/:id", server.updatePaymentStatus)
+	authRouter.POST("/payments", permissionMiddleware(rbac.PermPaymentWrite), server.createPayment)
+	authRouter.GET("/payments/:id", permissionMiddleware(rbac.PermPaymentRead), server.getPayment)
+	authRouter.GET("/payments", permissionMiddleware(rbac.PermPaymentRead), server.listPayments)
+	authRouter.DELETE("/payments/:id", permissionMiddleware(rbac.PermPaymentDelete), server.deletePayment)
+	authRouter.PATCH("/payments/status/:id", permissionMiddleware(rbac.PermPaymentWrite), server.updatePaymentStatus)
+
+	// Auth Report routes
+	authRouter.GET("/reports/sales", permissionMiddleware(rbac.PermReportRead), server.getSalesReport)
 
 
 	server.router = router
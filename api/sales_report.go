@@ -0,0 +1,84 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/gin-gonic/gin"
+)
+
+type salesReportRequest struct {
+	From time.Time `form:"from" binding:"required"`
+	To   time.Time `form:"to" binding:"required,gtfield=From"`
+}
+
+type variantSalesResponse struct {
+	VariantID   int64        `json:"variant_id"`
+	VariantName string       `json:"variant_name"`
+	Quantity    int64        `json:"quantity"`
+	Revenue     money.Amount `json:"revenue"`
+}
+
+type menuSalesResponse struct {
+	MenuID   int64                  `json:"menu_id"`
+	MenuName string                 `json:"menu_name"`
+	Quantity int64                  `json:"quantity"`
+	Revenue  money.Amount           `json:"revenue"`
+	Variants []variantSalesResponse `json:"variants"`
+}
+
+type salesReportResponse struct {
+	From     time.Time           `json:"from"`
+	To       time.Time           `json:"to"`
+	Quantity int64               `json:"quantity"`
+	Revenue  money.Amount        `json:"revenue"`
+	Menus    []menuSalesResponse `json:"menus"`
+}
+
+func (server *Server) getSalesReport(ctx *gin.Context) {
+	var req salesReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rows, err := server.store.ListSalesByVariant(ctx, db.ListSalesByVariantParams{
+		FromTime: req.From,
+		ToTime:   req.To,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	report := salesReportResponse{
+		From:  req.From,
+		To:    req.To,
+		Menus: make([]menuSalesResponse, 0),
+	}
+	for _, menu := range db.SummarizeSales(rows) {
+		report.Quantity += menu.Quantity
+		report.Revenue = report.Revenue.Add(menu.Revenue)
+
+		menuResponse := menuSalesResponse{
+			MenuID:   menu.MenuID,
+			MenuName: menu.MenuName,
+			Quantity: menu.Quantity,
+			Revenue:  menu.Revenue,
+			Variants: make([]variantSalesResponse, 0, len(menu.Variants)),
+		}
+		for _, variant := range menu.Variants {
+			menuResponse.Variants = append(menuResponse.Variants, variantSalesResponse{
+				VariantID:   variant.VariantID.Int64,
+				VariantName: variant.VariantName,
+				Quantity:    variant.Quantity,
+				Revenue:     variant.Revenue,
+			})
+		}
+		report.Menus = append(report.Menus, menuResponse)
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
@@ -2,38 +2,95 @@ package api
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/lockout"
+	"github.com/datmaithanh/orderfood/ratelimit"
+	"github.com/datmaithanh/orderfood/revocation"
+	"github.com/datmaithanh/orderfood/storage"
 	"github.com/datmaithanh/orderfood/token"
 	"github.com/datmaithanh/orderfood/utils"
+	"github.com/datmaithanh/orderfood/worker"
 	"github.com/gin-gonic/gin"
 )
 
 type Server struct {
-	store      db.Store
-	tokenMaker token.Maker
-	router     *gin.Engine
+	config          utils.Config
+	store           db.Store
+	tokenMaker      token.Maker
+	taskDistributor worker.TaskDistributor
+	eventBus        events.Bus
+	imageStore      storage.ImageStore
+	resetLimiters   ratelimit.PasswordResetLimiters
+	tokenChecker    *revocation.Checker
+	loginGuard      *lockout.Guard
+	location        *time.Location
+	router          *gin.Engine
 }
 
-func NewServer(store db.Store) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(utils.TokenSymmetricKey)
+func NewServer(config utils.Config, store db.Store, taskDistributor worker.TaskDistributor, eventBus events.Bus, imageStore storage.ImageStore, revocations revocation.List, loginGuard *lockout.Guard, resetLimiters ratelimit.PasswordResetLimiters) (*Server, error) {
+	tokenMaker, err := token.NewMaker(token.Config{
+		Type:             config.TokenType,
+		SymmetricKey:     config.TokenSymmetricKey,
+		PrivateKey:       config.TokenPrivateKey,
+		VerificationKeys: strings.Split(config.TokenVerificationKeys, ","),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token: %w", err)
 	}
+	location, err := config.Location()
+	if err != nil {
+		return nil, err
+	}
 	server := &Server{
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:          config,
+		store:           store,
+		tokenMaker:      tokenMaker,
+		taskDistributor: taskDistributor,
+		eventBus:        eventBus,
+		imageStore:      imageStore,
+		resetLimiters:   resetLimiters,
+		tokenChecker:    newTokenChecker(config, store, revocations),
+		loginGuard:      loginGuard,
+		location:        location,
 	}
 
 	server.setupRouter()
+	err = server.router.SetTrustedProxies(config.TrustedProxyList())
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted_proxies: %w", err)
+	}
 
 	return server, nil
 }
 
+// now returns the current time in the restaurant's timezone.
+func (server *Server) now() time.Time {
+	return time.Now().In(server.location)
+}
+
 func (server *Server) Start(address string) error {
 	return server.router.Run(address)
 }
 
+func (server *Server) Handler() http.Handler {
+	return server.router
+}
+
+// newTokenChecker enables the token version check only when configured, since
+// it costs a database lookup per request.
+func newTokenChecker(config utils.Config, store db.Store, revocations revocation.List) *revocation.Checker {
+	var versions revocation.VersionStore
+	if config.CheckTokenVersion {
+		versions = store
+	}
+	return revocation.NewChecker(revocations, versions)
+}
+
 func errorResponse(err error) gin.H {
 	return gin.H{"error": err.Error()}
 }
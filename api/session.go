@@ -0,0 +1,248 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type sessionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    int64     `json:"user_id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIP  string    `json:"client_ip"`
+	IsBlocked bool      `json:"is_blocked"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newSessionResponse(session db.Session) sessionResponse {
+	return sessionResponse{
+		ID:        session.ID,
+		UserID:    session.UserID,
+		UserAgent: session.UserAgent,
+		ClientIP:  session.ClientIp,
+		IsBlocked: session.IsBlocked,
+		ExpiresAt: session.ExpiresAt,
+		CreatedAt: session.CreatedAt,
+	}
+}
+
+func newSessionsResponse(sessions []db.Session) []sessionResponse {
+	result := make([]sessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, newSessionResponse(session))
+	}
+	return result
+}
+
+type revokeSessionsResponse struct {
+	Revoked int64 `json:"revoked"`
+}
+
+type logoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+func (server *Server) logout(ctx *gin.Context) {
+	var req logoutRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+	if refreshPayload.Username != authPayload.Username {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("refresh token belongs to another user")))
+		return
+	}
+
+	user, ok := server.sessionOwner(ctx, authPayload.Username)
+	if !ok {
+		return
+	}
+
+	if _, ok := server.revokeSession(ctx, user.ID, refreshPayload.ID); !ok {
+		return
+	}
+
+	err = server.tokenChecker.Revoke(ctx, authPayload)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "logged out"})
+}
+
+func (server *Server) listMySessions(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	user, ok := server.sessionOwner(ctx, authPayload.Username)
+	if !ok {
+		return
+	}
+
+	sessions, err := server.store.ListUserSessions(ctx, user.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSessionsResponse(sessions))
+}
+
+type revokeSessionRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+func (server *Server) revokeMySession(ctx *gin.Context) {
+	var req revokeSessionRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	user, ok := server.sessionOwner(ctx, authPayload.Username)
+	if !ok {
+		return
+	}
+
+	session, ok := server.revokeSession(ctx, user.ID, uuid.MustParse(req.ID))
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSessionResponse(session))
+}
+
+func (server *Server) revokeAllMySessions(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	user, ok := server.sessionOwner(ctx, authPayload.Username)
+	if !ok {
+		return
+	}
+
+	revoked, ok := server.revokeAllSessions(ctx, user.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revokeSessionsResponse{Revoked: revoked})
+}
+
+type userSessionsRequest struct {
+	UserID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) listUserSessions(ctx *gin.Context) {
+	var req userSessionsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	sessions, err := server.store.ListUserSessions(ctx, req.UserID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSessionsResponse(sessions))
+}
+
+type revokeUserSessionRequest struct {
+	UserID    int64  `uri:"id" binding:"required,min=1"`
+	SessionID string `uri:"session_id" binding:"required,uuid"`
+}
+
+func (server *Server) revokeUserSession(ctx *gin.Context) {
+	var req revokeUserSessionRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	session, ok := server.revokeSession(ctx, req.UserID, uuid.MustParse(req.SessionID))
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSessionResponse(session))
+}
+
+func (server *Server) revokeUserSessions(ctx *gin.Context) {
+	var req userSessionsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	revoked, ok := server.revokeAllSessions(ctx, req.UserID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revokeSessionsResponse{Revoked: revoked})
+}
+
+func (server *Server) sessionOwner(ctx *gin.Context, username string) (db.User, bool) {
+	user, err := server.store.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return db.User{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.User{}, false
+	}
+	return user, true
+}
+
+func (server *Server) revokeSession(ctx *gin.Context, userID int64, sessionID uuid.UUID) (db.Session, bool) {
+	session, err := server.store.BlockUserSession(ctx, db.BlockUserSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("session not found")))
+			return db.Session{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Session{}, false
+	}
+	return session, true
+}
+
+// revokeAllSessions blocks every refresh token of the user and bumps the token
+// version so that access tokens already handed out stop working too.
+func (server *Server) revokeAllSessions(ctx *gin.Context, userID int64) (int64, bool) {
+	revoked, err := server.store.BlockUserSessions(ctx, userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return 0, false
+	}
+
+	_, err = server.store.IncrementUserTokenVersion(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return 0, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return 0, false
+	}
+	return revoked, true
+}
@@ -1,17 +1,17 @@
 package api
 
 import (
+	"bytes"
+	"database/sql"
 	"fmt"
 	"net/http"
 	"time"
 
-	"github.com/cloudinary/cloudinary-go"
-	"github.com/cloudinary/cloudinary-go/api/uploader"
-
 	db "github.com/datmaithanh/orderfood/db/sqlc"
-	"github.com/datmaithanh/orderfood/utils"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/qrsheet"
+	"github.com/datmaithanh/orderfood/tableqr"
 	"github.com/gin-gonic/gin"
-	qrcode "github.com/skip2/go-qrcode"
 )
 
 func (server *Server) createTable(ctx *gin.Context) {
@@ -22,44 +22,61 @@ func (server *Server) createTable(ctx *gin.Context) {
 	}
 
 	tableName := fmt.Sprintf("Table-%d", maxTableID.(int64)+1)
-	qrText := fmt.Sprintf("%s/table/%d", utils.UrlToWebsiteOrderFood, maxTableID.(int64)+1)
-
-	filePath := fmt.Sprintf("./qrcodes/%s.png", tableName)
 
-	err = qrcode.WriteFile(qrText, qrcode.Medium, 256, filePath)
+	table, err := server.store.CreateTable(ctx, db.CreateTableParams{
+		Name: tableName,
+	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	cld, err := cloudinary.NewFromURL(utils.CLOUDINARY_URL)
+	table, err = tableqr.Generate(ctx, server.store, server.imageStore, server.config.TokenSymmetricKey, server.config.WebsiteURL, table)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Cannot connect to Cloudinary"})
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	uploadResult, err := cld.Upload.Upload(ctx, filePath, uploader.UploadParams{
-		Folder:   "orderfood_qrcode",
-		PublicID: tableName,
-	})
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Cannot upload QR to Cloudinary"})
+	ctx.JSON(http.StatusOK, table)
+}
+
+type rotateTableQRRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) rotateTableQR(ctx *gin.Context) {
+	var req rotateTableQRRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
-	qrImageURL := uploadResult.SecureURL
+	table, err := server.store.GetTable(ctx, req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
 
-	table, err := server.store.CreateTable(ctx, db.CreateTableParams{
-		Name:       tableName,
-		QrText:     qrText,
-		QrImageUrl: qrImageURL,
-	})
+	table, err = tableqr.Generate(ctx, server.store, server.imageStore, server.config.TokenSymmetricKey, server.config.WebsiteURL, table)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, table)
+	tableResponse := tableResponse{
+		ID:         table.ID,
+		Name:       table.Name,
+		QrText:     table.QrText,
+		QrImageUrl: table.QrImageUrl,
+		Status:     table.Status,
+		CreatedAt:  table.CreatedAt,
+	}
+
+	ctx.JSON(http.StatusOK, tableResponse)
 }
 
 type getTableRequest struct {
@@ -160,7 +177,7 @@ func (server *Server) updateTableStatus(ctx *gin.Context) {
 		return
 	}
 
-	table, err := server.store.UpdateTable(ctx, db.UpdateTableParams{
+	table, err := server.store.UpdateTableStatus(ctx, db.UpdateTableStatusParams{
 		ID:     reqUriID.ID,
 		Status: reqJson.Status,
 	})
@@ -168,6 +185,7 @@ func (server *Server) updateTableStatus(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	server.publishTableEvent(events.TypeTableStatusChanged, table)
 	tableResponse := tableResponse{
 		ID:         table.ID,
 		Name:       table.Name,
@@ -206,3 +224,58 @@ func (server *Server) deleteTable(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "table deleted successfully"})
 }
+
+type exportTableQRRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=pdf zip"`
+	Size   string `form:"size" binding:"omitempty,oneof=small medium large"`
+}
+
+func (server *Server) exportTableQR(ctx *gin.Context) {
+	var req exportTableQRRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Format == "" {
+		req.Format = "pdf"
+	}
+	if req.Size == "" {
+		req.Size = qrsheet.SizeMedium
+	}
+
+	tables, err := server.store.ListAllTables(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	entries := make([]qrsheet.Entry, 0, len(tables))
+	for _, table := range tables {
+		if table.QrText == "" {
+			continue
+		}
+		entries = append(entries, qrsheet.Entry{
+			Name:   table.Name,
+			QRText: table.QrText,
+		})
+	}
+
+	size, _ := qrsheet.SizeByName(req.Size)
+
+	var buf bytes.Buffer
+	var contentType string
+	if req.Format == "zip" {
+		contentType = "application/zip"
+		err = qrsheet.WriteZIP(&buf, entries, size)
+	} else {
+		contentType = "application/pdf"
+		err = qrsheet.WritePDF(&buf, entries, size, server.config.RestaurantName)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="table-qr-codes.%s"`, req.Format))
+	ctx.Data(http.StatusOK, contentType, buf.Bytes())
+}
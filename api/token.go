@@ -2,12 +2,15 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
-	"github.com/datmaithanh/orderfood/utils"
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/token"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type newAccessTokenUserRequest struct {
@@ -15,10 +18,16 @@ type newAccessTokenUserRequest struct {
 }
 
 type newAccessTokenUserResponse struct {
-	AccessToken         string    `json:"access_token"`
-	AccessTokenExpiesAt time.Time `json:"access_token_expires_at"`
+	SessionID             uuid.UUID `json:"session_id"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiesAt   time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
 }
 
+// reNewAccessToken rotates the refresh token on every call. The presented
+// token stops working, and presenting it again revokes every session that
+// descends from the same login.
 func (server *Server) reNewAccessToken(ctx *gin.Context) {
 	var req newAccessTokenUserRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -28,53 +37,96 @@ func (server *Server) reNewAccessToken(ctx *gin.Context) {
 
 	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
 		return
 	}
 
-	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	user, err := server.store.GetUserByUsername(ctx, refreshPayload.Username)
 	if err != nil {
-		if err != sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(fmt.Errorf("user not found")))
 			return
 		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-
-	if session.IsBlocked {
-		err := fmt.Errorf("session is blocked")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-		return
-	}
-
-	if session.ID != refreshPayload.ID {
-		err := fmt.Errorf("mismatched session ID")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	if refreshPayload.Version != user.TokenVersion {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(db.ErrSessionOutdated))
 		return
 	}
 
-	if session.RefreshToken != req.RefreshToken {
-		err := fmt.Errorf("mismatched refresh token")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	// New tokens carry the current role, so a demotion applies from the next
+	// refresh even when the version check is disabled.
+	refreshToken, newRefreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, user.TokenVersion, server.config.RefreshTokenDuration)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	if session.ExpiresAt.Before(time.Now()) {
-		err := fmt.Errorf("session has expired")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	result, err := server.store.RefreshSessionTx(ctx, db.RefreshSessionTxParams{
+		SessionID:    refreshPayload.ID,
+		RefreshToken: req.RefreshToken,
+		TokenVersion: user.TokenVersion,
+		NewSession: db.CreateSessionParams{
+			ID:           newRefreshPayload.ID,
+			UserID:       user.ID,
+			RefreshToken: refreshToken,
+			UserAgent:    ctx.Request.UserAgent(),
+			ClientIp:     ctx.ClientIP(),
+			ExpiresAt:    newRefreshPayload.ExpiredAt,
+		},
+	})
+	if err != nil {
+		switch {
+		case err == sql.ErrNoRows:
+			ctx.JSON(http.StatusUnauthorized, errorResponse(fmt.Errorf("session not found")))
+		case errors.Is(err, db.ErrRefreshTokenReused),
+			errors.Is(err, db.ErrRefreshTokenMismatch),
+			errors.Is(err, db.ErrSessionRevoked),
+			errors.Is(err, db.ErrSessionExpired),
+			errors.Is(err, db.ErrSessionOutdated):
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
 		return
 	}
 
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(refreshPayload.Username, refreshPayload.Role, utils.TokenDuration)
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, user.TokenVersion, server.config.AccessTokenDuration)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
 	newAccessTokenResponse := newAccessTokenUserResponse{
-		AccessToken:         accessToken,
-		AccessTokenExpiesAt: accessPayload.ExpiredAt,
+		SessionID:             result.Session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiesAt:   accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: newRefreshPayload.ExpiredAt,
 	}
 	ctx.JSON(http.StatusOK, newAccessTokenResponse)
 }
+
+type tokenKeysResponse struct {
+	Keys []token.JWK `json:"keys"`
+}
+
+// getTokenKeys publishes the public keys that access tokens are signed with,
+// so other services can verify tokens without the signing key.
+func (server *Server) getTokenKeys(ctx *gin.Context) {
+	var keys []token.PublicKey
+	if keySet, ok := server.tokenMaker.(token.KeySet); ok {
+		keys = keySet.PublicKeys()
+	}
+	if len(keys) == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("tokens are not signed with public keys")))
+		return
+	}
+
+	rsp := tokenKeysResponse{}
+	for _, key := range keys {
+		rsp.Keys = append(rsp.Keys, key.JWK())
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type refreshSessionStore struct {
+	db.Store
+	err    error
+	params []db.RefreshSessionTxParams
+}
+
+func (store *refreshSessionStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	return db.User{ID: 1, Username: username, Role: rbac.RoleManager, TokenVersion: 1}, nil
+}
+
+func (store *refreshSessionStore) RefreshSessionTx(ctx context.Context, arg db.RefreshSessionTxParams) (db.RefreshSessionTxResult, error) {
+	store.params = append(store.params, arg)
+	if store.err != nil {
+		return db.RefreshSessionTxResult{}, store.err
+	}
+	session := db.Session{
+		ID:           arg.NewSession.ID,
+		RefreshToken: arg.NewSession.RefreshToken,
+		ExpiresAt:    arg.NewSession.ExpiresAt,
+		FamilyID:     arg.SessionID,
+	}
+	return db.RefreshSessionTxResult{Session: session}, nil
+}
+
+func renewAccessToken(t *testing.T, server *Server, refreshToken string) *httptest.ResponseRecorder {
+	body, err := json.Marshal(newAccessTokenUserRequest{RefreshToken: refreshToken})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/users/token/renew_access", bytes.NewReader(body))
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestRenewAccessTokenRotatesRefreshToken(t *testing.T) {
+	store := &refreshSessionStore{}
+	server := newTestServer(t, store)
+
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 1, time.Hour)
+	require.NoError(t, err)
+
+	recorder := renewAccessToken(t, server, refreshToken)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var response newAccessTokenUserResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.NotEqual(t, refreshToken, response.RefreshToken)
+	require.NotEmpty(t, response.AccessToken)
+
+	require.Len(t, store.params, 1)
+	require.Equal(t, refreshPayload.ID, store.params[0].SessionID)
+	require.Equal(t, refreshToken, store.params[0].RefreshToken)
+	require.Equal(t, response.SessionID, store.params[0].NewSession.ID)
+	require.Equal(t, response.RefreshToken, store.params[0].NewSession.RefreshToken)
+
+	newPayload, err := server.tokenMaker.VerifyToken(response.RefreshToken)
+	require.NoError(t, err)
+	require.Equal(t, response.SessionID, newPayload.ID)
+
+	accessPayload, err := server.tokenMaker.VerifyToken(response.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, rbac.RoleManager, accessPayload.Role, "tokens carry the current role")
+}
+
+func TestRenewAccessTokenRejectsOutdatedToken(t *testing.T) {
+	store := &refreshSessionStore{}
+	server := newTestServer(t, store)
+
+	refreshToken, _, err := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 0, time.Hour)
+	require.NoError(t, err)
+
+	recorder := renewAccessToken(t, server, refreshToken)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Empty(t, store.params)
+}
+
+func TestRenewAccessTokenRejectsInvalidSession(t *testing.T) {
+	for _, err := range []error{
+		sql.ErrNoRows,
+		db.ErrRefreshTokenReused,
+		db.ErrRefreshTokenMismatch,
+		db.ErrSessionRevoked,
+		db.ErrSessionExpired,
+	} {
+		t.Run(err.Error(), func(t *testing.T) {
+			server := newTestServer(t, &refreshSessionStore{err: err})
+
+			refreshToken, _, tokenErr := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 1, time.Hour)
+			require.NoError(t, tokenErr)
+
+			recorder := renewAccessToken(t, server, refreshToken)
+			require.Equal(t, http.StatusUnauthorized, recorder.Code)
+		})
+	}
+}
+
+func TestGetTokenKeys(t *testing.T) {
+	serve := func(server *Server) *httptest.ResponseRecorder {
+		request, err := http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+		require.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+		server.router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	server := newTestServer(t, stubStore{})
+	require.Equal(t, http.StatusNotFound, serve(server).Code)
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	server.tokenMaker, err = token.NewPublicPasetoMaker(privateKey)
+	require.NoError(t, err)
+
+	recorder := serve(server)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var rsp tokenKeysResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+	require.Len(t, rsp.Keys, 1)
+	require.Equal(t, token.PaserkID(privateKey.Public().(ed25519.PublicKey)), rsp.Keys[0].KeyID)
+	require.Equal(t, "OKP", rsp.Keys[0].KeyType)
+}
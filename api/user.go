@@ -2,15 +2,20 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	"net/http"
 	"time"
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
 	"github.com/datmaithanh/orderfood/utils"
+	"github.com/datmaithanh/orderfood/worker"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
 )
 
+var errEmailNotVerified = errors.New("email address is not verified")
+
 type CreateUserRequest struct {
 	Username string `json:"username" binding:"required,alphanum"`
 	Password string `json:"password" binding:"required,min=6"`
@@ -19,10 +24,11 @@ type CreateUserRequest struct {
 }
 
 type UserResponse struct {
-	Username string    `json:"username"`
-	FullName string    `json:"full_name"`
-	Email    string    `json:"email"`
-	CreateAt time.Time `json:"created_at"`
+	Username        string    `json:"username"`
+	FullName        string    `json:"full_name"`
+	Email           string    `json:"email"`
+	IsEmailVerified bool      `json:"is_email_verified"`
+	CreateAt        time.Time `json:"created_at"`
 }
 
 func (server *Server) createUser(ctx *gin.Context) {
@@ -36,21 +42,36 @@ func (server *Server) createUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	user, err := server.store.CreateUser(ctx, db.CreateUserParams{
-		Username:     req.Username,
-		HashPassword: hashedPassword,
-		FullName:     req.FullName,
-		Email:        req.Email,
+	result, err := server.store.CreateUserTx(ctx, db.CreateUserTxParams{
+		CreateUserParams: db.CreateUserParams{
+			Username:     req.Username,
+			HashPassword: hashedPassword,
+			FullName:     req.FullName,
+			Email:        req.Email,
+		},
+		AfterCreate: func(user db.User) error {
+			taskPayload := &worker.PayloadSendVerifyEmail{
+				Username: user.Username,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.ProcessIn(10 * time.Second),
+				asynq.Queue(worker.QueueCritical),
+			}
+			return server.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
+		},
 	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	user := result.User
 	userResponse := UserResponse{
-		Username: user.Username,
-		FullName: user.FullName,
-		Email:    user.Email,
-		CreateAt: user.CreatedAt,
+		Username:        user.Username,
+		FullName:        user.FullName,
+		Email:           user.Email,
+		IsEmailVerified: user.IsEmailVerified,
+		CreateAt:        user.CreatedAt,
 	}
 	ctx.JSON(http.StatusOK, userResponse)
 }
@@ -152,7 +173,7 @@ type updateUserIdRequest struct {
 
 type updateUserRequest struct {
 	FullName string `json:"full_name" binding:"required"`
-	Role     string `json:"role" binding:"required"`
+	Role     string `json:"role" binding:"required,oneof=admin manager cashier waiter kitchen"`
 	Email    string `json:"email" binding:"required"`
 }
 
@@ -269,9 +290,17 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
+	if !server.checkLoginAllowed(ctx, req.Username) {
+		return
+	}
+
 	user, err := server.store.GetUserByUsername(ctx, req.Username)
 	if err != nil {
-		if err != sql.ErrNoRows {
+		if err == sql.ErrNoRows {
+			if err := server.loginFailed(ctx, req.Username, nil, db.LoginAttemptUnknownUser); err != nil {
+				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+				return
+			}
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
 			return
 		}
@@ -281,16 +310,32 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	err = utils.CheckPassword(req.Password, user.HashPassword)
 	if err != nil {
+		if err := server.loginFailed(ctx, req.Username, &user, db.LoginAttemptInvalidPassword); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
 		return
 	}
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, utils.TokenDuration)
+	if err := server.loginGuard.Succeed(ctx, user.Username, ctx.ClientIP()); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if server.config.RequireVerifiedEmail && !user.IsEmailVerified {
+		if err := server.recordLoginAttempt(ctx, req.Username, &user, db.LoginAttemptEmailNotVerified); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusForbidden, errorResponse(errEmailNotVerified))
+		return
+	}
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, user.TokenVersion, server.config.AccessTokenDuration)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, utils.RefreshTokenDuration)
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, user.TokenVersion, server.config.RefreshTokenDuration)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -304,17 +349,24 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		ClientIp:     ctx.ClientIP(),
 		IsBlocked:    false,
 		ExpiresAt:    refreshPayload.ExpiredAt,
+		FamilyID:     refreshPayload.ID,
 	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	if err := server.recordLoginAttempt(ctx, req.Username, &user, db.LoginAttemptSucceeded); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
 	UserResponse := UserResponse{
-		Username: user.Username,
-		FullName: user.FullName,
-		Email:    user.Email,
-		CreateAt: user.CreatedAt,
+		Username:        user.Username,
+		FullName:        user.FullName,
+		Email:           user.Email,
+		IsEmailVerified: user.IsEmailVerified,
+		CreateAt:        user.CreatedAt,
 	}
 	loginUserResponse := loginUserResponse{
 		SessionID:            session.ID,
@@ -326,3 +378,35 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, loginUserResponse)
 }
+
+type verifyEmailRequest struct {
+	EmailID    int64  `form:"email_id" binding:"required,min=1"`
+	SecretCode string `form:"secret_code" binding:"required,min=32,max=128"`
+}
+
+type verifyEmailResponse struct {
+	IsVerified bool `json:"is_verified"`
+}
+
+func (server *Server) verifyEmail(ctx *gin.Context) {
+	var req verifyEmailRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.VerifyEmailTx(ctx, db.VerifyEmailTxParams{
+		EmailID:        req.EmailID,
+		SecretCodeHash: utils.HashSecretCode(req.SecretCode),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("verification link is invalid or has expired")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, verifyEmailResponse{IsVerified: result.User.IsEmailVerified})
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/val"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+func registerValidators() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterValidation("price", validPrice)
+	}
+}
+
+var validPrice validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	price, ok := fieldLevel.Field().Interface().(money.Amount)
+	return ok && val.ValidatePrice(price) == nil
+}
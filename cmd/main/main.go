@@ -4,21 +4,36 @@ import (
 	"context"
 	"crypto/tls"
 	"database/sql"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/datmaithanh/orderfood/api"
 	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
 	"github.com/datmaithanh/orderfood/gapi"
+	"github.com/datmaithanh/orderfood/lockout"
+	"github.com/datmaithanh/orderfood/mailer"
 	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/ratelimit"
+	"github.com/datmaithanh/orderfood/redact"
+	"github.com/datmaithanh/orderfood/revocation"
+	"github.com/datmaithanh/orderfood/storage"
 	"github.com/datmaithanh/orderfood/utils"
 	"github.com/datmaithanh/orderfood/worker"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hibiken/asynq"
 	_ "github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -26,8 +41,16 @@ import (
 
 func main() {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
-	utils.LoadConfig()
-	conn, err := sql.Open(utils.DBDriver, utils.DBSource)
+	config, err := utils.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load config")
+	}
+	if err := config.Validate(); err != nil {
+		log.Fatal().Err(err).Msg("invalid config")
+	}
+	log.Logger = log.Output(redact.NewWriter(zerolog.ConsoleWriter{Out: os.Stderr}, config.Secrets()...))
+
+	conn, err := sql.Open(config.DBDriver, config.DBSource)
 	if err != nil {
 		log.Fatal().Msgf("cannot connect to db: %s", err)
 	}
@@ -35,54 +58,139 @@ func main() {
 	store := db.NewStore(conn)
 
 	redisOpt := asynq.RedisClientOpt{
-		Addr:    utils.Redis_Addr,
-		Password: utils.Redis_Password,
+		Addr:     config.RedisAddress,
+		Password: config.RedisPassword,
 		TLSConfig: &tls.Config{
 		},
 	}
 
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
-	go runTaskProcessor(redisOpt, store)
-	go runGatewayServer(store, taskDistributor)
-	runGrpcServer(store, taskDistributor)
+	eventBus := events.NewMemoryBus(1024)
+	imageStore := newImageStore(config)
+	revocations := newRevocationList(config, redisOpt)
+	loginGuard := newLoginGuard(config, redisOpt)
+	resetLimiters := newPasswordResetLimiters(config, redisOpt)
+
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	waitGroup, ctx := errgroup.WithContext(ctx)
+
+	runTaskProcessor(ctx, waitGroup, config, redisOpt, store, newMailer(config))
+	runTaskScheduler(ctx, waitGroup, config, redisOpt)
+	runGinServer(ctx, waitGroup, config, store, taskDistributor, eventBus, imageStore, revocations, loginGuard, resetLimiters)
+	runGatewayServer(ctx, waitGroup, config, store, taskDistributor, eventBus, imageStore, revocations, loginGuard, resetLimiters)
+	runGrpcServer(ctx, waitGroup, config, store, taskDistributor, eventBus, imageStore, revocations, loginGuard, resetLimiters)
+
+	// Closing the bus ends SSE and WatchOrders streams so that the servers
+	// can drain instead of waiting on long-lived connections.
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		eventBus.Close()
+		return nil
+	})
+
+	err = waitGroup.Wait()
+	if err != nil {
+		log.Fatal().Err(err).Msg("error from wait group")
+	}
+	log.Info().Msg("all servers stopped")
+}
+
+var interruptSignals = []os.Signal{
+	os.Interrupt,
+	syscall.SIGTERM,
+}
+
+func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, redisOpt asynq.RedisClientOpt, store db.Store, sender mailer.Sender) {
+	processor := worker.NewRedisTaskProcessor(config, redisOpt, store, sender)
+
+	waitGroup.Go(func() error {
+		log.Info().Msg("start task processor")
+		err := processor.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start task processor: %w", err)
+		}
+
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown task processor")
+		processor.Shutdown()
+		log.Info().Msg("task processor is stopped")
+		return nil
+	})
 }
 
-func runTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) {
-	processor := worker.NewRedisTaskProcessor(redisOpt, store)
-	log.Info().Msg("start task processor")
-	err := processor.Start()
+func runTaskScheduler(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, redisOpt asynq.RedisClientOpt) {
+	scheduler, err := worker.NewRedisTaskScheduler(config, redisOpt)
 	if err != nil {
-		log.Fatal().Err(err).Msg("failed to start task processor")
+		log.Fatal().Msgf("Cannot create task scheduler: %s", err)
 	}
 
+	waitGroup.Go(func() error {
+		log.Info().Msg("start task scheduler")
+		err := scheduler.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start task scheduler: %w", err)
+		}
+
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown task scheduler")
+		scheduler.Shutdown()
+		log.Info().Msg("task scheduler is stopped")
+		return nil
+	})
 }
 
-func runGrpcServer(store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(store, taskDistributor)
+func runGrpcServer(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, store db.Store, taskDistributor worker.TaskDistributor, eventBus events.Bus, imageStore storage.ImageStore, revocations revocation.List, loginGuard *lockout.Guard, resetLimiters ratelimit.PasswordResetLimiters) {
+	server, err := gapi.NewServer(config, store, taskDistributor, eventBus, imageStore, revocations, loginGuard, resetLimiters)
 	if err != nil {
 		log.Fatal().Msgf("Cannot create grpc server: %s", err)
 	}
 
-	grpcLogger := grpc.UnaryInterceptor(gapi.GrpcLoger)
+	grpcLogger := grpc.ChainUnaryInterceptor(gapi.GrpcLoger, server.AuthorizationInterceptor)
+	grpcStreamLogger := grpc.ChainStreamInterceptor(gapi.GrpcStreamLoger, server.AuthorizationStreamInterceptor)
 
-	grpcServer := grpc.NewServer(grpcLogger)
+	grpcServer := grpc.NewServer(grpcLogger, grpcStreamLogger)
 	pb.RegisterOrderFoodServiceServer(grpcServer, server)
 	reflection.Register(grpcServer)
 
-	listener, err := net.Listen("tcp", utils.GrpcServerAddress)
-	if err != nil {
-		log.Fatal().Msgf("Cannot create listener: %s", err)
-	}
+	waitGroup.Go(func() error {
+		listener, err := net.Listen("tcp", config.GRPCServerAddress)
+		if err != nil {
+			return fmt.Errorf("cannot create gRPC listener: %w", err)
+		}
 
-	log.Printf("start gRPC server at %s", listener.Addr().String())
-	err = grpcServer.Serve(listener)
-	if err != nil {
-		log.Fatal().Msgf("Cannot start gRPC server: %s", err)
-	}
+		log.Info().Msgf("start gRPC server at %s", listener.Addr().String())
+		err = grpcServer.Serve(listener)
+		if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			return fmt.Errorf("gRPC server failed to serve: %w", err)
+		}
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msg("graceful shutdown gRPC server")
+
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(config.ShutdownTimeout):
+			log.Warn().Msg("gRPC server did not drain in time, forcing stop")
+			grpcServer.Stop()
+		}
+		log.Info().Msg("gRPC server is stopped")
+		return nil
+	})
 }
 
-func runGatewayServer(store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(store, taskDistributor)
+func runGatewayServer(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, store db.Store, taskDistributor worker.TaskDistributor, eventBus events.Bus, imageStore storage.ImageStore, revocations revocation.List, loginGuard *lockout.Guard, resetLimiters ratelimit.PasswordResetLimiters) {
+	server, err := gapi.NewServer(config, store, taskDistributor, eventBus, imageStore, revocations, loginGuard, resetLimiters)
 	if err != nil {
 		log.Fatal().Msgf("Cannot create HTTP gateway server: %s", err)
 	}
@@ -96,8 +204,6 @@ func runGatewayServer(store db.Store, taskDistributor worker.TaskDistributor) {
 	})
 
 	grpcMux := runtime.NewServeMux(jsonOption)
-	ctx, called := context.WithCancel(context.Background())
-	defer called()
 	err = pb.RegisterOrderFoodServiceHandlerServer(ctx, grpcMux, server)
 	if err != nil {
 		log.Fatal().Msgf("Cannot register gateway server: %s", err)
@@ -106,26 +212,142 @@ func runGatewayServer(store db.Store, taskDistributor worker.TaskDistributor) {
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
 
-	listener, err := net.Listen("tcp", utils.ServerAddress)
-	if err != nil {
-		log.Fatal().Msgf("Cannot create listener: %s", err)
+	httpServer := &http.Server{
+		Addr:    config.HTTPServerAddress,
+		Handler: gapi.HTTPLoger(mux),
 	}
+	runHTTPServer(ctx, waitGroup, "HTTP gateway server", httpServer, config.ShutdownTimeout)
+}
 
-	log.Printf("start HTTP gateway server at %s", listener.Addr().String())
-	handler := gapi.HTTPLoger(mux)
-	err = http.Serve(listener, handler)
+func runGinServer(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, store db.Store, taskDistributor worker.TaskDistributor, eventBus events.Bus, imageStore storage.ImageStore, revocations revocation.List, loginGuard *lockout.Guard, resetLimiters ratelimit.PasswordResetLimiters) {
+	server, err := api.NewServer(config, store, taskDistributor, eventBus, imageStore, revocations, loginGuard, resetLimiters)
 	if err != nil {
-		log.Fatal().Msgf("Cannot start HTTP gateway server: %s", err)
+		log.Fatal().Msgf("Cannot create Gin server: %s", err)
+	}
+
+	httpServer := &http.Server{
+		Addr:    config.GinServerAddress,
+		Handler: server.Handler(),
 	}
+	runHTTPServer(ctx, waitGroup, "Gin server", httpServer, config.ShutdownTimeout)
 }
 
-func runGinServer(store db.Store) {
-	server, err := api.NewServer(store)
+// runHTTPServer serves until ctx is cancelled, then waits up to
+// shutdownTimeout for in-flight requests before closing connections.
+func runHTTPServer(ctx context.Context, waitGroup *errgroup.Group, name string, httpServer *http.Server, shutdownTimeout time.Duration) {
+	waitGroup.Go(func() error {
+		log.Info().Msgf("start %s at %s", name, httpServer.Addr)
+		err := httpServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("%s failed to serve: %w", name, err)
+		}
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Info().Msgf("graceful shutdown %s", name)
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		err := httpServer.Shutdown(shutdownCtx)
+		if err != nil {
+			log.Warn().Err(err).Msgf("%s did not drain in time, closing connections", name)
+			httpServer.Close()
+		}
+		log.Info().Msgf("%s is stopped", name)
+		return nil
+	})
+}
+
+// newMailer falls back to an in-memory sender when SMTP is not configured, so
+// verification emails are dropped rather than failing every task. Config
+// validation allows this only in development.
+func newMailer(config utils.Config) mailer.Sender {
+	if config.SMTPAddress == "" {
+		log.Warn().Msg("SMTP is not configured, emails will not be delivered")
+		return mailer.NewFakeSender()
+	}
+
+	sender, err := mailer.NewSMTPSender(config.SMTPAddress, config.SMTPUsername, config.SMTPPassword, config.EmailSenderName, config.EmailSenderAddress)
 	if err != nil {
-		log.Fatal().Msgf("Cannot run server: %s", err)
+		log.Fatal().Msgf("Cannot create mailer: %s", err)
+	}
+	return sender
+}
+
+// newRevocationList shares one list between the Gin, gateway and gRPC servers
+// so that a token revoked through one of them is rejected by all.
+func newRevocationList(config utils.Config, redisOpt asynq.RedisClientOpt) revocation.List {
+	switch config.TokenRevocationStore {
+	case "memory":
+		return revocation.NewMemoryList()
+	case "redis":
+		return revocation.NewRedisList(redisOpt.MakeRedisClient().(redis.UniversalClient))
+	}
+	return nil
+}
+
+// newLoginGuard shares failed login counts between the Gin, gateway and gRPC
+// servers so that spreading attempts across them does not help an attacker.
+func newLoginGuard(config utils.Config, redisOpt asynq.RedisClientOpt) *lockout.Guard {
+	var store lockout.Store = lockout.NewMemoryStore()
+	if config.LoginAttemptStore == "redis" {
+		store = lockout.NewRedisStore(redisOpt.MakeRedisClient().(redis.UniversalClient))
 	}
-	err = server.Start(utils.ServerAddress)
+
+	return lockout.NewGuard(store, lockout.Policy{
+		DelayAfter:   config.LoginUserDelayAfter,
+		BaseDelay:    config.LoginBaseDelay,
+		MaxDelay:     config.LoginMaxDelay,
+		MaxFailures:  config.LoginUserMaxFailures,
+		LockDuration: config.LoginLockoutDuration,
+		Window:       config.LoginFailureWindow,
+	}, lockout.Policy{
+		DelayAfter:   config.LoginIPDelayAfter,
+		BaseDelay:    config.LoginBaseDelay,
+		MaxDelay:     config.LoginMaxDelay,
+		MaxFailures:  config.LoginIPMaxFailures,
+		LockDuration: config.LoginLockoutDuration,
+		Window:       config.LoginFailureWindow,
+	})
+}
+
+// newPasswordResetLimiters shares one pair of limiters between the Gin, gateway
+// and gRPC servers so that each of them does not grant the full quota again.
+func newPasswordResetLimiters(config utils.Config, redisOpt asynq.RedisClientOpt) ratelimit.PasswordResetLimiters {
+	if config.PasswordResetLimitStore == "redis" {
+		client := redisOpt.MakeRedisClient().(redis.UniversalClient)
+		return ratelimit.PasswordResetLimiters{
+			Email: ratelimit.NewRedisLimiter(client, "password_reset_email", config.PasswordResetEmailLimit, config.PasswordResetWindow),
+			IP:    ratelimit.NewRedisLimiter(client, "password_reset_ip", config.PasswordResetIPLimit, config.PasswordResetWindow),
+		}
+	}
+
+	return ratelimit.PasswordResetLimiters{
+		Email: ratelimit.NewMemoryLimiter(config.PasswordResetEmailLimit, config.PasswordResetWindow),
+		IP:    ratelimit.NewMemoryLimiter(config.PasswordResetIPLimit, config.PasswordResetWindow),
+	}
+}
+
+func newImageStore(config utils.Config) storage.ImageStore {
+	imageStore, err := storage.NewImageStore(storage.Config{
+		Type:          config.ImageStoreType,
+		CloudinaryURL: config.CloudinaryURL,
+		LocalDir:      config.LocalImageDir,
+		LocalBaseURL:  config.LocalImageBaseURL,
+		S3: storage.S3Config{
+			Endpoint:        config.S3Endpoint,
+			Region:          config.S3Region,
+			Bucket:          config.S3Bucket,
+			AccessKeyID:     config.S3AccessKeyID,
+			SecretAccessKey: config.S3SecretAccessKey,
+			PublicURL:       config.S3PublicURL,
+		},
+	})
 	if err != nil {
-		log.Fatal().Msgf("cannot start server: %s", err)
+		log.Fatal().Msgf("Cannot create image store: %s", err)
 	}
+	return imageStore
 }
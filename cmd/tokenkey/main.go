@@ -0,0 +1,25 @@
+// Command tokenkey generates an Ed25519 key pair for the paseto-public and
+// jwt-public token types. Set the secret key as TOKEN_PRIVATE_KEY; when
+// rotating, append the public key of the previous pair to
+// TOKEN_VERIFICATION_KEYS.
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"log"
+
+	"github.com/datmaithanh/orderfood/token"
+)
+
+func main() {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("TOKEN_PRIVATE_KEY=" + token.PaserkSecret(privateKey))
+	fmt.Println("public key:", token.PaserkPublic(publicKey))
+	fmt.Println("key id:", token.PaserkID(publicKey))
+}
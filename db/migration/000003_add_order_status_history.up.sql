@@ -0,0 +1,15 @@
+ALTER TABLE "orders" ADD CONSTRAINT "orders_status_check"
+  CHECK ("status" IN ('pending', 'confirmed', 'preparing', 'served', 'paid', 'cancelled'));
+
+CREATE TABLE "order_status_history" (
+  "id" bigserial PRIMARY KEY,
+  "order_id" bigint NOT NULL,
+  "from_status" varchar(20) NOT NULL,
+  "to_status" varchar(20) NOT NULL,
+  "changed_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "order_status_history" ("order_id");
+
+ALTER TABLE "order_status_history" ADD FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS order_item_status_created_at_idx;
+
+ALTER TABLE "order_item" DROP CONSTRAINT IF EXISTS "order_item_status_check";
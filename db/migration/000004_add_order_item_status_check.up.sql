@@ -0,0 +1,4 @@
+ALTER TABLE "order_item" ADD CONSTRAINT "order_item_status_check"
+  CHECK ("status" IN ('pending', 'cooking', 'ready', 'served'));
+
+CREATE INDEX ON "order_item" ("status", "created_at");
@@ -0,0 +1,3 @@
+ALTER TABLE "orders" ALTER COLUMN "customer_id" SET NOT NULL;
+
+ALTER TABLE "orders" ALTER COLUMN "user_id" SET NOT NULL;
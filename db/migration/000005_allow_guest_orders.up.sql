@@ -0,0 +1,3 @@
+ALTER TABLE "orders" ALTER COLUMN "user_id" DROP NOT NULL;
+
+ALTER TABLE "orders" ALTER COLUMN "customer_id" DROP NOT NULL;
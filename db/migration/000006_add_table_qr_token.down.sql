@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS tables_qr_token_idx;
+
+ALTER TABLE "tables" DROP COLUMN IF EXISTS "qr_token";
@@ -0,0 +1,3 @@
+ALTER TABLE "tables" ADD COLUMN "qr_token" varchar(255) NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX ON "tables" ("qr_token") WHERE "qr_token" <> '';
@@ -0,0 +1,5 @@
+ALTER TABLE "users" DROP CONSTRAINT IF EXISTS "users_role_check";
+
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'staff';
+
+UPDATE "users" SET "role" = 'staff' WHERE "role" <> 'admin';
@@ -0,0 +1,7 @@
+UPDATE "users" SET "role" = 'waiter'
+WHERE "role" NOT IN ('admin', 'manager', 'cashier', 'waiter', 'kitchen');
+
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'waiter';
+
+ALTER TABLE "users" ADD CONSTRAINT "users_role_check"
+  CHECK ("role" IN ('admin', 'manager', 'cashier', 'waiter', 'kitchen'));
@@ -0,0 +1,25 @@
+ALTER TABLE "sessions" ADD COLUMN "family_id" uuid;
+ALTER TABLE "sessions" ADD COLUMN "parent_id" uuid;
+ALTER TABLE "sessions" ADD COLUMN "rotated_at" timestamptz;
+
+UPDATE "sessions" SET "family_id" = "id";
+ALTER TABLE "sessions" ALTER COLUMN "family_id" SET NOT NULL;
+
+ALTER TABLE "sessions" ADD FOREIGN KEY ("parent_id") REFERENCES "sessions" ("id") ON DELETE SET NULL;
+
+CREATE INDEX ON "sessions" ("family_id");
+CREATE INDEX ON "sessions" ("user_id");
+
+CREATE TABLE "security_events" (
+  "id" bigserial PRIMARY KEY,
+  "user_id" bigint NOT NULL,
+  "event_type" varchar NOT NULL,
+  "session_id" uuid,
+  "client_ip" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "security_events" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "security_events" ("user_id");
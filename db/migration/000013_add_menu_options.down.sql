@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS order_item_options;
+
+DROP TABLE IF EXISTS menu_options;
+
+DROP TABLE IF EXISTS option_groups;
+
+ALTER TABLE "order_item" DROP COLUMN IF EXISTS "options_price";
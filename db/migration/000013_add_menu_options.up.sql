@@ -0,0 +1,44 @@
+CREATE TABLE "option_groups" (
+  "id" bigserial PRIMARY KEY,
+  "menu_id" bigint NOT NULL,
+  "name" varchar NOT NULL,
+  "min_selections" int NOT NULL DEFAULT 0,
+  "max_selections" int NOT NULL DEFAULT 1,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "option_groups_selections_check"
+    CHECK ("min_selections" >= 0 AND "max_selections" >= 1 AND "max_selections" >= "min_selections")
+);
+
+CREATE TABLE "menu_options" (
+  "id" bigserial PRIMARY KEY,
+  "option_group_id" bigint NOT NULL,
+  "name" varchar NOT NULL,
+  "price" numeric(10,2) NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "order_item_options" (
+  "id" bigserial PRIMARY KEY,
+  "order_item_id" bigint NOT NULL,
+  "menu_option_id" bigint,
+  "option_group_name" varchar NOT NULL,
+  "option_name" varchar NOT NULL,
+  "price" numeric(10,2) NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "order_item" ADD COLUMN "options_price" numeric(10,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE "option_groups" ADD FOREIGN KEY ("menu_id") REFERENCES "menus" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "menu_options" ADD FOREIGN KEY ("option_group_id") REFERENCES "option_groups" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "order_item_options" ADD FOREIGN KEY ("order_item_id") REFERENCES "order_item" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "order_item_options" ADD FOREIGN KEY ("menu_option_id") REFERENCES "menu_options" ("id") ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX ON "option_groups" ("menu_id", "name");
+
+CREATE UNIQUE INDEX ON "menu_options" ("option_group_id", "name");
+
+CREATE INDEX ON "order_item_options" ("order_item_id");
@@ -0,0 +1,5 @@
+ALTER TABLE "order_item" DROP COLUMN IF EXISTS "variant_name";
+
+ALTER TABLE "order_item" DROP COLUMN IF EXISTS "variant_id";
+
+DROP TABLE IF EXISTS menu_variants;
@@ -0,0 +1,20 @@
+CREATE TABLE "menu_variants" (
+  "id" bigserial PRIMARY KEY,
+  "menu_id" bigint NOT NULL,
+  "name" varchar NOT NULL,
+  "price" numeric(10,2) NOT NULL,
+  "available" bool NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "order_item" ADD COLUMN "variant_id" bigint;
+
+ALTER TABLE "order_item" ADD COLUMN "variant_name" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "menu_variants" ADD FOREIGN KEY ("menu_id") REFERENCES "menus" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "order_item" ADD FOREIGN KEY ("variant_id") REFERENCES "menu_variants" ("id") ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX ON "menu_variants" ("menu_id", "name");
+
+CREATE INDEX ON "order_item" ("variant_id");
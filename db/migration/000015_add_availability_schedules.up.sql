@@ -0,0 +1,42 @@
+CREATE TABLE "availability_windows" (
+  "id" bigserial PRIMARY KEY,
+  "menu_id" bigint,
+  "category_id" bigint,
+  "days_of_week" int NOT NULL,
+  "start_minute" int NOT NULL,
+  "end_minute" int NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "availability_windows_target_check"
+    CHECK (("menu_id" IS NULL) <> ("category_id" IS NULL)),
+  CONSTRAINT "availability_windows_days_check"
+    CHECK ("days_of_week" BETWEEN 1 AND 127),
+  CONSTRAINT "availability_windows_minutes_check"
+    CHECK ("start_minute" BETWEEN 0 AND 1439 AND "end_minute" BETWEEN 1 AND 1440 AND "start_minute" <> "end_minute")
+);
+
+CREATE TABLE "availability_exceptions" (
+  "id" bigserial PRIMARY KEY,
+  "menu_id" bigint,
+  "category_id" bigint,
+  "exception_date" date NOT NULL,
+  "available" bool NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "availability_exceptions_target_check"
+    CHECK (("menu_id" IS NULL) <> ("category_id" IS NULL))
+);
+
+ALTER TABLE "availability_windows" ADD FOREIGN KEY ("menu_id") REFERENCES "menus" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "availability_windows" ADD FOREIGN KEY ("category_id") REFERENCES "categories" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "availability_exceptions" ADD FOREIGN KEY ("menu_id") REFERENCES "menus" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "availability_exceptions" ADD FOREIGN KEY ("category_id") REFERENCES "categories" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "availability_windows" ("menu_id");
+
+CREATE INDEX ON "availability_windows" ("category_id");
+
+CREATE UNIQUE INDEX ON "availability_exceptions" ("menu_id", "exception_date");
+
+CREATE UNIQUE INDEX ON "availability_exceptions" ("category_id", "exception_date");
@@ -0,0 +1 @@
+ALTER TABLE "menus" ALTER COLUMN "status" SET DEFAULT false;
@@ -0,0 +1,5 @@
+-- Menus could not be activated so far, so every existing one is still on the
+-- old default. New menus are orderable until they are deactivated.
+ALTER TABLE "menus" ALTER COLUMN "status" SET DEFAULT true;
+
+UPDATE "menus" SET "status" = true;
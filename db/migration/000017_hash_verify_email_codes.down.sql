@@ -0,0 +1,4 @@
+-- Digests cannot be turned back into codes, so pending links stop working.
+UPDATE "verify_emails" SET "is_used" = true WHERE "is_used" = false;
+
+ALTER TABLE "verify_emails" RENAME COLUMN "secret_code_hash" TO "secret_code";
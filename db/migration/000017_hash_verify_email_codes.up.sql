@@ -0,0 +1,6 @@
+-- Verification codes are stored as SHA-256 digests, like password reset
+-- tokens. Hashing the existing codes keeps links already sent working.
+ALTER TABLE "verify_emails" RENAME COLUMN "secret_code" TO "secret_code_hash";
+
+UPDATE "verify_emails"
+SET "secret_code_hash" = encode(sha256(convert_to("secret_code_hash", 'UTF8')), 'hex');
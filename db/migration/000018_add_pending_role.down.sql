@@ -0,0 +1,8 @@
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'waiter';
+
+UPDATE "users" SET "role" = 'waiter' WHERE "role" = 'pending';
+
+ALTER TABLE "users" DROP CONSTRAINT IF EXISTS "users_role_check";
+
+ALTER TABLE "users" ADD CONSTRAINT "users_role_check"
+  CHECK ("role" IN ('admin', 'manager', 'cashier', 'waiter', 'kitchen'));
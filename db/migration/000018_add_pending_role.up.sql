@@ -0,0 +1,8 @@
+-- Self-registered users start without any permissions until an admin assigns
+-- them a staff role.
+ALTER TABLE "users" DROP CONSTRAINT IF EXISTS "users_role_check";
+
+ALTER TABLE "users" ADD CONSTRAINT "users_role_check"
+  CHECK ("role" IN ('admin', 'manager', 'cashier', 'waiter', 'kitchen', 'pending'));
+
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'pending';
@@ -0,0 +1,42 @@
+-- name: CreateAvailabilityWindow :one
+INSERT INTO availability_windows (
+    menu_id,
+    category_id,
+    days_of_week,
+    start_minute,
+    end_minute
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListAvailabilityWindows :many
+SELECT * FROM availability_windows
+WHERE menu_id = ANY(sqlc.arg(menu_ids)::bigint[])
+   OR category_id = ANY(sqlc.arg(category_ids)::bigint[])
+ORDER BY id;
+
+-- name: DeleteAvailabilityWindows :exec
+DELETE FROM availability_windows
+WHERE menu_id = sqlc.narg(menu_id)
+   OR category_id = sqlc.narg(category_id);
+
+-- name: CreateAvailabilityException :one
+INSERT INTO availability_exceptions (
+    menu_id,
+    category_id,
+    exception_date,
+    available
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListAvailabilityExceptions :many
+SELECT * FROM availability_exceptions
+WHERE menu_id = ANY(sqlc.arg(menu_ids)::bigint[])
+   OR category_id = ANY(sqlc.arg(category_ids)::bigint[])
+ORDER BY exception_date, id;
+
+-- name: DeleteAvailabilityExceptions :exec
+DELETE FROM availability_exceptions
+WHERE menu_id = sqlc.narg(menu_id)
+   OR category_id = sqlc.narg(category_id);
@@ -0,0 +1,18 @@
+-- name: CreateLoginAttempt :one
+INSERT INTO login_attempts (
+  username,
+  user_id,
+  result,
+  client_ip,
+  user_agent
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: ListLoginAttempts :many
+SELECT * FROM login_attempts
+WHERE username = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
@@ -21,7 +21,8 @@ OFFSET $2;
 UPDATE menus
 SET name = $2,
     price = $3,
-    category_id = $4
+    category_id = $4,
+    status = $5
 WHERE id = $1
 RETURNING *;
 
@@ -31,3 +32,9 @@ WHERE id = $1;
 
 
 
+-- name: ListActiveMenu :many
+SELECT menus.*, categories.name AS category_name
+FROM menus
+JOIN categories ON categories.id = menus.category_id
+WHERE menus.status = true
+ORDER BY categories.name, menus.name;
@@ -0,0 +1,55 @@
+-- name: CreateOptionGroup :one
+INSERT INTO option_groups (
+  menu_id,
+  name,
+  min_selections,
+  max_selections
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetOptionGroup :one
+SELECT * FROM option_groups
+WHERE id = $1 LIMIT 1;
+
+-- name: GetOptionGroupForUpdate :one
+SELECT * FROM option_groups
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListOptionGroupsByMenuIDs :many
+SELECT * FROM option_groups
+WHERE menu_id = ANY(sqlc.arg(menu_ids)::bigint[])
+ORDER BY menu_id, id;
+
+-- name: DeleteOptionGroup :exec
+DELETE FROM option_groups
+WHERE id = $1;
+
+-- name: CreateMenuOption :one
+INSERT INTO menu_options (
+  option_group_id,
+  name,
+  price
+) VALUES (
+  $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetMenuOption :one
+SELECT * FROM menu_options
+WHERE id = $1 LIMIT 1;
+
+-- name: ListMenuOptionsByGroupIDs :many
+SELECT * FROM menu_options
+WHERE option_group_id = ANY(sqlc.arg(option_group_ids)::bigint[])
+ORDER BY option_group_id, id;
+
+-- name: CountMenuOptionsByGroup :one
+SELECT COUNT(*) FROM menu_options
+WHERE option_group_id = $1;
+
+-- name: DeleteMenuOption :exec
+DELETE FROM menu_options
+WHERE id = $1;
@@ -0,0 +1,36 @@
+-- name: CreateMenuVariant :one
+INSERT INTO menu_variants (
+  menu_id,
+  name,
+  price,
+  available
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetMenuVariant :one
+SELECT * FROM menu_variants
+WHERE id = $1 LIMIT 1;
+
+-- name: GetMenuVariantForShare :one
+SELECT * FROM menu_variants
+WHERE id = $1 LIMIT 1
+FOR SHARE;
+
+-- name: ListMenuVariantsByMenuIDs :many
+SELECT * FROM menu_variants
+WHERE menu_id = ANY(sqlc.arg(menu_ids)::bigint[])
+ORDER BY menu_id, price, id;
+
+-- name: UpdateMenuVariant :one
+UPDATE menu_variants
+SET name = $2,
+    price = $3,
+    available = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteMenuVariant :exec
+DELETE FROM menu_variants
+WHERE id = $1;
@@ -5,26 +5,41 @@ INSERT INTO orders (
     table_id,
     total_price
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, 0
 ) RETURNING *;
 
 -- name: GetOrder :one
 SELECT * FROM orders
 WHERE id = $1 LIMIT 1;
 
+-- name: GetOrderForUpdate :one
+SELECT * FROM orders
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
 -- name: ListOrder :many
 SELECT * FROM orders
 ORDER BY id
 LIMIT $1
 OFFSET $2;
 
+-- name: ListOpenOrdersByTable :many
+SELECT * FROM orders
+WHERE table_id = $1
+  AND status NOT IN ('paid', 'cancelled')
+ORDER BY created_at, id;
+
+-- name: GetOpenOrdersTotalByTable :one
+SELECT COALESCE(SUM(total_price), 0)::numeric(12,2) AS total
+FROM orders
+WHERE table_id = $1
+  AND status NOT IN ('paid', 'cancelled');
+
 -- name: UpdateOrder :one
 UPDATE orders
 SET user_id = $2,
     customer_id = $3,
-    table_id = $4,
-    status = $5,
-    total_price = $6
+    table_id = $4
 WHERE id = $1
 RETURNING *;
 
@@ -38,9 +53,13 @@ RETURNING *;
 DELETE FROM orders
 WHERE id = $1;
 
--- name: UpdateOrderTotalPrice :one
+-- name: UpdateOrderTotalFromItems :one
 UPDATE orders
-SET total_price = $2
-WHERE id = $1
+SET total_price = (
+    SELECT COALESCE(SUM((order_item.price + order_item.options_price) * order_item.quantity), 0)
+    FROM order_item
+    WHERE order_item.order_id = orders.id
+)
+WHERE orders.id = $1
 RETURNING *;
 
@@ -2,11 +2,20 @@
 INSERT INTO order_item (
     order_id,
     menu_id,
+    variant_id,
+    variant_name,
     quantity,
-    price
-) VALUES (
-  $1, $2, $3, $4
-) RETURNING *;
+    price,
+    options_price,
+    note_item
+)
+SELECT sqlc.arg(order_id)::bigint, menus.id, menu_variants.id, COALESCE(menu_variants.name, ''), sqlc.arg(quantity)::int,
+       COALESCE(menu_variants.price, menus.price), sqlc.arg(options_price)::numeric, sqlc.arg(note_item)::varchar
+FROM menus
+LEFT JOIN menu_variants ON menu_variants.menu_id = menus.id AND menu_variants.id = sqlc.narg(variant_id)
+WHERE menus.id = sqlc.arg(menu_id)
+  AND (sqlc.narg(variant_id)::bigint IS NULL OR menu_variants.id IS NOT NULL)
+RETURNING *;
 
 -- name: GetOrderItem :one
 SELECT * FROM order_item
@@ -20,11 +29,8 @@ OFFSET $2;
 
 -- name: UpdateOrderItem :one
 UPDATE order_item
-SET order_id = $2,
-    menu_id = $3,
-    quantity = $4,
-    note_item = $5,
-    status = $6
+SET quantity = $2,
+    note_item = $3
 WHERE id = $1
 RETURNING *;
 
@@ -32,5 +38,45 @@ RETURNING *;
 DELETE FROM order_item
 WHERE id = $1;
 
+-- name: GetOrderItemForUpdate :one
+SELECT * FROM order_item
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: UpdateOrderItemStatus :one
+UPDATE order_item
+SET status = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: CountOrderItemsNotReady :one
+SELECT COUNT(*) FROM order_item
+WHERE order_id = $1
+  AND status NOT IN ('ready', 'served');
 
+-- name: ListKitchenOrderItems :many
+SELECT order_item.id,
+       order_item.order_id,
+       order_item.menu_id,
+       order_item.variant_name,
+       order_item.quantity,
+       order_item.note_item,
+       order_item.status,
+       order_item.created_at,
+       orders.table_id,
+       menus.name AS menu_name,
+       categories.id AS category_id,
+       categories.name AS category_name
+FROM order_item
+JOIN orders ON orders.id = order_item.order_id
+JOIN menus ON menus.id = order_item.menu_id
+JOIN categories ON categories.id = menus.category_id
+WHERE order_item.status IN ('pending', 'cooking', 'ready')
+  AND orders.status IN ('confirmed', 'preparing', 'served')
+  AND (sqlc.narg(category_id)::bigint IS NULL OR categories.id = sqlc.narg(category_id))
+ORDER BY categories.name, order_item.created_at, order_item.id;
 
+-- name: ListOrderItemsByOrderIDs :many
+SELECT * FROM order_item
+WHERE order_id = ANY(sqlc.arg(order_ids)::bigint[])
+ORDER BY order_id, id;
@@ -0,0 +1,16 @@
+-- name: CreateOrderItemOption :one
+INSERT INTO order_item_options (
+  order_item_id,
+  menu_option_id,
+  option_group_name,
+  option_name,
+  price
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: ListOrderItemOptionsByOrderItemIDs :many
+SELECT * FROM order_item_options
+WHERE order_item_id = ANY(sqlc.arg(order_item_ids)::bigint[])
+ORDER BY order_item_id, id;
@@ -0,0 +1,22 @@
+-- name: CreatePasswordReset :one
+INSERT INTO password_resets (
+    user_id,
+    token_hash,
+    client_ip,
+    expired_at
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: UsePasswordReset :one
+UPDATE password_resets
+SET is_used = TRUE
+WHERE token_hash = $1
+    AND is_used = FALSE
+    AND expired_at > now()
+RETURNING *;
+
+-- name: InvalidateUserPasswordResets :exec
+UPDATE password_resets
+SET is_used = TRUE
+WHERE user_id = $1 AND is_used = FALSE;
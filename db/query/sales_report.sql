@@ -0,0 +1,15 @@
+-- name: ListSalesByVariant :many
+SELECT order_item.menu_id,
+       menus.name AS menu_name,
+       order_item.variant_id,
+       order_item.variant_name,
+       SUM(order_item.quantity)::bigint AS quantity,
+       SUM((order_item.price + order_item.options_price) * order_item.quantity)::numeric(12,2) AS revenue
+FROM order_item
+JOIN orders ON orders.id = order_item.order_id
+JOIN menus ON menus.id = order_item.menu_id
+WHERE orders.status = 'paid'
+  AND orders.created_at >= sqlc.arg(from_time)
+  AND orders.created_at < sqlc.arg(to_time)
+GROUP BY order_item.menu_id, menus.name, order_item.variant_id, order_item.variant_name
+ORDER BY menus.name, order_item.menu_id, order_item.variant_name;
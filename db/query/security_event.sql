@@ -0,0 +1,18 @@
+-- name: CreateSecurityEvent :one
+INSERT INTO security_events (
+  user_id,
+  event_type,
+  session_id,
+  client_ip,
+  user_agent
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: ListUserSecurityEvents :many
+SELECT * FROM security_events
+WHERE user_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
@@ -20,6 +20,10 @@ ORDER BY id
 LIMIT $1
 OFFSET $2;
 
+-- name: ListAllTables :many
+SELECT * FROM tables
+ORDER BY id;
+
 -- name: UpdateTable :one
 UPDATE tables
 SET name = $2,
@@ -29,6 +33,20 @@ SET name = $2,
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateTableStatus :one
+UPDATE tables
+SET status = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateTableQR :one
+UPDATE tables
+SET qr_text = $2,
+    qr_image_url = $3,
+    qr_token = $4
+WHERE id = $1
+RETURNING *;
+
 -- name: DeleteTable :exec
 DELETE FROM tables
 WHERE id = $1;
@@ -16,6 +16,24 @@ WHERE username = $1 LIMIT 1;
 SELECT * FROM users
 WHERE username = $1 LIMIT 1;
 
+-- name: GetUserByID :one
+SELECT * FROM users
+WHERE id = $1 LIMIT 1;
+
+-- name: GetUserTokenVersion :one
+SELECT token_version FROM users
+WHERE username = $1 LIMIT 1;
+
+-- name: IncrementUserTokenVersion :one
+UPDATE users
+SET token_version = token_version + 1
+WHERE id = $1
+RETURNING token_version;
+
+-- name: GetUserByEmail :one
+SELECT * FROM users
+WHERE email = $1 LIMIT 1;
+
 -- name: ListUser :many
 SELECT * FROM users
 ORDER BY id
@@ -27,17 +45,31 @@ UPDATE users
 SET 
     full_name = COALESCE(sqlc.narg(full_name), full_name),
     role = COALESCE(sqlc.narg(role), role),
-    email = COALESCE(sqlc.narg(email), email)
+    email = COALESCE(sqlc.narg(email), email),
+    is_email_verified = is_email_verified AND email = COALESCE(sqlc.narg(email), email),
+    token_version = token_version + CASE WHEN role <> COALESCE(sqlc.narg(role), role) THEN 1 ELSE 0 END
 WHERE username = $1
 RETURNING *;
 
 -- name: UpdateUserWithPassword :one
 UPDATE users
-SET hash_password = $2
+SET hash_password = $2, token_version = token_version + 1
 WHERE username = $1
 RETURNING *;
 
 
+-- name: UpdateUserPasswordByID :one
+UPDATE users
+SET hash_password = $2, token_version = token_version + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: VerifyUserEmail :one
+UPDATE users
+SET is_email_verified = TRUE
+WHERE username = $1 AND email = $2
+RETURNING *;
+
 -- name: DeleteUser :exec
 DELETE FROM users
 WHERE username = $1;
@@ -0,0 +1,188 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMenuUnavailable = errors.New("menu is not available")
+	ErrInvalidSchedule = errors.New("invalid availability schedule")
+)
+
+// MinutesPerDay bounds the start and end minutes of an availability window.
+const MinutesPerDay = 24 * 60
+
+var weekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseWindow builds a window from day names such as "mon" and times of day
+// such as "07:30". An end before the start runs past midnight and "24:00"
+// ends at midnight.
+func ParseWindow(days []string, start string, end string) (NewAvailabilityWindow, error) {
+	var window NewAvailabilityWindow
+	if len(days) == 0 {
+		return window, fmt.Errorf("%w: a window needs at least one day", ErrInvalidSchedule)
+	}
+	for _, name := range days {
+		day := indexOf(weekdayNames, strings.ToLower(name))
+		if day < 0 {
+			return window, fmt.Errorf("%w: day must be one of %s", ErrInvalidSchedule, strings.Join(weekdayNames, ", "))
+		}
+		window.DaysOfWeek |= WeekdayMask(time.Weekday(day))
+	}
+
+	var err error
+	if window.StartMinute, err = ParseClock(start); err != nil {
+		return window, err
+	}
+	if window.EndMinute, err = ParseClock(end); err != nil {
+		return window, err
+	}
+	if window.StartMinute == MinutesPerDay || window.EndMinute == 0 || window.StartMinute == window.EndMinute {
+		return window, fmt.Errorf("%w: %s-%s is not a valid window", ErrInvalidSchedule, start, end)
+	}
+	return window, nil
+}
+
+func indexOf(values []string, value string) int {
+	for i := range values {
+		if values[i] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseClock reads an "HH:MM" time of day, from "00:00" to "24:00", as
+// minutes since midnight.
+func ParseClock(value string) (int32, error) {
+	if value == "24:00" {
+		return MinutesPerDay, nil
+	}
+	clock, err := time.Parse("15:04", value)
+	if err != nil || len(value) != 5 {
+		return 0, fmt.Errorf("%w: %q is not an HH:MM time", ErrInvalidSchedule, value)
+	}
+	return int32(clock.Hour()*60 + clock.Minute()), nil
+}
+
+// FormatClock formats minutes since midnight as "HH:MM".
+func FormatClock(minute int32) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// WeekdayMask returns the days_of_week bits of the given days, with bit 0
+// standing for Sunday like time.Weekday.
+func WeekdayMask(days ...time.Weekday) int32 {
+	var mask int32
+	for _, day := range days {
+		mask |= 1 << day
+	}
+	return mask
+}
+
+// WeekdayNames lists the days set in a days_of_week mask by the names
+// ParseWindow accepts.
+func WeekdayNames(mask int32) []string {
+	days := make([]string, 0, len(weekdayNames))
+	for day, name := range weekdayNames {
+		if mask&(1<<day) != 0 {
+			days = append(days, name)
+		}
+	}
+	return days
+}
+
+// Contains reports whether the local time t falls inside the window. A window
+// whose end is not after its start runs past midnight into the next day.
+func (window AvailabilityWindow) Contains(t time.Time) bool {
+	minute := int32(t.Hour()*60 + t.Minute())
+	today := window.DaysOfWeek&(1<<t.Weekday()) != 0
+	if window.StartMinute < window.EndMinute {
+		return today && minute >= window.StartMinute && minute < window.EndMinute
+	}
+
+	yesterday := window.DaysOfWeek&(1<<((t.Weekday()+6)%7)) != 0
+	return today && minute >= window.StartMinute || yesterday && minute < window.EndMinute
+}
+
+// Schedule is the availability of one menu or category. Without windows it
+// is always available; an exception for a date overrides the windows for the
+// whole day.
+type Schedule struct {
+	Windows    []AvailabilityWindow
+	Exceptions []AvailabilityException
+}
+
+// AvailableAt reports whether the schedule allows ordering at t, which must
+// be in the restaurant's timezone.
+func (schedule Schedule) AvailableAt(t time.Time) bool {
+	year, month, day := t.Date()
+	for _, exception := range schedule.Exceptions {
+		exceptionYear, exceptionMonth, exceptionDay := exception.ExceptionDate.Date()
+		if exceptionYear == year && exceptionMonth == month && exceptionDay == day {
+			return exception.Available
+		}
+	}
+
+	if len(schedule.Windows) == 0 {
+		return true
+	}
+	for _, window := range schedule.Windows {
+		if window.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Availability holds the schedules of several menus and categories.
+type Availability struct {
+	menus      map[int64]Schedule
+	categories map[int64]Schedule
+}
+
+func NewAvailability(windows []AvailabilityWindow, exceptions []AvailabilityException) Availability {
+	availability := Availability{
+		menus:      make(map[int64]Schedule),
+		categories: make(map[int64]Schedule),
+	}
+	for _, window := range windows {
+		schedules, id := availability.target(window.MenuID.Int64, window.CategoryID.Int64)
+		schedule := schedules[id]
+		schedule.Windows = append(schedule.Windows, window)
+		schedules[id] = schedule
+	}
+	for _, exception := range exceptions {
+		schedules, id := availability.target(exception.MenuID.Int64, exception.CategoryID.Int64)
+		schedule := schedules[id]
+		schedule.Exceptions = append(schedule.Exceptions, exception)
+		schedules[id] = schedule
+	}
+	return availability
+}
+
+func (availability Availability) target(menuID int64, categoryID int64) (map[int64]Schedule, int64) {
+	if menuID != 0 {
+		return availability.menus, menuID
+	}
+	return availability.categories, categoryID
+}
+
+// MenuSchedule returns the schedule of a menu, not including its category's.
+func (availability Availability) MenuSchedule(menuID int64) Schedule {
+	return availability.menus[menuID]
+}
+
+// CategorySchedule returns the schedule of a category.
+func (availability Availability) CategorySchedule(categoryID int64) Schedule {
+	return availability.categories[categoryID]
+}
+
+// MenuAvailableAt reports whether a menu can be ordered at t, which requires
+// both its own schedule and its category's to allow it.
+func (availability Availability) MenuAvailableAt(menuID int64, categoryID int64, t time.Time) bool {
+	return availability.categories[categoryID].AvailableAt(t) && availability.menus[menuID].AvailableAt(t)
+}
@@ -0,0 +1,209 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: availability.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const createAvailabilityException = `-- name: CreateAvailabilityException :one
+INSERT INTO availability_exceptions (
+    menu_id,
+    category_id,
+    exception_date,
+    available
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING id, menu_id, category_id, exception_date, available, created_at
+`
+
+type CreateAvailabilityExceptionParams struct {
+	MenuID        sql.NullInt64
+	CategoryID    sql.NullInt64
+	ExceptionDate time.Time
+	Available     bool
+}
+
+func (q *Queries) CreateAvailabilityException(ctx context.Context, arg CreateAvailabilityExceptionParams) (AvailabilityException, error) {
+	row := q.db.QueryRowContext(ctx, createAvailabilityException,
+		arg.MenuID,
+		arg.CategoryID,
+		arg.ExceptionDate,
+		arg.Available,
+	)
+	var i AvailabilityException
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.CategoryID,
+		&i.ExceptionDate,
+		&i.Available,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createAvailabilityWindow = `-- name: CreateAvailabilityWindow :one
+INSERT INTO availability_windows (
+    menu_id,
+    category_id,
+    days_of_week,
+    start_minute,
+    end_minute
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING id, menu_id, category_id, days_of_week, start_minute, end_minute, created_at
+`
+
+type CreateAvailabilityWindowParams struct {
+	MenuID      sql.NullInt64
+	CategoryID  sql.NullInt64
+	DaysOfWeek  int32
+	StartMinute int32
+	EndMinute   int32
+}
+
+func (q *Queries) CreateAvailabilityWindow(ctx context.Context, arg CreateAvailabilityWindowParams) (AvailabilityWindow, error) {
+	row := q.db.QueryRowContext(ctx, createAvailabilityWindow,
+		arg.MenuID,
+		arg.CategoryID,
+		arg.DaysOfWeek,
+		arg.StartMinute,
+		arg.EndMinute,
+	)
+	var i AvailabilityWindow
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.CategoryID,
+		&i.DaysOfWeek,
+		&i.StartMinute,
+		&i.EndMinute,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAvailabilityExceptions = `-- name: DeleteAvailabilityExceptions :exec
+DELETE FROM availability_exceptions
+WHERE menu_id = $1
+   OR category_id = $2
+`
+
+type DeleteAvailabilityExceptionsParams struct {
+	MenuID     sql.NullInt64
+	CategoryID sql.NullInt64
+}
+
+func (q *Queries) DeleteAvailabilityExceptions(ctx context.Context, arg DeleteAvailabilityExceptionsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAvailabilityExceptions, arg.MenuID, arg.CategoryID)
+	return err
+}
+
+const deleteAvailabilityWindows = `-- name: DeleteAvailabilityWindows :exec
+DELETE FROM availability_windows
+WHERE menu_id = $1
+   OR category_id = $2
+`
+
+type DeleteAvailabilityWindowsParams struct {
+	MenuID     sql.NullInt64
+	CategoryID sql.NullInt64
+}
+
+func (q *Queries) DeleteAvailabilityWindows(ctx context.Context, arg DeleteAvailabilityWindowsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAvailabilityWindows, arg.MenuID, arg.CategoryID)
+	return err
+}
+
+const listAvailabilityExceptions = `-- name: ListAvailabilityExceptions :many
+SELECT id, menu_id, category_id, exception_date, available, created_at FROM availability_exceptions
+WHERE menu_id = ANY($1::bigint[])
+   OR category_id = ANY($2::bigint[])
+ORDER BY exception_date, id
+`
+
+type ListAvailabilityExceptionsParams struct {
+	MenuIds     []int64
+	CategoryIds []int64
+}
+
+func (q *Queries) ListAvailabilityExceptions(ctx context.Context, arg ListAvailabilityExceptionsParams) ([]AvailabilityException, error) {
+	rows, err := q.db.QueryContext(ctx, listAvailabilityExceptions, pq.Array(arg.MenuIds), pq.Array(arg.CategoryIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AvailabilityException{}
+	for rows.Next() {
+		var i AvailabilityException
+		if err := rows.Scan(
+			&i.ID,
+			&i.MenuID,
+			&i.CategoryID,
+			&i.ExceptionDate,
+			&i.Available,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAvailabilityWindows = `-- name: ListAvailabilityWindows :many
+SELECT id, menu_id, category_id, days_of_week, start_minute, end_minute, created_at FROM availability_windows
+WHERE menu_id = ANY($1::bigint[])
+   OR category_id = ANY($2::bigint[])
+ORDER BY id
+`
+
+type ListAvailabilityWindowsParams struct {
+	MenuIds     []int64
+	CategoryIds []int64
+}
+
+func (q *Queries) ListAvailabilityWindows(ctx context.Context, arg ListAvailabilityWindowsParams) ([]AvailabilityWindow, error) {
+	rows, err := q.db.QueryContext(ctx, listAvailabilityWindows, pq.Array(arg.MenuIds), pq.Array(arg.CategoryIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AvailabilityWindow{}
+	for rows.Next() {
+		var i AvailabilityWindow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MenuID,
+			&i.CategoryID,
+			&i.DaysOfWeek,
+			&i.StartMinute,
+			&i.EndMinute,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
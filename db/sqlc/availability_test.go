@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWindow(t *testing.T) {
+	window, err := ParseWindow([]string{"mon", "Fri"}, "07:30", "24:00")
+	require.NoError(t, err)
+	require.Equal(t, NewAvailabilityWindow{
+		DaysOfWeek:  WeekdayMask(time.Monday, time.Friday),
+		StartMinute: 450,
+		EndMinute:   MinutesPerDay,
+	}, window)
+	require.Equal(t, []string{"mon", "fri"}, WeekdayNames(window.DaysOfWeek))
+	require.Equal(t, "24:00", FormatClock(window.EndMinute))
+
+	for _, times := range [][2]string{{"24:00", "02:00"}, {"08:00", "00:00"}, {"08:00", "08:00"}, {"8:00", "10:00"}, {"08:00", "24:30"}} {
+		_, err := ParseWindow([]string{"mon"}, times[0], times[1])
+		require.ErrorIs(t, err, ErrInvalidSchedule, times)
+	}
+	_, err = ParseWindow(nil, "08:00", "10:00")
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+	_, err = ParseWindow([]string{"monday"}, "08:00", "10:00")
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+}
+
+func TestAvailabilityWindowContains(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	require.NoError(t, err)
+	// 2026-10-16 is a Friday.
+	at := func(day int, hour int, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, location)
+	}
+
+	breakfast := AvailabilityWindow{DaysOfWeek: WeekdayMask(time.Friday), StartMinute: 6 * 60, EndMinute: 10 * 60}
+	require.True(t, breakfast.Contains(at(16, 6, 0)))
+	require.True(t, breakfast.Contains(at(16, 9, 59)))
+	require.False(t, breakfast.Contains(at(16, 10, 0)))
+	require.False(t, breakfast.Contains(at(17, 7, 0)))
+
+	lateNight := AvailabilityWindow{DaysOfWeek: WeekdayMask(time.Friday), StartMinute: 22 * 60, EndMinute: 2 * 60}
+	require.True(t, lateNight.Contains(at(16, 23, 0)))
+	require.True(t, lateNight.Contains(at(17, 1, 30)))
+	require.False(t, lateNight.Contains(at(17, 2, 0)))
+	require.False(t, lateNight.Contains(at(16, 1, 0)))
+}
+
+func TestMenuAvailableAt(t *testing.T) {
+	friday := time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)
+	windows := []AvailabilityWindow{
+		{CategoryID: sql.NullInt64{Int64: 1, Valid: true}, DaysOfWeek: 127, StartMinute: 6 * 60, EndMinute: 11 * 60},
+		{MenuID: sql.NullInt64{Int64: 2, Valid: true}, DaysOfWeek: WeekdayMask(time.Saturday, time.Sunday), StartMinute: 0, EndMinute: MinutesPerDay},
+	}
+	exceptions := []AvailabilityException{
+		{CategoryID: sql.NullInt64{Int64: 1, Valid: true}, ExceptionDate: time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), Available: false},
+		{MenuID: sql.NullInt64{Int64: 3, Valid: true}, ExceptionDate: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), Available: true},
+	}
+	availability := NewAvailability(windows, exceptions)
+
+	require.True(t, availability.MenuAvailableAt(1, 1, friday))
+	require.False(t, availability.MenuAvailableAt(1, 1, friday.Add(4*time.Hour)))
+	require.True(t, availability.MenuAvailableAt(1, 2, friday.Add(12*time.Hour)))
+	require.False(t, availability.MenuAvailableAt(2, 1, friday))
+	require.True(t, availability.MenuAvailableAt(2, 1, friday.Add(24*time.Hour)))
+	require.False(t, availability.MenuAvailableAt(1, 1, time.Date(2026, 12, 25, 8, 0, 0, 0, time.UTC)))
+	require.True(t, availability.MenuAvailableAt(3, 2, friday))
+
+	require.Len(t, availability.CategorySchedule(1).Windows, 1)
+	require.Len(t, availability.MenuSchedule(3).Exceptions, 1)
+	require.Empty(t, availability.MenuSchedule(4).Windows)
+}
+
+func TestPlaceOrderTxUnavailableMenu(t *testing.T) {
+	_, _, table, menus := createRandomOrderFixtures(t)
+	ctx := context.Background()
+
+	breakfast, err := ParseWindow([]string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}, "06:00", "10:00")
+	require.NoError(t, err)
+	_, err = testStore.ReplaceScheduleTx(ctx, ReplaceScheduleTxParams{
+		CategoryID: sql.NullInt64{Int64: menus[0].CategoryID, Valid: true},
+		Windows:    []NewAvailabilityWindow{breakfast},
+	})
+	require.NoError(t, err)
+
+	morning := time.Date(2026, 10, 16, 7, 0, 0, 0, time.UTC)
+	_, err = testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{
+		TableID:   table.ID,
+		Items:     []PlaceOrderItem{{MenuID: menus[0].ID, Quantity: 1}},
+		OrderedAt: morning,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{
+		TableID:   table.ID,
+		Items:     []PlaceOrderItem{{MenuID: menus[0].ID, Quantity: 1}},
+		OrderedAt: morning.Add(12 * time.Hour),
+	})
+	require.ErrorIs(t, err, ErrMenuUnavailable)
+
+	_, err = testStore.ReplaceScheduleTx(ctx, ReplaceScheduleTxParams{
+		MenuID:     sql.NullInt64{Int64: menus[1].ID, Valid: true},
+		Exceptions: []NewAvailabilityException{{Date: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), Available: false}},
+	})
+	require.NoError(t, err)
+	_, err = testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{
+		TableID:   table.ID,
+		Items:     []PlaceOrderItem{{MenuID: menus[1].ID, Quantity: 1}},
+		OrderedAt: morning,
+	})
+	require.ErrorIs(t, err, ErrMenuUnavailable)
+}
+
+func TestPlaceOrderTxDeactivatedMenu(t *testing.T) {
+	_, _, table, menus := createRandomOrderFixtures(t)
+	ctx := context.Background()
+	require.True(t, menus[0].Status, "new menus are active")
+
+	_, err := testQueries.UpdateMenu(ctx, UpdateMenuParams{
+		ID:         menus[0].ID,
+		Name:       menus[0].Name,
+		Price:      menus[0].Price,
+		CategoryID: menus[0].CategoryID,
+		Status:     false,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{
+		TableID:   table.ID,
+		Items:     []PlaceOrderItem{{MenuID: menus[0].ID, Quantity: 1}},
+		OrderedAt: time.Now(),
+	})
+	require.ErrorIs(t, err, ErrMenuUnavailable)
+}
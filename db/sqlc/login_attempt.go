@@ -0,0 +1,14 @@
+package db
+
+const (
+	LoginAttemptSucceeded        = "succeeded"
+	LoginAttemptUnknownUser      = "unknown_user"
+	LoginAttemptInvalidPassword  = "invalid_password"
+	LoginAttemptEmailNotVerified = "email_not_verified"
+	LoginAttemptBlocked          = "blocked"
+)
+
+const (
+	SecurityEventAccountLocked   = "account_locked"
+	SecurityEventAccountUnlocked = "account_unlocked"
+)
@@ -0,0 +1,98 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: login_attempt.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createLoginAttempt = `-- name: CreateLoginAttempt :one
+INSERT INTO login_attempts (
+  username,
+  user_id,
+  result,
+  client_ip,
+  user_agent
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, username, user_id, result, client_ip, user_agent, created_at
+`
+
+type CreateLoginAttemptParams struct {
+	Username  string
+	UserID    sql.NullInt64
+	Result    string
+	ClientIp  string
+	UserAgent string
+}
+
+func (q *Queries) CreateLoginAttempt(ctx context.Context, arg CreateLoginAttemptParams) (LoginAttempt, error) {
+	row := q.db.QueryRowContext(ctx, createLoginAttempt,
+		arg.Username,
+		arg.UserID,
+		arg.Result,
+		arg.ClientIp,
+		arg.UserAgent,
+	)
+	var i LoginAttempt
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.UserID,
+		&i.Result,
+		&i.ClientIp,
+		&i.UserAgent,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listLoginAttempts = `-- name: ListLoginAttempts :many
+SELECT id, username, user_id, result, client_ip, user_agent, created_at FROM login_attempts
+WHERE username = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListLoginAttemptsParams struct {
+	Username string
+	Limit    int32
+	Offset   int32
+}
+
+func (q *Queries) ListLoginAttempts(ctx context.Context, arg ListLoginAttemptsParams) ([]LoginAttempt, error) {
+	rows, err := q.db.QueryContext(ctx, listLoginAttempts, arg.Username, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LoginAttempt{}
+	for rows.Next() {
+		var i LoginAttempt
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.UserID,
+			&i.Result,
+			&i.ClientIp,
+			&i.UserAgent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
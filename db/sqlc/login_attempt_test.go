@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginAttempts(t *testing.T) {
+	user := createRandomUser(t)
+
+	failed, err := testQueries.CreateLoginAttempt(context.Background(), CreateLoginAttemptParams{
+		Username:  user.Username,
+		UserID:    sql.NullInt64{Int64: user.ID, Valid: true},
+		Result:    LoginAttemptInvalidPassword,
+		ClientIp:  "10.0.0.1",
+		UserAgent: "test",
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, failed.UserID.Int64)
+	require.NotZero(t, failed.CreatedAt)
+
+	unknown, err := testQueries.CreateLoginAttempt(context.Background(), CreateLoginAttemptParams{
+		Username: utils.RandomString(8),
+		Result:   LoginAttemptUnknownUser,
+		ClientIp: "10.0.0.1",
+	})
+	require.NoError(t, err)
+	require.False(t, unknown.UserID.Valid)
+
+	attempts, err := testQueries.ListLoginAttempts(context.Background(), ListLoginAttemptsParams{
+		Username: user.Username,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	require.Equal(t, failed.ID, attempts[0].ID)
+}
@@ -11,19 +11,22 @@ import (
 )
 
 var testQueries *Queries
+var testStore Store
 
 var testDB *sql.DB
 var err error
 
 func TestMain(m *testing.M) {
+	config, err := utils.LoadConfig(nil)
 	if err != nil {
 		log.Fatal("Can not load config", err)
 	}
-	testDB, err = sql.Open(utils.DBDriver, utils.DBSource)
+	testDB, err = sql.Open(config.DBDriver, config.DBSource)
 	if err != nil {
 		log.Fatal("cannot connect to db:", err)
 	}
 	testQueries = New(testDB)
+	testStore = NewStore(testDB)
 
 	code := m.Run()
 	os.Exit(code)
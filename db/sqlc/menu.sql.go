@@ -7,6 +7,9 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/datmaithanh/orderfood/money"
 )
 
 const createMenu = `-- name: CreateMenu :one
@@ -21,7 +24,7 @@ INSERT INTO menus (
 
 type CreateMenuParams struct {
 	Name       string
-	Price      string
+	Price      money.Amount
 	CategoryID int64
 }
 
@@ -68,6 +71,55 @@ func (q *Queries) GetMenu(ctx context.Context, id int64) (Menu, error) {
 	return i, err
 }
 
+const listActiveMenu = `-- name: ListActiveMenu :many
+SELECT menus.id, menus.name, menus.price, menus.category_id, menus.status, menus.created_at, categories.name AS category_name
+FROM menus
+JOIN categories ON categories.id = menus.category_id
+WHERE menus.status = true
+ORDER BY categories.name, menus.name
+`
+
+type ListActiveMenuRow struct {
+	ID           int64
+	Name         string
+	Price        money.Amount
+	CategoryID   int64
+	Status       bool
+	CreatedAt    time.Time
+	CategoryName string
+}
+
+func (q *Queries) ListActiveMenu(ctx context.Context) ([]ListActiveMenuRow, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveMenu)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActiveMenuRow{}
+	for rows.Next() {
+		var i ListActiveMenuRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Price,
+			&i.CategoryID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.CategoryName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMenu = `-- name: ListMenu :many
 SELECT id, name, price, category_id, status, created_at FROM menus
 ORDER BY id
@@ -114,7 +166,8 @@ const updateMenu = `-- name: UpdateMenu :one
 UPDATE menus
 SET name = $2,
     price = $3,
-    category_id = $4
+    category_id = $4,
+    status = $5
 WHERE id = $1
 RETURNING id, name, price, category_id, status, created_at
 `
@@ -122,8 +175,9 @@ RETURNING id, name, price, category_id, status, created_at
 type UpdateMenuParams struct {
 	ID         int64
 	Name       string
-	Price      string
+	Price      money.Amount
 	CategoryID int64
+	Status     bool
 }
 
 func (q *Queries) UpdateMenu(ctx context.Context, arg UpdateMenuParams) (Menu, error) {
@@ -132,6 +186,7 @@ func (q *Queries) UpdateMenu(ctx context.Context, arg UpdateMenuParams) (Menu, e
 		arg.Name,
 		arg.Price,
 		arg.CategoryID,
+		arg.Status,
 	)
 	var i Menu
 	err := row.Scan(
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/datmaithanh/orderfood/money"
+)
+
+var (
+	ErrInvalidOptionSelection = errors.New("invalid option selection")
+	ErrOptionRequired         = errors.New("option is required by its group")
+)
+
+// Required reports whether at least one option of the group must be chosen.
+func (group OptionGroup) Required() bool {
+	return group.MinSelections > 0
+}
+
+// SelectOptions checks the options chosen for a menu item against the item's
+// option groups and returns the chosen options in menu order. Every group must
+// receive between its minimum and maximum number of selections, including the
+// groups the request leaves out.
+func SelectOptions(groups []OptionGroup, options []MenuOption, optionIDs []int64) ([]MenuOption, error) {
+	chosen := make(map[int64]bool, len(optionIDs))
+	for _, id := range optionIDs {
+		if chosen[id] {
+			return nil, fmt.Errorf("%w: option %d is selected more than once", ErrInvalidOptionSelection, id)
+		}
+		chosen[id] = true
+	}
+
+	inGroup := make(map[int64]bool, len(groups))
+	for _, group := range groups {
+		inGroup[group.ID] = true
+	}
+
+	selected := make([]MenuOption, 0, len(optionIDs))
+	counts := make(map[int64]int32, len(groups))
+	for _, option := range options {
+		if chosen[option.ID] && inGroup[option.OptionGroupID] {
+			selected = append(selected, option)
+			counts[option.OptionGroupID]++
+			delete(chosen, option.ID)
+		}
+	}
+	for _, id := range optionIDs {
+		if chosen[id] {
+			return nil, fmt.Errorf("%w: option %d is not available for this menu", ErrInvalidOptionSelection, id)
+		}
+	}
+
+	for _, group := range groups {
+		count := counts[group.ID]
+		if count < group.MinSelections {
+			return nil, fmt.Errorf("%w: %q needs at least %d selections", ErrInvalidOptionSelection, group.Name, group.MinSelections)
+		}
+		if count > group.MaxSelections {
+			return nil, fmt.Errorf("%w: %q allows at most %d selections", ErrInvalidOptionSelection, group.Name, group.MaxSelections)
+		}
+	}
+	return selected, nil
+}
+
+// UnitPrice is the price of one item including the options chosen for it.
+func (item OrderItem) UnitPrice() money.Amount {
+	return item.Price.Add(item.OptionsPrice)
+}
+
+// Total is the price of the item line, options included.
+func (item OrderItem) Total() money.Amount {
+	return item.UnitPrice().Mul(int64(item.Quantity))
+}
@@ -0,0 +1,241 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: menu_option.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/lib/pq"
+)
+
+const countMenuOptionsByGroup = `-- name: CountMenuOptionsByGroup :one
+SELECT COUNT(*) FROM menu_options
+WHERE option_group_id = $1
+`
+
+func (q *Queries) CountMenuOptionsByGroup(ctx context.Context, optionGroupID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMenuOptionsByGroup, optionGroupID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createMenuOption = `-- name: CreateMenuOption :one
+INSERT INTO menu_options (
+  option_group_id,
+  name,
+  price
+) VALUES (
+  $1, $2, $3
+)
+RETURNING id, option_group_id, name, price, created_at
+`
+
+type CreateMenuOptionParams struct {
+	OptionGroupID int64
+	Name          string
+	Price         money.Amount
+}
+
+func (q *Queries) CreateMenuOption(ctx context.Context, arg CreateMenuOptionParams) (MenuOption, error) {
+	row := q.db.QueryRowContext(ctx, createMenuOption, arg.OptionGroupID, arg.Name, arg.Price)
+	var i MenuOption
+	err := row.Scan(
+		&i.ID,
+		&i.OptionGroupID,
+		&i.Name,
+		&i.Price,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createOptionGroup = `-- name: CreateOptionGroup :one
+INSERT INTO option_groups (
+  menu_id,
+  name,
+  min_selections,
+  max_selections
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING id, menu_id, name, min_selections, max_selections, created_at
+`
+
+type CreateOptionGroupParams struct {
+	MenuID        int64
+	Name          string
+	MinSelections int32
+	MaxSelections int32
+}
+
+func (q *Queries) CreateOptionGroup(ctx context.Context, arg CreateOptionGroupParams) (OptionGroup, error) {
+	row := q.db.QueryRowContext(ctx, createOptionGroup,
+		arg.MenuID,
+		arg.Name,
+		arg.MinSelections,
+		arg.MaxSelections,
+	)
+	var i OptionGroup
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.Name,
+		&i.MinSelections,
+		&i.MaxSelections,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteMenuOption = `-- name: DeleteMenuOption :exec
+DELETE FROM menu_options
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMenuOption(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuOption, id)
+	return err
+}
+
+const deleteOptionGroup = `-- name: DeleteOptionGroup :exec
+DELETE FROM option_groups
+WHERE id = $1
+`
+
+func (q *Queries) DeleteOptionGroup(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteOptionGroup, id)
+	return err
+}
+
+const getMenuOption = `-- name: GetMenuOption :one
+SELECT id, option_group_id, name, price, created_at FROM menu_options
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetMenuOption(ctx context.Context, id int64) (MenuOption, error) {
+	row := q.db.QueryRowContext(ctx, getMenuOption, id)
+	var i MenuOption
+	err := row.Scan(
+		&i.ID,
+		&i.OptionGroupID,
+		&i.Name,
+		&i.Price,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getOptionGroup = `-- name: GetOptionGroup :one
+SELECT id, menu_id, name, min_selections, max_selections, created_at FROM option_groups
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetOptionGroup(ctx context.Context, id int64) (OptionGroup, error) {
+	row := q.db.QueryRowContext(ctx, getOptionGroup, id)
+	var i OptionGroup
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.Name,
+		&i.MinSelections,
+		&i.MaxSelections,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getOptionGroupForUpdate = `-- name: GetOptionGroupForUpdate :one
+SELECT id, menu_id, name, min_selections, max_selections, created_at FROM option_groups
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetOptionGroupForUpdate(ctx context.Context, id int64) (OptionGroup, error) {
+	row := q.db.QueryRowContext(ctx, getOptionGroupForUpdate, id)
+	var i OptionGroup
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.Name,
+		&i.MinSelections,
+		&i.MaxSelections,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listMenuOptionsByGroupIDs = `-- name: ListMenuOptionsByGroupIDs :many
+SELECT id, option_group_id, name, price, created_at FROM menu_options
+WHERE option_group_id = ANY($1::bigint[])
+ORDER BY option_group_id, id
+`
+
+func (q *Queries) ListMenuOptionsByGroupIDs(ctx context.Context, optionGroupIds []int64) ([]MenuOption, error) {
+	rows, err := q.db.QueryContext(ctx, listMenuOptionsByGroupIDs, pq.Array(optionGroupIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MenuOption{}
+	for rows.Next() {
+		var i MenuOption
+		if err := rows.Scan(
+			&i.ID,
+			&i.OptionGroupID,
+			&i.Name,
+			&i.Price,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOptionGroupsByMenuIDs = `-- name: ListOptionGroupsByMenuIDs :many
+SELECT id, menu_id, name, min_selections, max_selections, created_at FROM option_groups
+WHERE menu_id = ANY($1::bigint[])
+ORDER BY menu_id, id
+`
+
+func (q *Queries) ListOptionGroupsByMenuIDs(ctx context.Context, menuIds []int64) ([]OptionGroup, error) {
+	rows, err := q.db.QueryContext(ctx, listOptionGroupsByMenuIDs, pq.Array(menuIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OptionGroup{}
+	for rows.Next() {
+		var i OptionGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.MenuID,
+			&i.Name,
+			&i.MinSelections,
+			&i.MaxSelections,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectOptions(t *testing.T) {
+	groups := []OptionGroup{
+		{ID: 1, Name: "Size", MinSelections: 1, MaxSelections: 1},
+		{ID: 2, Name: "Toppings", MinSelections: 0, MaxSelections: 2},
+	}
+	options := []MenuOption{
+		{ID: 10, OptionGroupID: 1, Name: "M"},
+		{ID: 11, OptionGroupID: 1, Name: "L", Price: money.MustParse("5000")},
+		{ID: 20, OptionGroupID: 2, Name: "Egg", Price: money.MustParse("7000")},
+		{ID: 21, OptionGroupID: 2, Name: "Pork", Price: money.MustParse("15000")},
+		{ID: 22, OptionGroupID: 2, Name: "Tofu", Price: money.MustParse("6000")},
+	}
+
+	selected, err := SelectOptions(groups, options, []int64{20, 11})
+	require.NoError(t, err)
+	require.Equal(t, []MenuOption{options[1], options[2]}, selected)
+
+	selected, err = SelectOptions(groups, options, []int64{10})
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+
+	for _, optionIDs := range [][]int64{
+		nil,
+		{10, 11},
+		{10, 20, 21, 22},
+		{10, 10},
+		{10, 99},
+	} {
+		_, err := SelectOptions(groups, options, optionIDs)
+		require.ErrorIs(t, err, ErrInvalidOptionSelection, optionIDs)
+	}
+
+	selected, err = SelectOptions(nil, nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, selected)
+}
+
+func TestOrderItemTotal(t *testing.T) {
+	item := OrderItem{Quantity: 3, Price: money.MustParse("45000"), OptionsPrice: money.MustParse("12000.50")}
+	require.Equal(t, money.MustParse("57000.50"), item.UnitPrice())
+	require.Equal(t, money.MustParse("171001.50"), item.Total())
+}
+
+func TestPlaceOrderTxWithOptions(t *testing.T) {
+	_, _, table, menus := createRandomOrderFixtures(t)
+	ctx := context.Background()
+
+	size, err := testQueries.CreateOptionGroup(ctx, CreateOptionGroupParams{
+		MenuID:        menus[0].ID,
+		Name:          "Size",
+		MinSelections: 1,
+		MaxSelections: 1,
+	})
+	require.NoError(t, err)
+	large, err := testQueries.CreateMenuOption(ctx, CreateMenuOptionParams{
+		OptionGroupID: size.ID,
+		Name:          "L",
+		Price:         money.MustParse("5000"),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{
+		TableID: table.ID,
+		Items:   []PlaceOrderItem{{MenuID: menus[0].ID, Quantity: 1}},
+	})
+	require.ErrorIs(t, err, ErrInvalidOptionSelection)
+
+	result, err := testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{
+		TableID: table.ID,
+		Items: []PlaceOrderItem{
+			{MenuID: menus[0].ID, Quantity: 2, OptionIDs: []int64{large.ID}},
+			{MenuID: menus[1].ID, Quantity: 1},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Options, 1)
+	require.Equal(t, result.Items[0].ID, result.Options[0].OrderItemID)
+	require.Equal(t, sql.NullInt64{Int64: large.ID, Valid: true}, result.Options[0].MenuOptionID)
+	require.Equal(t, "Size", result.Options[0].OptionGroupName)
+	require.Equal(t, large.Price, result.Options[0].Price)
+	require.Equal(t, large.Price, result.Items[0].OptionsPrice)
+	require.Equal(t, money.Sum(result.Items[0].Total(), result.Items[1].Total()), result.Order.TotalPrice)
+
+	// Removing the option from the menu keeps what was ordered.
+	require.NoError(t, testQueries.DeleteMenuOption(ctx, large.ID))
+	options, err := testQueries.ListOrderItemOptionsByOrderItemIDs(ctx, []int64{result.Items[0].ID})
+	require.NoError(t, err)
+	require.Len(t, options, 1)
+	require.False(t, options[0].MenuOptionID.Valid)
+	require.Equal(t, "L", options[0].OptionName)
+
+}
+
+func TestDeleteMenuOptionTx(t *testing.T) {
+	_, _, _, menus := createRandomOrderFixtures(t)
+	ctx := context.Background()
+
+	result, err := testStore.CreateOptionGroupTx(ctx, CreateOptionGroupTxParams{
+		CreateOptionGroupParams: CreateOptionGroupParams{
+			MenuID:        menus[0].ID,
+			Name:          "Size",
+			MinSelections: 1,
+			MaxSelections: 1,
+		},
+		Options: []NewMenuOption{
+			{Name: "M", Price: money.MustParse("0")},
+			{Name: "L", Price: money.MustParse("5000")},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, testStore.DeleteMenuOptionTx(ctx, result.Options[0].ID))
+
+	err = testStore.DeleteMenuOptionTx(ctx, result.Options[1].ID)
+	require.ErrorIs(t, err, ErrOptionRequired)
+	_, err = testQueries.GetMenuOption(ctx, result.Options[1].ID)
+	require.NoError(t, err)
+
+	err = testStore.DeleteMenuOptionTx(ctx, result.Options[0].ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
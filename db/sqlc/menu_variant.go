@@ -0,0 +1,20 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidVariant = errors.New("invalid menu variant")
+
+// CheckVariant reports whether the variant can be ordered for the menu.
+// menuID is 0 when the item is ordered by its variant alone.
+func CheckVariant(menuID int64, variant MenuVariant) error {
+	if menuID != 0 && variant.MenuID != menuID {
+		return fmt.Errorf("%w: variant %d does not belong to menu %d", ErrInvalidVariant, variant.ID, menuID)
+	}
+	if !variant.Available {
+		return fmt.Errorf("%w: %q is not available", ErrInvalidVariant, variant.Name)
+	}
+	return nil
+}
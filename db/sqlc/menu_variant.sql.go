@@ -0,0 +1,171 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: menu_variant.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/lib/pq"
+)
+
+const createMenuVariant = `-- name: CreateMenuVariant :one
+INSERT INTO menu_variants (
+  menu_id,
+  name,
+  price,
+  available
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING id, menu_id, name, price, available, created_at
+`
+
+type CreateMenuVariantParams struct {
+	MenuID    int64
+	Name      string
+	Price     money.Amount
+	Available bool
+}
+
+func (q *Queries) CreateMenuVariant(ctx context.Context, arg CreateMenuVariantParams) (MenuVariant, error) {
+	row := q.db.QueryRowContext(ctx, createMenuVariant,
+		arg.MenuID,
+		arg.Name,
+		arg.Price,
+		arg.Available,
+	)
+	var i MenuVariant
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.Name,
+		&i.Price,
+		&i.Available,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteMenuVariant = `-- name: DeleteMenuVariant :exec
+DELETE FROM menu_variants
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMenuVariant(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuVariant, id)
+	return err
+}
+
+const getMenuVariant = `-- name: GetMenuVariant :one
+SELECT id, menu_id, name, price, available, created_at FROM menu_variants
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetMenuVariant(ctx context.Context, id int64) (MenuVariant, error) {
+	row := q.db.QueryRowContext(ctx, getMenuVariant, id)
+	var i MenuVariant
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.Name,
+		&i.Price,
+		&i.Available,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMenuVariantForShare = `-- name: GetMenuVariantForShare :one
+SELECT id, menu_id, name, price, available, created_at FROM menu_variants
+WHERE id = $1 LIMIT 1
+FOR SHARE
+`
+
+func (q *Queries) GetMenuVariantForShare(ctx context.Context, id int64) (MenuVariant, error) {
+	row := q.db.QueryRowContext(ctx, getMenuVariantForShare, id)
+	var i MenuVariant
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.Name,
+		&i.Price,
+		&i.Available,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listMenuVariantsByMenuIDs = `-- name: ListMenuVariantsByMenuIDs :many
+SELECT id, menu_id, name, price, available, created_at FROM menu_variants
+WHERE menu_id = ANY($1::bigint[])
+ORDER BY menu_id, price, id
+`
+
+func (q *Queries) ListMenuVariantsByMenuIDs(ctx context.Context, menuIds []int64) ([]MenuVariant, error) {
+	rows, err := q.db.QueryContext(ctx, listMenuVariantsByMenuIDs, pq.Array(menuIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MenuVariant{}
+	for rows.Next() {
+		var i MenuVariant
+		if err := rows.Scan(
+			&i.ID,
+			&i.MenuID,
+			&i.Name,
+			&i.Price,
+			&i.Available,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateMenuVariant = `-- name: UpdateMenuVariant :one
+UPDATE menu_variants
+SET name = $2,
+    price = $3,
+    available = $4
+WHERE id = $1
+RETURNING id, menu_id, name, price, available, created_at
+`
+
+type UpdateMenuVariantParams struct {
+	ID        int64
+	Name      string
+	Price     money.Amount
+	Available bool
+}
+
+func (q *Queries) UpdateMenuVariant(ctx context.Context, arg UpdateMenuVariantParams) (MenuVariant, error) {
+	row := q.db.QueryRowContext(ctx, updateMenuVariant,
+		arg.ID,
+		arg.Name,
+		arg.Price,
+		arg.Available,
+	)
+	var i MenuVariant
+	err := row.Scan(
+		&i.ID,
+		&i.MenuID,
+		&i.Name,
+		&i.Price,
+		&i.Available,
+		&i.CreatedAt,
+	)
+	return i, err
+}
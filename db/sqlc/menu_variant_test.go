@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckVariant(t *testing.T) {
+	variant := MenuVariant{ID: 3, MenuID: 1, Name: "Large", Available: true}
+	require.NoError(t, CheckVariant(1, variant))
+	require.NoError(t, CheckVariant(0, variant))
+	require.ErrorIs(t, CheckVariant(2, variant), ErrInvalidVariant)
+
+	variant.Available = false
+	require.ErrorIs(t, CheckVariant(1, variant), ErrInvalidVariant)
+}
+
+func TestSummarizeSales(t *testing.T) {
+	rows := []ListSalesByVariantRow{
+		{MenuID: 1, MenuName: "Pho", VariantID: sql.NullInt64{Int64: 1, Valid: true}, VariantName: "Large", Quantity: 2, Revenue: money.MustParse("110000")},
+		{MenuID: 1, MenuName: "Pho", VariantID: sql.NullInt64{Int64: 2, Valid: true}, VariantName: "Small", Quantity: 3, Revenue: money.MustParse("120000")},
+		{MenuID: 2, MenuName: "Tea", Quantity: 4, Revenue: money.MustParse("20000.50")},
+	}
+
+	sales := SummarizeSales(rows)
+	require.Len(t, sales, 2)
+	require.Equal(t, int64(5), sales[0].Quantity)
+	require.Equal(t, money.MustParse("230000"), sales[0].Revenue)
+	require.Equal(t, rows[:2], sales[0].Variants)
+	require.Equal(t, "Tea", sales[1].MenuName)
+	require.Equal(t, money.MustParse("20000.50"), sales[1].Revenue)
+	require.Empty(t, sales[1].Variants)
+
+	require.Empty(t, SummarizeSales(nil))
+}
+
+func TestPlaceOrderTxWithVariant(t *testing.T) {
+	_, _, table, menus := createRandomOrderFixtures(t)
+	ctx := context.Background()
+
+	large, err := testQueries.CreateMenuVariant(ctx, CreateMenuVariantParams{
+		MenuID:    menus[0].ID,
+		Name:      "Large",
+		Price:     money.MustParse("55000"),
+		Available: true,
+	})
+	require.NoError(t, err)
+	small, err := testQueries.CreateMenuVariant(ctx, CreateMenuVariantParams{
+		MenuID: menus[0].ID,
+		Name:   "Small",
+		Price:  money.MustParse("40000"),
+	})
+	require.NoError(t, err)
+
+	for _, item := range []PlaceOrderItem{
+		{MenuID: menus[0].ID, Quantity: 1},
+		{VariantID: small.ID, Quantity: 1},
+		{MenuID: menus[1].ID, VariantID: large.ID, Quantity: 1},
+	} {
+		_, err = testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{TableID: table.ID, Items: []PlaceOrderItem{item}})
+		require.ErrorIs(t, err, ErrInvalidVariant)
+	}
+
+	result, err := testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{
+		TableID: table.ID,
+		Items:   []PlaceOrderItem{{VariantID: large.ID, Quantity: 2}},
+	})
+	require.NoError(t, err)
+	item := result.Items[0]
+	require.Equal(t, menus[0].ID, item.MenuID)
+	require.Equal(t, sql.NullInt64{Int64: large.ID, Valid: true}, item.VariantID)
+	require.Equal(t, "Large", item.VariantName)
+	require.Equal(t, large.Price, item.Price)
+	require.Equal(t, money.MustParse("110000"), result.Order.TotalPrice)
+
+	_, err = testQueries.UpdateOrderStatus(ctx, UpdateOrderStatusParams{ID: result.Order.ID, Status: OrderStatusPaid})
+	require.NoError(t, err)
+
+	rows, err := testQueries.ListSalesByVariant(ctx, ListSalesByVariantParams{
+		FromTime: result.Order.CreatedAt.Add(-time.Minute),
+		ToTime:   result.Order.CreatedAt.Add(time.Minute),
+	})
+	require.NoError(t, err)
+	var found bool
+	for _, row := range rows {
+		if row.VariantID.Int64 == large.ID {
+			found = true
+			require.Equal(t, int64(2), row.Quantity)
+			require.Equal(t, money.MustParse("110000"), row.Revenue)
+		}
+	}
+	require.True(t, found)
+}
+
+func TestCreateOrderItemMissingVariant(t *testing.T) {
+	_, _, table, menus := createRandomOrderFixtures(t)
+	ctx := context.Background()
+
+	variant, err := testQueries.CreateMenuVariant(ctx, CreateMenuVariantParams{
+		MenuID:    menus[0].ID,
+		Name:      "Large",
+		Price:     money.MustParse("55000"),
+		Available: true,
+	})
+	require.NoError(t, err)
+
+	placed, err := testStore.PlaceOrderTx(ctx, PlaceOrderTxParams{
+		TableID: table.ID,
+		Items:   []PlaceOrderItem{{VariantID: variant.ID, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, testQueries.DeleteMenuVariant(ctx, variant.ID))
+
+	// A variant deleted after it was resolved must not fall back to the menu price.
+	_, err = testQueries.CreateOrderItem(ctx, CreateOrderItemParams{
+		OrderID:   placed.Order.ID,
+		MenuID:    menus[0].ID,
+		VariantID: sql.NullInt64{Int64: variant.ID, Valid: true},
+		Quantity:  1,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
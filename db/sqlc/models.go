@@ -5,11 +5,32 @@
 package db
 
 import (
+	"database/sql"
 	"time"
 
+	"github.com/datmaithanh/orderfood/money"
 	"github.com/google/uuid"
 )
 
+type AvailabilityException struct {
+	ID            int64
+	MenuID        sql.NullInt64
+	CategoryID    sql.NullInt64
+	ExceptionDate time.Time
+	Available     bool
+	CreatedAt     time.Time
+}
+
+type AvailabilityWindow struct {
+	ID          int64
+	MenuID      sql.NullInt64
+	CategoryID  sql.NullInt64
+	DaysOfWeek  int32
+	StartMinute int32
+	EndMinute   int32
+	CreatedAt   time.Time
+}
+
 type Category struct {
 	ID        int64
 	Name      string
@@ -24,45 +45,123 @@ type Customer struct {
 	CreatedAt   time.Time
 }
 
+type LoginAttempt struct {
+	ID        int64
+	Username  string
+	UserID    sql.NullInt64
+	Result    string
+	ClientIp  string
+	UserAgent string
+	CreatedAt time.Time
+}
+
 type Menu struct {
 	ID         int64
 	Name       string
-	Price      string
+	Price      money.Amount
 	CategoryID int64
 	Status     bool
 	CreatedAt  time.Time
 }
 
+type MenuOption struct {
+	ID            int64
+	OptionGroupID int64
+	Name          string
+	Price         money.Amount
+	CreatedAt     time.Time
+}
+
+type MenuVariant struct {
+	ID        int64
+	MenuID    int64
+	Name      string
+	Price     money.Amount
+	Available bool
+	CreatedAt time.Time
+}
+
+type OptionGroup struct {
+	ID            int64
+	MenuID        int64
+	Name          string
+	MinSelections int32
+	MaxSelections int32
+	CreatedAt     time.Time
+}
+
 type Order struct {
 	ID         int64
-	UserID     int64
-	CustomerID int64
+	UserID     sql.NullInt64
+	CustomerID sql.NullInt64
 	TableID    int64
 	Status     string
-	TotalPrice string
+	TotalPrice money.Amount
 	CreatedAt  time.Time
 }
 
 type OrderItem struct {
+	ID           int64
+	OrderID      int64
+	MenuID       int64
+	Quantity     int32
+	Price        money.Amount
+	NoteItem     string
+	Status       string
+	CreatedAt    time.Time
+	OptionsPrice money.Amount
+	VariantID    sql.NullInt64
+	VariantName  string
+}
+
+type OrderItemOption struct {
+	ID              int64
+	OrderItemID     int64
+	MenuOptionID    sql.NullInt64
+	OptionGroupName string
+	OptionName      string
+	Price           money.Amount
+	CreatedAt       time.Time
+}
+
+type OrderStatusHistory struct {
+	ID         int64
+	OrderID    int64
+	FromStatus string
+	ToStatus   string
+	ChangedBy  string
+	CreatedAt  time.Time
+}
+
+type PasswordReset struct {
 	ID        int64
-	OrderID   int64
-	MenuID    int64
-	Quantity  int32
-	Price     string
-	NoteItem  string
-	Status    string
+	UserID    int64
+	TokenHash string
+	ClientIp  string
+	IsUsed    bool
 	CreatedAt time.Time
+	ExpiredAt time.Time
 }
 
 type Payment struct {
 	ID            int64
 	OrderID       int64
-	Amount        string
+	Amount        money.Amount
 	PaymentMethod string
 	Status        string
 	CreatedAt     time.Time
 }
 
+type SecurityEvent struct {
+	ID        int64
+	UserID    int64
+	EventType string
+	SessionID uuid.NullUUID
+	ClientIp  string
+	UserAgent string
+	CreatedAt time.Time
+}
+
 type Session struct {
 	ID           uuid.UUID
 	UserID       int64
@@ -72,6 +171,9 @@ type Session struct {
 	IsBlocked    bool
 	ExpiresAt    time.Time
 	CreatedAt    time.Time
+	FamilyID     uuid.UUID
+	ParentID     uuid.NullUUID
+	RotatedAt    sql.NullTime
 }
 
 type Table struct {
@@ -81,14 +183,27 @@ type Table struct {
 	QrImageUrl string
 	Status     string
 	CreatedAt  time.Time
+	QrToken    string
 }
 
 type User struct {
-	ID           int64
-	Username     string
-	HashPassword string
-	FullName     string
-	Role         string
-	Email        string
-	CreatedAt    time.Time
+	ID              int64
+	Username        string
+	HashPassword    string
+	FullName        string
+	Role            string
+	Email           string
+	CreatedAt       time.Time
+	IsEmailVerified bool
+	TokenVersion    int64
+}
+
+type VerifyEmail struct {
+	ID             int64
+	Username       string
+	Email          string
+	SecretCodeHash string
+	IsUsed         bool
+	CreatedAt      time.Time
+	ExpiredAt      time.Time
 }
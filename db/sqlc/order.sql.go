@@ -7,6 +7,9 @@ package db
 
 import (
 	"context"
+	"database/sql"
+
+	"github.com/datmaithanh/orderfood/money"
 )
 
 const createOrder = `-- name: CreateOrder :one
@@ -16,24 +19,18 @@ INSERT INTO orders (
     table_id,
     total_price
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, 0
 ) RETURNING id, user_id, customer_id, table_id, status, total_price, created_at
 `
 
 type CreateOrderParams struct {
-	UserID     int64
-	CustomerID int64
+	UserID     sql.NullInt64
+	CustomerID sql.NullInt64
 	TableID    int64
-	TotalPrice string
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error) {
-	row := q.db.QueryRowContext(ctx, createOrder,
-		arg.UserID,
-		arg.CustomerID,
-		arg.TableID,
-		arg.TotalPrice,
-	)
+	row := q.db.QueryRowContext(ctx, createOrder, arg.UserID, arg.CustomerID, arg.TableID)
 	var i Order
 	err := row.Scan(
 		&i.ID,
@@ -57,6 +54,20 @@ func (q *Queries) DeleteOrder(ctx context.Context, id int64) error {
 	return err
 }
 
+const getOpenOrdersTotalByTable = `-- name: GetOpenOrdersTotalByTable :one
+SELECT COALESCE(SUM(total_price), 0)::numeric(12,2) AS total
+FROM orders
+WHERE table_id = $1
+  AND status NOT IN ('paid', 'cancelled')
+`
+
+func (q *Queries) GetOpenOrdersTotalByTable(ctx context.Context, tableID int64) (money.Amount, error) {
+	row := q.db.QueryRowContext(ctx, getOpenOrdersTotalByTable, tableID)
+	var total money.Amount
+	err := row.Scan(&total)
+	return total, err
+}
+
 const getOrder = `-- name: GetOrder :one
 SELECT id, user_id, customer_id, table_id, status, total_price, created_at FROM orders
 WHERE id = $1 LIMIT 1
@@ -77,6 +88,65 @@ func (q *Queries) GetOrder(ctx context.Context, id int64) (Order, error) {
 	return i, err
 }
 
+const getOrderForUpdate = `-- name: GetOrderForUpdate :one
+SELECT id, user_id, customer_id, table_id, status, total_price, created_at FROM orders
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetOrderForUpdate(ctx context.Context, id int64) (Order, error) {
+	row := q.db.QueryRowContext(ctx, getOrderForUpdate, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CustomerID,
+		&i.TableID,
+		&i.Status,
+		&i.TotalPrice,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listOpenOrdersByTable = `-- name: ListOpenOrdersByTable :many
+SELECT id, user_id, customer_id, table_id, status, total_price, created_at FROM orders
+WHERE table_id = $1
+  AND status NOT IN ('paid', 'cancelled')
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListOpenOrdersByTable(ctx context.Context, tableID int64) ([]Order, error) {
+	rows, err := q.db.QueryContext(ctx, listOpenOrdersByTable, tableID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CustomerID,
+			&i.TableID,
+			&i.Status,
+			&i.TotalPrice,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrder = `-- name: ListOrder :many
 SELECT id, user_id, customer_id, table_id, status, total_price, created_at FROM orders
 ORDER BY id
@@ -124,20 +194,16 @@ const updateOrder = `-- name: UpdateOrder :one
 UPDATE orders
 SET user_id = $2,
     customer_id = $3,
-    table_id = $4,
-    status = $5,
-    total_price = $6
+    table_id = $4
 WHERE id = $1
 RETURNING id, user_id, customer_id, table_id, status, total_price, created_at
 `
 
 type UpdateOrderParams struct {
 	ID         int64
-	UserID     int64
-	CustomerID int64
+	UserID     sql.NullInt64
+	CustomerID sql.NullInt64
 	TableID    int64
-	Status     string
-	TotalPrice string
 }
 
 func (q *Queries) UpdateOrder(ctx context.Context, arg UpdateOrderParams) (Order, error) {
@@ -146,8 +212,6 @@ func (q *Queries) UpdateOrder(ctx context.Context, arg UpdateOrderParams) (Order
 		arg.UserID,
 		arg.CustomerID,
 		arg.TableID,
-		arg.Status,
-		arg.TotalPrice,
 	)
 	var i Order
 	err := row.Scan(
@@ -189,20 +253,19 @@ func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusPa
 	return i, err
 }
 
-const updateOrderTotalPrice = `-- name: UpdateOrderTotalPrice :one
+const updateOrderTotalFromItems = `-- name: UpdateOrderTotalFromItems :one
 UPDATE orders
-SET total_price = $2
-WHERE id = $1
+SET total_price = (
+    SELECT COALESCE(SUM((order_item.price + order_item.options_price) * order_item.quantity), 0)
+    FROM order_item
+    WHERE order_item.order_id = orders.id
+)
+WHERE orders.id = $1
 RETURNING id, user_id, customer_id, table_id, status, total_price, created_at
 `
 
-type UpdateOrderTotalPriceParams struct {
-	ID         int64
-	TotalPrice string
-}
-
-func (q *Queries) UpdateOrderTotalPrice(ctx context.Context, arg UpdateOrderTotalPriceParams) (Order, error) {
-	row := q.db.QueryRowContext(ctx, updateOrderTotalPrice, arg.ID, arg.TotalPrice)
+func (q *Queries) UpdateOrderTotalFromItems(ctx context.Context, id int64) (Order, error) {
+	row := q.db.QueryRowContext(ctx, updateOrderTotalFromItems, id)
 	var i Order
 	err := row.Scan(
 		&i.ID,
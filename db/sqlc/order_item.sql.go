@@ -7,32 +7,63 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/lib/pq"
 )
 
+const countOrderItemsNotReady = `-- name: CountOrderItemsNotReady :one
+SELECT COUNT(*) FROM order_item
+WHERE order_id = $1
+  AND status NOT IN ('ready', 'served')
+`
+
+func (q *Queries) CountOrderItemsNotReady(ctx context.Context, orderID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOrderItemsNotReady, orderID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createOrderItem = `-- name: CreateOrderItem :one
 INSERT INTO order_item (
     order_id,
     menu_id,
+    variant_id,
+    variant_name,
     quantity,
-    price
-) VALUES (
-  $1, $2, $3, $4
-) RETURNING id, order_id, menu_id, quantity, price, note_item, status, created_at
+    price,
+    options_price,
+    note_item
+)
+SELECT $1::bigint, menus.id, menu_variants.id, COALESCE(menu_variants.name, ''), $2::int,
+       COALESCE(menu_variants.price, menus.price), $3::numeric, $4::varchar
+FROM menus
+LEFT JOIN menu_variants ON menu_variants.menu_id = menus.id AND menu_variants.id = $5
+WHERE menus.id = $6
+  AND ($5::bigint IS NULL OR menu_variants.id IS NOT NULL)
+RETURNING id, order_id, menu_id, quantity, price, note_item, status, created_at, options_price, variant_id, variant_name
 `
 
 type CreateOrderItemParams struct {
-	OrderID  int64
-	MenuID   int64
-	Quantity int32
-	Price    string
+	OrderID      int64
+	Quantity     int32
+	OptionsPrice money.Amount
+	NoteItem     string
+	VariantID    sql.NullInt64
+	MenuID       int64
 }
 
 func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams) (OrderItem, error) {
 	row := q.db.QueryRowContext(ctx, createOrderItem,
 		arg.OrderID,
-		arg.MenuID,
 		arg.Quantity,
-		arg.Price,
+		arg.OptionsPrice,
+		arg.NoteItem,
+		arg.VariantID,
+		arg.MenuID,
 	)
 	var i OrderItem
 	err := row.Scan(
@@ -44,6 +75,9 @@ func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams
 		&i.NoteItem,
 		&i.Status,
 		&i.CreatedAt,
+		&i.OptionsPrice,
+		&i.VariantID,
+		&i.VariantName,
 	)
 	return i, err
 }
@@ -59,7 +93,7 @@ func (q *Queries) DeleteOrderItem(ctx context.Context, id int64) error {
 }
 
 const getOrderItem = `-- name: GetOrderItem :one
-SELECT id, order_id, menu_id, quantity, price, note_item, status, created_at FROM order_item
+SELECT id, order_id, menu_id, quantity, price, note_item, status, created_at, options_price, variant_id, variant_name FROM order_item
 WHERE id = $1 LIMIT 1
 `
 
@@ -75,12 +109,114 @@ func (q *Queries) GetOrderItem(ctx context.Context, id int64) (OrderItem, error)
 		&i.NoteItem,
 		&i.Status,
 		&i.CreatedAt,
+		&i.OptionsPrice,
+		&i.VariantID,
+		&i.VariantName,
 	)
 	return i, err
 }
 
+const getOrderItemForUpdate = `-- name: GetOrderItemForUpdate :one
+SELECT id, order_id, menu_id, quantity, price, note_item, status, created_at, options_price, variant_id, variant_name FROM order_item
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetOrderItemForUpdate(ctx context.Context, id int64) (OrderItem, error) {
+	row := q.db.QueryRowContext(ctx, getOrderItemForUpdate, id)
+	var i OrderItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.MenuID,
+		&i.Quantity,
+		&i.Price,
+		&i.NoteItem,
+		&i.Status,
+		&i.CreatedAt,
+		&i.OptionsPrice,
+		&i.VariantID,
+		&i.VariantName,
+	)
+	return i, err
+}
+
+const listKitchenOrderItems = `-- name: ListKitchenOrderItems :many
+SELECT order_item.id,
+       order_item.order_id,
+       order_item.menu_id,
+       order_item.variant_name,
+       order_item.quantity,
+       order_item.note_item,
+       order_item.status,
+       order_item.created_at,
+       orders.table_id,
+       menus.name AS menu_name,
+       categories.id AS category_id,
+       categories.name AS category_name
+FROM order_item
+JOIN orders ON orders.id = order_item.order_id
+JOIN menus ON menus.id = order_item.menu_id
+JOIN categories ON categories.id = menus.category_id
+WHERE order_item.status IN ('pending', 'cooking', 'ready')
+  AND orders.status IN ('confirmed', 'preparing', 'served')
+  AND ($1::bigint IS NULL OR categories.id = $1)
+ORDER BY categories.name, order_item.created_at, order_item.id
+`
+
+type ListKitchenOrderItemsRow struct {
+	ID           int64
+	OrderID      int64
+	MenuID       int64
+	VariantName  string
+	Quantity     int32
+	NoteItem     string
+	Status       string
+	CreatedAt    time.Time
+	TableID      int64
+	MenuName     string
+	CategoryID   int64
+	CategoryName string
+}
+
+func (q *Queries) ListKitchenOrderItems(ctx context.Context, categoryID sql.NullInt64) ([]ListKitchenOrderItemsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listKitchenOrderItems, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListKitchenOrderItemsRow{}
+	for rows.Next() {
+		var i ListKitchenOrderItemsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.MenuID,
+			&i.VariantName,
+			&i.Quantity,
+			&i.NoteItem,
+			&i.Status,
+			&i.CreatedAt,
+			&i.TableID,
+			&i.MenuName,
+			&i.CategoryID,
+			&i.CategoryName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrderItem = `-- name: ListOrderItem :many
-SELECT id, order_id, menu_id, quantity, price, note_item, status, created_at FROM order_item
+SELECT id, order_id, menu_id, quantity, price, note_item, status, created_at, options_price, variant_id, variant_name FROM order_item
 ORDER BY id
 LIMIT $1
 OFFSET $2
@@ -109,6 +245,50 @@ func (q *Queries) ListOrderItem(ctx context.Context, arg ListOrderItemParams) ([
 			&i.NoteItem,
 			&i.Status,
 			&i.CreatedAt,
+			&i.OptionsPrice,
+			&i.VariantID,
+			&i.VariantName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrderItemsByOrderIDs = `-- name: ListOrderItemsByOrderIDs :many
+SELECT id, order_id, menu_id, quantity, price, note_item, status, created_at, options_price, variant_id, variant_name FROM order_item
+WHERE order_id = ANY($1::bigint[])
+ORDER BY order_id, id
+`
+
+func (q *Queries) ListOrderItemsByOrderIDs(ctx context.Context, orderIds []int64) ([]OrderItem, error) {
+	rows, err := q.db.QueryContext(ctx, listOrderItemsByOrderIDs, pq.Array(orderIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrderItem{}
+	for rows.Next() {
+		var i OrderItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.MenuID,
+			&i.Quantity,
+			&i.Price,
+			&i.NoteItem,
+			&i.Status,
+			&i.CreatedAt,
+			&i.OptionsPrice,
+			&i.VariantID,
+			&i.VariantName,
 		); err != nil {
 			return nil, err
 		}
@@ -125,33 +305,51 @@ func (q *Queries) ListOrderItem(ctx context.Context, arg ListOrderItemParams) ([
 
 const updateOrderItem = `-- name: UpdateOrderItem :one
 UPDATE order_item
-SET order_id = $2,
-    menu_id = $3,
-    quantity = $4,
-    note_item = $5,
-    status = $6
+SET quantity = $2,
+    note_item = $3
 WHERE id = $1
-RETURNING id, order_id, menu_id, quantity, price, note_item, status, created_at
+RETURNING id, order_id, menu_id, quantity, price, note_item, status, created_at, options_price, variant_id, variant_name
 `
 
 type UpdateOrderItemParams struct {
 	ID       int64
-	OrderID  int64
-	MenuID   int64
 	Quantity int32
 	NoteItem string
-	Status   string
 }
 
 func (q *Queries) UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams) (OrderItem, error) {
-	row := q.db.QueryRowContext(ctx, updateOrderItem,
-		arg.ID,
-		arg.OrderID,
-		arg.MenuID,
-		arg.Quantity,
-		arg.NoteItem,
-		arg.Status,
+	row := q.db.QueryRowContext(ctx, updateOrderItem, arg.ID, arg.Quantity, arg.NoteItem)
+	var i OrderItem
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.MenuID,
+		&i.Quantity,
+		&i.Price,
+		&i.NoteItem,
+		&i.Status,
+		&i.CreatedAt,
+		&i.OptionsPrice,
+		&i.VariantID,
+		&i.VariantName,
 	)
+	return i, err
+}
+
+const updateOrderItemStatus = `-- name: UpdateOrderItemStatus :one
+UPDATE order_item
+SET status = $2
+WHERE id = $1
+RETURNING id, order_id, menu_id, quantity, price, note_item, status, created_at, options_price, variant_id, variant_name
+`
+
+type UpdateOrderItemStatusParams struct {
+	ID     int64
+	Status string
+}
+
+func (q *Queries) UpdateOrderItemStatus(ctx context.Context, arg UpdateOrderItemStatusParams) (OrderItem, error) {
+	row := q.db.QueryRowContext(ctx, updateOrderItemStatus, arg.ID, arg.Status)
 	var i OrderItem
 	err := row.Scan(
 		&i.ID,
@@ -162,6 +360,9 @@ func (q *Queries) UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams
 		&i.NoteItem,
 		&i.Status,
 		&i.CreatedAt,
+		&i.OptionsPrice,
+		&i.VariantID,
+		&i.VariantName,
 	)
 	return i, err
 }
@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: order_item_option.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/lib/pq"
+)
+
+const createOrderItemOption = `-- name: CreateOrderItemOption :one
+INSERT INTO order_item_options (
+  order_item_id,
+  menu_option_id,
+  option_group_name,
+  option_name,
+  price
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, order_item_id, menu_option_id, option_group_name, option_name, price, created_at
+`
+
+type CreateOrderItemOptionParams struct {
+	OrderItemID     int64
+	MenuOptionID    sql.NullInt64
+	OptionGroupName string
+	OptionName      string
+	Price           money.Amount
+}
+
+func (q *Queries) CreateOrderItemOption(ctx context.Context, arg CreateOrderItemOptionParams) (OrderItemOption, error) {
+	row := q.db.QueryRowContext(ctx, createOrderItemOption,
+		arg.OrderItemID,
+		arg.MenuOptionID,
+		arg.OptionGroupName,
+		arg.OptionName,
+		arg.Price,
+	)
+	var i OrderItemOption
+	err := row.Scan(
+		&i.ID,
+		&i.OrderItemID,
+		&i.MenuOptionID,
+		&i.OptionGroupName,
+		&i.OptionName,
+		&i.Price,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listOrderItemOptionsByOrderItemIDs = `-- name: ListOrderItemOptionsByOrderItemIDs :many
+SELECT id, order_item_id, menu_option_id, option_group_name, option_name, price, created_at FROM order_item_options
+WHERE order_item_id = ANY($1::bigint[])
+ORDER BY order_item_id, id
+`
+
+func (q *Queries) ListOrderItemOptionsByOrderItemIDs(ctx context.Context, orderItemIds []int64) ([]OrderItemOption, error) {
+	rows, err := q.db.QueryContext(ctx, listOrderItemOptionsByOrderItemIDs, pq.Array(orderItemIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrderItemOption{}
+	for rows.Next() {
+		var i OrderItemOption
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderItemID,
+			&i.MenuOptionID,
+			&i.OptionGroupName,
+			&i.OptionName,
+			&i.Price,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,35 @@
+package db
+
+import (
+	"errors"
+)
+
+const (
+	OrderItemStatusPending = "pending"
+	OrderItemStatusCooking = "cooking"
+	OrderItemStatusReady   = "ready"
+	OrderItemStatusServed  = "served"
+)
+
+var ErrInvalidOrderItemStatusTransition = errors.New("invalid order item status transition")
+
+var orderItemStatusTransitions = map[string]string{
+	OrderItemStatusPending: OrderItemStatusCooking,
+	OrderItemStatusCooking: OrderItemStatusReady,
+	OrderItemStatusReady:   OrderItemStatusServed,
+}
+
+func IsValidOrderItemStatus(status string) bool {
+	if status == OrderItemStatusServed {
+		return true
+	}
+	_, ok := orderItemStatusTransitions[status]
+	return ok
+}
+
+// CanTransitionOrderItemStatus reports whether a kitchen item may be bumped
+// from one status to the next. Items only move forward one step at a time.
+func CanTransitionOrderItemStatus(from string, to string) bool {
+	next, ok := orderItemStatusTransitions[from]
+	return ok && next == to
+}
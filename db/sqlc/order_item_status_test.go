@@ -0,0 +1,24 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransitionOrderItemStatus(t *testing.T) {
+	require.True(t, CanTransitionOrderItemStatus(OrderItemStatusPending, OrderItemStatusCooking))
+	require.True(t, CanTransitionOrderItemStatus(OrderItemStatusCooking, OrderItemStatusReady))
+	require.True(t, CanTransitionOrderItemStatus(OrderItemStatusReady, OrderItemStatusServed))
+
+	require.False(t, CanTransitionOrderItemStatus(OrderItemStatusPending, OrderItemStatusReady))
+	require.False(t, CanTransitionOrderItemStatus(OrderItemStatusReady, OrderItemStatusCooking))
+	require.False(t, CanTransitionOrderItemStatus(OrderItemStatusServed, OrderItemStatusPending))
+}
+
+func TestIsValidOrderItemStatus(t *testing.T) {
+	for _, status := range []string{OrderItemStatusPending, OrderItemStatusCooking, OrderItemStatusReady, OrderItemStatusServed} {
+		require.True(t, IsValidOrderItemStatus(status))
+	}
+	require.False(t, IsValidOrderItemStatus("burnt"))
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	OrderStatusPending   = "pending"
+	OrderStatusConfirmed = "confirmed"
+	OrderStatusPreparing = "preparing"
+	OrderStatusServed    = "served"
+	OrderStatusPaid      = "paid"
+	OrderStatusCancelled = "cancelled"
+)
+
+var ErrInvalidOrderStatusTransition = errors.New("invalid order status transition")
+
+var orderStatusTransitions = map[string][]string{
+	OrderStatusPending:   {OrderStatusConfirmed, OrderStatusCancelled},
+	OrderStatusConfirmed: {OrderStatusPreparing, OrderStatusCancelled},
+	OrderStatusPreparing: {OrderStatusServed, OrderStatusCancelled},
+	OrderStatusServed:    {OrderStatusPreparing, OrderStatusPaid},
+	OrderStatusPaid:      {},
+	OrderStatusCancelled: {},
+}
+
+func IsValidOrderStatus(status string) bool {
+	_, ok := orderStatusTransitions[status]
+	return ok
+}
+
+// IsOrderOpen reports whether items may still be added to, changed on or
+// removed from an order in the given status.
+func IsOrderOpen(status string) bool {
+	return status != OrderStatusPaid && status != OrderStatusCancelled && IsValidOrderStatus(status)
+}
+
+func CanTransitionOrderStatus(from string, to string) bool {
+	for _, next := range orderStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// changeOrderStatus moves a locked order to a new status and records the change.
+// Callers must hold the order row lock inside the same transaction.
+func changeOrderStatus(ctx context.Context, q *Queries, order Order, status string, changedBy string) (Order, OrderStatusHistory, error) {
+	if !CanTransitionOrderStatus(order.Status, status) {
+		return order, OrderStatusHistory{}, fmt.Errorf("%w: cannot move order from %s to %s", ErrInvalidOrderStatusTransition, order.Status, status)
+	}
+
+	updated, err := q.UpdateOrderStatus(ctx, UpdateOrderStatusParams{
+		ID:     order.ID,
+		Status: status,
+	})
+	if err != nil {
+		return order, OrderStatusHistory{}, err
+	}
+
+	history, err := q.CreateOrderStatusHistory(ctx, CreateOrderStatusHistoryParams{
+		OrderID:    order.ID,
+		FromStatus: order.Status,
+		ToStatus:   status,
+		ChangedBy:  changedBy,
+	})
+	if err != nil {
+		return order, OrderStatusHistory{}, err
+	}
+
+	return updated, history, nil
+}
@@ -0,0 +1,83 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: order_status_history.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createOrderStatusHistory = `-- name: CreateOrderStatusHistory :one
+INSERT INTO order_status_history (
+    order_id,
+    from_status,
+    to_status,
+    changed_by
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING id, order_id, from_status, to_status, changed_by, created_at
+`
+
+type CreateOrderStatusHistoryParams struct {
+	OrderID    int64
+	FromStatus string
+	ToStatus   string
+	ChangedBy  string
+}
+
+func (q *Queries) CreateOrderStatusHistory(ctx context.Context, arg CreateOrderStatusHistoryParams) (OrderStatusHistory, error) {
+	row := q.db.QueryRowContext(ctx, createOrderStatusHistory,
+		arg.OrderID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.ChangedBy,
+	)
+	var i OrderStatusHistory
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.ChangedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listOrderStatusHistory = `-- name: ListOrderStatusHistory :many
+SELECT id, order_id, from_status, to_status, changed_by, created_at FROM order_status_history
+WHERE order_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListOrderStatusHistory(ctx context.Context, orderID int64) ([]OrderStatusHistory, error) {
+	rows, err := q.db.QueryContext(ctx, listOrderStatusHistory, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrderStatusHistory{}
+	for rows.Next() {
+		var i OrderStatusHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.ChangedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
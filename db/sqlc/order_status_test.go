@@ -0,0 +1,46 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransitionOrderStatus(t *testing.T) {
+	testCases := []struct {
+		from string
+		to   string
+		ok   bool
+	}{
+		{OrderStatusPending, OrderStatusConfirmed, true},
+		{OrderStatusPending, OrderStatusCancelled, true},
+		{OrderStatusPending, OrderStatusServed, false},
+		{OrderStatusConfirmed, OrderStatusPreparing, true},
+		{OrderStatusConfirmed, OrderStatusPending, false},
+		{OrderStatusPreparing, OrderStatusServed, true},
+		{OrderStatusPreparing, OrderStatusCancelled, true},
+		{OrderStatusServed, OrderStatusPaid, true},
+		{OrderStatusServed, OrderStatusPreparing, true},
+		{OrderStatusServed, OrderStatusCancelled, false},
+		{OrderStatusPaid, OrderStatusPending, false},
+		{OrderStatusCancelled, OrderStatusPending, false},
+		{"unknown", OrderStatusPending, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.ok, CanTransitionOrderStatus(tc.from, tc.to), "%s -> %s", tc.from, tc.to)
+	}
+}
+
+func TestIsValidOrderStatus(t *testing.T) {
+	require.True(t, IsValidOrderStatus(OrderStatusPaid))
+	require.False(t, IsValidOrderStatus("done"))
+}
+
+func TestIsOrderOpen(t *testing.T) {
+	require.True(t, IsOrderOpen(OrderStatusPending))
+	require.True(t, IsOrderOpen(OrderStatusServed))
+	require.False(t, IsOrderOpen(OrderStatusPaid))
+	require.False(t, IsOrderOpen(OrderStatusCancelled))
+	require.False(t, IsOrderOpen("done"))
+}
@@ -0,0 +1,84 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: password_reset.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createPasswordReset = `-- name: CreatePasswordReset :one
+INSERT INTO password_resets (
+    user_id,
+    token_hash,
+    client_ip,
+    expired_at
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, user_id, token_hash, client_ip, is_used, created_at, expired_at
+`
+
+type CreatePasswordResetParams struct {
+	UserID    int64
+	TokenHash string
+	ClientIp  string
+	ExpiredAt time.Time
+}
+
+func (q *Queries) CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error) {
+	row := q.db.QueryRowContext(ctx, createPasswordReset,
+		arg.UserID,
+		arg.TokenHash,
+		arg.ClientIp,
+		arg.ExpiredAt,
+	)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ClientIp,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const invalidateUserPasswordResets = `-- name: InvalidateUserPasswordResets :exec
+UPDATE password_resets
+SET is_used = TRUE
+WHERE user_id = $1 AND is_used = FALSE
+`
+
+func (q *Queries) InvalidateUserPasswordResets(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, invalidateUserPasswordResets, userID)
+	return err
+}
+
+const usePasswordReset = `-- name: UsePasswordReset :one
+UPDATE password_resets
+SET is_used = TRUE
+WHERE token_hash = $1
+    AND is_used = FALSE
+    AND expired_at > now()
+RETURNING id, user_id, token_hash, client_ip, is_used, created_at, expired_at
+`
+
+func (q *Queries) UsePasswordReset(ctx context.Context, tokenHash string) (PasswordReset, error) {
+	row := q.db.QueryRowContext(ctx, usePasswordReset, tokenHash)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.ClientIp,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/datmaithanh/orderfood/money"
 )
 
 const createPayment = `-- name: CreatePayment :one
@@ -21,7 +23,7 @@ INSERT INTO payments (
 
 type CreatePaymentParams struct {
 	OrderID       int64
-	Amount        string
+	Amount        money.Amount
 	PaymentMethod string
 }
 
@@ -6,24 +6,49 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
+	"github.com/datmaithanh/orderfood/money"
 	"github.com/google/uuid"
 )
 
 type Querier interface {
 	BlockSession(ctx context.Context, id uuid.UUID) error
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) (int64, error)
+	BlockUserSession(ctx context.Context, arg BlockUserSessionParams) (Session, error)
+	BlockUserSessions(ctx context.Context, userID int64) (int64, error)
+	CountMenuOptionsByGroup(ctx context.Context, optionGroupID int64) (int64, error)
+	CountOrderItemsNotReady(ctx context.Context, orderID int64) (int64, error)
+	CreateAvailabilityException(ctx context.Context, arg CreateAvailabilityExceptionParams) (AvailabilityException, error)
+	CreateAvailabilityWindow(ctx context.Context, arg CreateAvailabilityWindowParams) (AvailabilityWindow, error)
 	CreateCategory(ctx context.Context, name string) (Category, error)
 	CreateCustomer(ctx context.Context, arg CreateCustomerParams) (Customer, error)
+	CreateLoginAttempt(ctx context.Context, arg CreateLoginAttemptParams) (LoginAttempt, error)
 	CreateMenu(ctx context.Context, arg CreateMenuParams) (Menu, error)
+	CreateMenuOption(ctx context.Context, arg CreateMenuOptionParams) (MenuOption, error)
+	CreateMenuVariant(ctx context.Context, arg CreateMenuVariantParams) (MenuVariant, error)
+	CreateOptionGroup(ctx context.Context, arg CreateOptionGroupParams) (OptionGroup, error)
 	CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error)
 	CreateOrderItem(ctx context.Context, arg CreateOrderItemParams) (OrderItem, error)
+	CreateOrderItemOption(ctx context.Context, arg CreateOrderItemOptionParams) (OrderItemOption, error)
+	CreateOrderStatusHistory(ctx context.Context, arg CreateOrderStatusHistoryParams) (OrderStatusHistory, error)
+	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error)
 	CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error)
+	CreateSecurityEvent(ctx context.Context, arg CreateSecurityEventParams) (SecurityEvent, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTable(ctx context.Context, arg CreateTableParams) (Table, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
+	DeleteAvailabilityExceptions(ctx context.Context, arg DeleteAvailabilityExceptionsParams) error
+	DeleteAvailabilityWindows(ctx context.Context, arg DeleteAvailabilityWindowsParams) error
 	DeleteCategory(ctx context.Context, id int64) error
 	DeleteCustomer(ctx context.Context, id int64) error
+	DeleteExpiredSessions(ctx context.Context, expiredBefore time.Time) (int64, error)
 	DeleteMenu(ctx context.Context, id int64) error
+	DeleteMenuOption(ctx context.Context, id int64) error
+	DeleteMenuVariant(ctx context.Context, id int64) error
+	DeleteOptionGroup(ctx context.Context, id int64) error
 	DeleteOrder(ctx context.Context, id int64) error
 	DeleteOrderItem(ctx context.Context, id int64) error
 	DeletePayment(ctx context.Context, id int64) error
@@ -33,31 +58,70 @@ type Querier interface {
 	GetCustomer(ctx context.Context, id int64) (Customer, error)
 	GetMaxTableID(ctx context.Context) (interface{}, error)
 	GetMenu(ctx context.Context, id int64) (Menu, error)
+	GetMenuOption(ctx context.Context, id int64) (MenuOption, error)
+	GetMenuVariant(ctx context.Context, id int64) (MenuVariant, error)
+	GetMenuVariantForShare(ctx context.Context, id int64) (MenuVariant, error)
+	GetOpenOrdersTotalByTable(ctx context.Context, tableID int64) (money.Amount, error)
+	GetOptionGroup(ctx context.Context, id int64) (OptionGroup, error)
+	GetOptionGroupForUpdate(ctx context.Context, id int64) (OptionGroup, error)
 	GetOrder(ctx context.Context, id int64) (Order, error)
+	GetOrderForUpdate(ctx context.Context, id int64) (Order, error)
 	GetOrderItem(ctx context.Context, id int64) (OrderItem, error)
+	GetOrderItemForUpdate(ctx context.Context, id int64) (OrderItem, error)
 	GetPayment(ctx context.Context, id int64) (Payment, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSessionForUpdate(ctx context.Context, id uuid.UUID) (Session, error)
 	GetTable(ctx context.Context, id int64) (Table, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserByID(ctx context.Context, id int64) (User, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
+	GetUserTokenVersion(ctx context.Context, username string) (int64, error)
+	IncrementUserTokenVersion(ctx context.Context, id int64) (int64, error)
+	InvalidateUserPasswordResets(ctx context.Context, userID int64) error
+	ListActiveMenu(ctx context.Context) ([]ListActiveMenuRow, error)
+	ListAllTables(ctx context.Context) ([]Table, error)
+	ListAvailabilityExceptions(ctx context.Context, arg ListAvailabilityExceptionsParams) ([]AvailabilityException, error)
+	ListAvailabilityWindows(ctx context.Context, arg ListAvailabilityWindowsParams) ([]AvailabilityWindow, error)
 	ListCategory(ctx context.Context, arg ListCategoryParams) ([]Category, error)
 	ListCustomer(ctx context.Context, arg ListCustomerParams) ([]Customer, error)
+	ListKitchenOrderItems(ctx context.Context, categoryID sql.NullInt64) ([]ListKitchenOrderItemsRow, error)
+	ListLoginAttempts(ctx context.Context, arg ListLoginAttemptsParams) ([]LoginAttempt, error)
 	ListMenu(ctx context.Context, arg ListMenuParams) ([]Menu, error)
+	ListMenuOptionsByGroupIDs(ctx context.Context, optionGroupIds []int64) ([]MenuOption, error)
+	ListMenuVariantsByMenuIDs(ctx context.Context, menuIds []int64) ([]MenuVariant, error)
+	ListOpenOrdersByTable(ctx context.Context, tableID int64) ([]Order, error)
+	ListOptionGroupsByMenuIDs(ctx context.Context, menuIds []int64) ([]OptionGroup, error)
 	ListOrder(ctx context.Context, arg ListOrderParams) ([]Order, error)
 	ListOrderItem(ctx context.Context, arg ListOrderItemParams) ([]OrderItem, error)
+	ListOrderItemOptionsByOrderItemIDs(ctx context.Context, orderItemIds []int64) ([]OrderItemOption, error)
+	ListOrderItemsByOrderIDs(ctx context.Context, orderIds []int64) ([]OrderItem, error)
+	ListOrderStatusHistory(ctx context.Context, orderID int64) ([]OrderStatusHistory, error)
 	ListPayment(ctx context.Context, arg ListPaymentParams) ([]Payment, error)
+	ListSalesByVariant(ctx context.Context, arg ListSalesByVariantParams) ([]ListSalesByVariantRow, error)
 	ListTable(ctx context.Context, arg ListTableParams) ([]Table, error)
 	ListUser(ctx context.Context, arg ListUserParams) ([]User, error)
+	ListUserSecurityEvents(ctx context.Context, arg ListUserSecurityEventsParams) ([]SecurityEvent, error)
+	ListUserSessions(ctx context.Context, userID int64) ([]Session, error)
+	RotateSession(ctx context.Context, id uuid.UUID) (Session, error)
 	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error)
 	UpdateMenu(ctx context.Context, arg UpdateMenuParams) (Menu, error)
+	UpdateMenuVariant(ctx context.Context, arg UpdateMenuVariantParams) (MenuVariant, error)
 	UpdateOrder(ctx context.Context, arg UpdateOrderParams) (Order, error)
 	UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams) (OrderItem, error)
+	UpdateOrderItemStatus(ctx context.Context, arg UpdateOrderItemStatusParams) (OrderItem, error)
 	UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) (Order, error)
-	UpdateOrderTotalPrice(ctx context.Context, arg UpdateOrderTotalPriceParams) (Order, error)
+	UpdateOrderTotalFromItems(ctx context.Context, id int64) (Order, error)
 	UpdatePaymentStatus(ctx context.Context, arg UpdatePaymentStatusParams) (Payment, error)
 	UpdateTable(ctx context.Context, arg UpdateTableParams) (Table, error)
+	UpdateTableQR(ctx context.Context, arg UpdateTableQRParams) (Table, error)
+	UpdateTableStatus(ctx context.Context, arg UpdateTableStatusParams) (Table, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserPasswordByID(ctx context.Context, arg UpdateUserPasswordByIDParams) (User, error)
 	UpdateUserWithPassword(ctx context.Context, arg UpdateUserWithPasswordParams) (User, error)
+	UsePasswordReset(ctx context.Context, tokenHash string) (PasswordReset, error)
+	UseVerifyEmail(ctx context.Context, arg UseVerifyEmailParams) (VerifyEmail, error)
+	VerifyUserEmail(ctx context.Context, arg VerifyUserEmailParams) (User, error)
 }
 
 var _ Querier = (*Queries)(nil)
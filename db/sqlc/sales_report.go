@@ -0,0 +1,39 @@
+package db
+
+import (
+	"github.com/datmaithanh/orderfood/money"
+)
+
+// MenuSales is the sales of one menu item with its variants rolled up into it.
+type MenuSales struct {
+	MenuID   int64
+	MenuName string
+	Quantity int64
+	Revenue  money.Amount
+	Variants []ListSalesByVariantRow
+}
+
+// SummarizeSales groups per-variant sales rows, which ListSalesByVariant
+// returns ordered by menu, into one entry per menu. Sales made without a
+// variant count towards the menu only.
+func SummarizeSales(rows []ListSalesByVariantRow) []MenuSales {
+	sales := make([]MenuSales, 0)
+	for _, row := range rows {
+		n := len(sales)
+		if n == 0 || sales[n-1].MenuID != row.MenuID {
+			sales = append(sales, MenuSales{
+				MenuID:   row.MenuID,
+				MenuName: row.MenuName,
+				Variants: make([]ListSalesByVariantRow, 0),
+			})
+			n++
+		}
+		menu := &sales[n-1]
+		menu.Quantity += row.Quantity
+		menu.Revenue = menu.Revenue.Add(row.Revenue)
+		if row.VariantName != "" {
+			menu.Variants = append(menu.Variants, row)
+		}
+	}
+	return sales
+}
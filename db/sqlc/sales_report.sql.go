@@ -0,0 +1,75 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: sales_report.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/datmaithanh/orderfood/money"
+)
+
+const listSalesByVariant = `-- name: ListSalesByVariant :many
+SELECT order_item.menu_id,
+       menus.name AS menu_name,
+       order_item.variant_id,
+       order_item.variant_name,
+       SUM(order_item.quantity)::bigint AS quantity,
+       SUM((order_item.price + order_item.options_price) * order_item.quantity)::numeric(12,2) AS revenue
+FROM order_item
+JOIN orders ON orders.id = order_item.order_id
+JOIN menus ON menus.id = order_item.menu_id
+WHERE orders.status = 'paid'
+  AND orders.created_at >= $1
+  AND orders.created_at < $2
+GROUP BY order_item.menu_id, menus.name, order_item.variant_id, order_item.variant_name
+ORDER BY menus.name, order_item.menu_id, order_item.variant_name
+`
+
+type ListSalesByVariantParams struct {
+	FromTime time.Time
+	ToTime   time.Time
+}
+
+type ListSalesByVariantRow struct {
+	MenuID      int64
+	MenuName    string
+	VariantID   sql.NullInt64
+	VariantName string
+	Quantity    int64
+	Revenue     money.Amount
+}
+
+func (q *Queries) ListSalesByVariant(ctx context.Context, arg ListSalesByVariantParams) ([]ListSalesByVariantRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSalesByVariant, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSalesByVariantRow{}
+	for rows.Next() {
+		var i ListSalesByVariantRow
+		if err := rows.Scan(
+			&i.MenuID,
+			&i.MenuName,
+			&i.VariantID,
+			&i.VariantName,
+			&i.Quantity,
+			&i.Revenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
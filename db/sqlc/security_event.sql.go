@@ -0,0 +1,99 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: security_event.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createSecurityEvent = `-- name: CreateSecurityEvent :one
+INSERT INTO security_events (
+  user_id,
+  event_type,
+  session_id,
+  client_ip,
+  user_agent
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING id, user_id, event_type, session_id, client_ip, user_agent, created_at
+`
+
+type CreateSecurityEventParams struct {
+	UserID    int64
+	EventType string
+	SessionID uuid.NullUUID
+	ClientIp  string
+	UserAgent string
+}
+
+func (q *Queries) CreateSecurityEvent(ctx context.Context, arg CreateSecurityEventParams) (SecurityEvent, error) {
+	row := q.db.QueryRowContext(ctx, createSecurityEvent,
+		arg.UserID,
+		arg.EventType,
+		arg.SessionID,
+		arg.ClientIp,
+		arg.UserAgent,
+	)
+	var i SecurityEvent
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.EventType,
+		&i.SessionID,
+		&i.ClientIp,
+		&i.UserAgent,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listUserSecurityEvents = `-- name: ListUserSecurityEvents :many
+SELECT id, user_id, event_type, session_id, client_ip, user_agent, created_at FROM security_events
+WHERE user_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListUserSecurityEventsParams struct {
+	UserID int64
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) ListUserSecurityEvents(ctx context.Context, arg ListUserSecurityEventsParams) ([]SecurityEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listUserSecurityEvents, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SecurityEvent{}
+	for rows.Next() {
+		var i SecurityEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.EventType,
+			&i.SessionID,
+			&i.ClientIp,
+			&i.UserAgent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
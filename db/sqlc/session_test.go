@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSession(t *testing.T, user User, expiresAt time.Time) Session {
+	id := uuid.New()
+	session, err := testQueries.CreateSession(context.Background(), CreateSessionParams{
+		ID:           id,
+		UserID:       user.ID,
+		RefreshToken: utils.RandomString(32),
+		UserAgent:    "test",
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    expiresAt,
+		FamilyID:     id,
+	})
+	require.NoError(t, err)
+	return session
+}
+
+func TestBlockUserSession(t *testing.T) {
+	user := createRandomUser(t)
+	other := createRandomUser(t)
+	session := createRandomSession(t, user, time.Now().Add(time.Hour))
+
+	_, err := testQueries.BlockUserSession(context.Background(), BlockUserSessionParams{
+		ID:     session.ID,
+		UserID: other.ID,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	blocked, err := testQueries.BlockUserSession(context.Background(), BlockUserSessionParams{
+		ID:     session.ID,
+		UserID: user.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+}
+
+func TestBlockUserSessions(t *testing.T) {
+	user := createRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomSession(t, user, time.Now().Add(time.Hour))
+	}
+
+	count, err := testQueries.BlockUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	sessions, err := testQueries.ListUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+	for _, session := range sessions {
+		require.True(t, session.IsBlocked)
+	}
+}
+
+func TestDeleteExpiredSessions(t *testing.T) {
+	user := createRandomUser(t)
+	expired := createRandomSession(t, user, time.Now().Add(-time.Minute))
+	active := createRandomSession(t, user, time.Now().Add(time.Hour))
+
+	_, err := testQueries.DeleteExpiredSessions(context.Background(), time.Now())
+	require.NoError(t, err)
+
+	_, err = testQueries.GetSession(context.Background(), expired.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQueries.GetSession(context.Background(), active.ID)
+	require.NoError(t, err)
+}
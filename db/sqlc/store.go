@@ -9,6 +9,19 @@ import (
 type Store interface {
 	Querier
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
+	PlaceOrderTx(ctx context.Context, arg PlaceOrderTxParams) (PlaceOrderTxResult, error)
+	AddOrderItemTx(ctx context.Context, arg AddOrderItemTxParams) (AddOrderItemTxResult, error)
+	UpdateOrderItemTx(ctx context.Context, arg UpdateOrderItemTxParams) (UpdateOrderItemTxResult, error)
+	DeleteOrderItemTx(ctx context.Context, id int64) (DeleteOrderItemTxResult, error)
+	CreateOptionGroupTx(ctx context.Context, arg CreateOptionGroupTxParams) (CreateOptionGroupTxResult, error)
+	DeleteMenuOptionTx(ctx context.Context, id int64) error
+	ReplaceScheduleTx(ctx context.Context, arg ReplaceScheduleTxParams) (ReplaceScheduleTxResult, error)
+	UpdateOrderTx(ctx context.Context, arg UpdateOrderTxParams) (UpdateOrderTxResult, error)
+	UpdateOrderStatusTx(ctx context.Context, arg UpdateOrderStatusTxParams) (UpdateOrderStatusTxResult, error)
+	UpdateOrderItemStatusTx(ctx context.Context, arg UpdateOrderItemStatusTxParams) (UpdateOrderItemStatusTxResult, error)
+	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error)
+	RefreshSessionTx(ctx context.Context, arg RefreshSessionTxParams) (RefreshSessionTxResult, error)
 }
 
 type SQLStore struct {
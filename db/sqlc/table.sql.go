@@ -16,7 +16,7 @@ INSERT INTO tables (
     qr_image_url
 ) VALUES (
   $1, $2, $3
-) RETURNING id, name, qr_text, qr_image_url, status, created_at
+) RETURNING id, name, qr_text, qr_image_url, status, created_at, qr_token
 `
 
 type CreateTableParams struct {
@@ -35,6 +35,7 @@ func (q *Queries) CreateTable(ctx context.Context, arg CreateTableParams) (Table
 		&i.QrImageUrl,
 		&i.Status,
 		&i.CreatedAt,
+		&i.QrToken,
 	)
 	return i, err
 }
@@ -61,7 +62,7 @@ func (q *Queries) GetMaxTableID(ctx context.Context) (interface{}, error) {
 }
 
 const getTable = `-- name: GetTable :one
-SELECT id, name, qr_text, qr_image_url, status, created_at FROM tables
+SELECT id, name, qr_text, qr_image_url, status, created_at, qr_token FROM tables
 WHERE id = $1 LIMIT 1
 `
 
@@ -75,12 +76,49 @@ func (q *Queries) GetTable(ctx context.Context, id int64) (Table, error) {
 		&i.QrImageUrl,
 		&i.Status,
 		&i.CreatedAt,
+		&i.QrToken,
 	)
 	return i, err
 }
 
+const listAllTables = `-- name: ListAllTables :many
+SELECT id, name, qr_text, qr_image_url, status, created_at, qr_token FROM tables
+ORDER BY id
+`
+
+func (q *Queries) ListAllTables(ctx context.Context) ([]Table, error) {
+	rows, err := q.db.QueryContext(ctx, listAllTables)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Table{}
+	for rows.Next() {
+		var i Table
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.QrText,
+			&i.QrImageUrl,
+			&i.Status,
+			&i.CreatedAt,
+			&i.QrToken,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTable = `-- name: ListTable :many
-SELECT id, name, qr_text, qr_image_url, status, created_at FROM tables
+SELECT id, name, qr_text, qr_image_url, status, created_at, qr_token FROM tables
 ORDER BY id
 LIMIT $1
 OFFSET $2
@@ -107,6 +145,7 @@ func (q *Queries) ListTable(ctx context.Context, arg ListTableParams) ([]Table,
 			&i.QrImageUrl,
 			&i.Status,
 			&i.CreatedAt,
+			&i.QrToken,
 		); err != nil {
 			return nil, err
 		}
@@ -128,7 +167,7 @@ SET name = $2,
     qr_image_url = $4,
     status = $5
 WHERE id = $1
-RETURNING id, name, qr_text, qr_image_url, status, created_at
+RETURNING id, name, qr_text, qr_image_url, status, created_at, qr_token
 `
 
 type UpdateTableParams struct {
@@ -155,6 +194,70 @@ func (q *Queries) UpdateTable(ctx context.Context, arg UpdateTableParams) (Table
 		&i.QrImageUrl,
 		&i.Status,
 		&i.CreatedAt,
+		&i.QrToken,
+	)
+	return i, err
+}
+
+const updateTableQR = `-- name: UpdateTableQR :one
+UPDATE tables
+SET qr_text = $2,
+    qr_image_url = $3,
+    qr_token = $4
+WHERE id = $1
+RETURNING id, name, qr_text, qr_image_url, status, created_at, qr_token
+`
+
+type UpdateTableQRParams struct {
+	ID         int64
+	QrText     string
+	QrImageUrl string
+	QrToken    string
+}
+
+func (q *Queries) UpdateTableQR(ctx context.Context, arg UpdateTableQRParams) (Table, error) {
+	row := q.db.QueryRowContext(ctx, updateTableQR,
+		arg.ID,
+		arg.QrText,
+		arg.QrImageUrl,
+		arg.QrToken,
+	)
+	var i Table
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.QrText,
+		&i.QrImageUrl,
+		&i.Status,
+		&i.CreatedAt,
+		&i.QrToken,
+	)
+	return i, err
+}
+
+const updateTableStatus = `-- name: UpdateTableStatus :one
+UPDATE tables
+SET status = $2
+WHERE id = $1
+RETURNING id, name, qr_text, qr_image_url, status, created_at, qr_token
+`
+
+type UpdateTableStatusParams struct {
+	ID     int64
+	Status string
+}
+
+func (q *Queries) UpdateTableStatus(ctx context.Context, arg UpdateTableStatusParams) (Table, error) {
+	row := q.db.QueryRowContext(ctx, updateTableStatus, arg.ID, arg.Status)
+	var i Table
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.QrText,
+		&i.QrImageUrl,
+		&i.Status,
+		&i.CreatedAt,
+		&i.QrToken,
 	)
 	return i, err
 }
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type AddOrderItemTxParams struct {
+	OrderID   int64
+	MenuID    int64
+	VariantID int64
+	Quantity  int32
+	NoteItem  string
+	OptionIDs []int64
+	OrderedAt time.Time
+	ChangedBy string
+}
+
+type AddOrderItemTxResult struct {
+	Order     Order
+	OrderItem OrderItem
+	Options   []OrderItemOption
+	History   []OrderStatusHistory
+}
+
+// AddOrderItemTx appends an item to an open order and recomputes its total.
+// The order row is locked first so concurrent additions cannot overwrite each other.
+// A served order goes back to preparing so the kitchen picks up the new item.
+func (store *SQLStore) AddOrderItemTx(ctx context.Context, arg AddOrderItemTxParams) (AddOrderItemTxResult, error) {
+	var result AddOrderItemTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetOrderForUpdate(ctx, arg.OrderID)
+		if err != nil {
+			return err
+		}
+
+		if !IsOrderOpen(order.Status) {
+			return fmt.Errorf("%w: cannot add items to a %s order", ErrInvalidOrderStatusTransition, order.Status)
+		}
+
+		result.OrderItem, result.Options, err = placeOrderItem(ctx, q, arg.OrderID, arg.OrderedAt, PlaceOrderItem{
+			MenuID:    arg.MenuID,
+			VariantID: arg.VariantID,
+			Quantity:  arg.Quantity,
+			NoteItem:  arg.NoteItem,
+			OptionIDs: arg.OptionIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		if order.Status == OrderStatusServed {
+			var history OrderStatusHistory
+			_, history, err = changeOrderStatus(ctx, q, order, OrderStatusPreparing, arg.ChangedBy)
+			if err != nil {
+				return err
+			}
+			result.History = append(result.History, history)
+		}
+
+		result.Order, err = q.UpdateOrderTotalFromItems(ctx, arg.OrderID)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+
+	"github.com/datmaithanh/orderfood/money"
+)
+
+type NewMenuOption struct {
+	Name  string
+	Price money.Amount
+}
+
+type CreateOptionGroupTxParams struct {
+	CreateOptionGroupParams
+	Options []NewMenuOption
+}
+
+type CreateOptionGroupTxResult struct {
+	OptionGroup OptionGroup
+	Options     []MenuOption
+}
+
+// CreateOptionGroupTx creates an option group on a menu together with its
+// options, so a required group is never visible without anything to choose.
+func (store *SQLStore) CreateOptionGroupTx(ctx context.Context, arg CreateOptionGroupTxParams) (CreateOptionGroupTxResult, error) {
+	var result CreateOptionGroupTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.GetMenu(ctx, arg.MenuID)
+		if err != nil {
+			return err
+		}
+
+		result.OptionGroup, err = q.CreateOptionGroup(ctx, arg.CreateOptionGroupParams)
+		if err != nil {
+			return err
+		}
+
+		result.Options = make([]MenuOption, 0, len(arg.Options))
+		for _, option := range arg.Options {
+			menuOption, err := q.CreateMenuOption(ctx, CreateMenuOptionParams{
+				OptionGroupID: result.OptionGroup.ID,
+				Name:          option.Name,
+				Price:         option.Price,
+			})
+			if err != nil {
+				return err
+			}
+			result.Options = append(result.Options, menuOption)
+		}
+		return nil
+	})
+	return result, err
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeleteMenuOptionTx deletes an option unless that leaves its group with fewer
+// options than it requires, which would make the menu impossible to order. The
+// group row is locked so concurrent deletes cannot both pass the check.
+func (store *SQLStore) DeleteMenuOptionTx(ctx context.Context, id int64) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		option, err := q.GetMenuOption(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		group, err := q.GetOptionGroupForUpdate(ctx, option.OptionGroupID)
+		if err != nil {
+			return err
+		}
+
+		count, err := q.CountMenuOptionsByGroup(ctx, group.ID)
+		if err != nil {
+			return err
+		}
+		if count-1 < int64(group.MinSelections) {
+			return fmt.Errorf("%w: %q needs at least %d options", ErrOptionRequired, group.Name, group.MinSelections)
+		}
+
+		return q.DeleteMenuOption(ctx, id)
+	})
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// PlaceOrderItem identifies the dish by MenuID, VariantID or both; with only a
+// VariantID the menu is the variant's.
+type PlaceOrderItem struct {
+	MenuID    int64
+	VariantID int64
+	Quantity  int32
+	NoteItem  string
+	OptionIDs []int64
+}
+
+// PlaceOrderTxParams carries OrderedAt in the restaurant's timezone; every
+// item must be available at that time.
+type PlaceOrderTxParams struct {
+	UserID     sql.NullInt64
+	CustomerID sql.NullInt64
+	TableID    int64
+	Items      []PlaceOrderItem
+	OrderedAt  time.Time
+}
+
+type PlaceOrderTxResult struct {
+	Order   Order
+	Items   []OrderItem
+	Options []OrderItemOption
+}
+
+// PlaceOrderTx creates an order together with all of its items in one transaction.
+// Item and option prices are copied from menus and the order total is summed by
+// the database.
+func (store *SQLStore) PlaceOrderTx(ctx context.Context, arg PlaceOrderTxParams) (PlaceOrderTxResult, error) {
+	var result PlaceOrderTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.CreateOrder(ctx, CreateOrderParams{
+			UserID:     arg.UserID,
+			CustomerID: arg.CustomerID,
+			TableID:    arg.TableID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Items = make([]OrderItem, 0, len(arg.Items))
+		for _, item := range arg.Items {
+			orderItem, options, err := placeOrderItem(ctx, q, order.ID, arg.OrderedAt, item)
+			if err != nil {
+				return err
+			}
+			result.Items = append(result.Items, orderItem)
+			result.Options = append(result.Options, options...)
+		}
+
+		result.Order, err = q.UpdateOrderTotalFromItems(ctx, order.ID)
+		return err
+	})
+	return result, err
+}
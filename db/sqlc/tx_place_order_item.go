@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/datmaithanh/orderfood/money"
+)
+
+// placeOrderItem checks that the menu is available at orderedAt, validates the
+// chosen variant and options against it and stores the item together with a
+// snapshot of its variant and each option, so later menu changes do not alter
+// what was ordered.
+func placeOrderItem(ctx context.Context, q *Queries, orderID int64, orderedAt time.Time, item PlaceOrderItem) (OrderItem, []OrderItemOption, error) {
+	menuID, variantID, err := resolveVariant(ctx, q, item)
+	if err != nil {
+		return OrderItem{}, nil, err
+	}
+
+	err = checkMenuAvailable(ctx, q, menuID, orderedAt)
+	if err != nil {
+		return OrderItem{}, nil, err
+	}
+
+	groups, err := q.ListOptionGroupsByMenuIDs(ctx, []int64{menuID})
+	if err != nil {
+		return OrderItem{}, nil, err
+	}
+
+	groupIDs := make([]int64, 0, len(groups))
+	groupNames := make(map[int64]string, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+		groupNames[group.ID] = group.Name
+	}
+
+	options, err := q.ListMenuOptionsByGroupIDs(ctx, groupIDs)
+	if err != nil {
+		return OrderItem{}, nil, err
+	}
+
+	selected, err := SelectOptions(groups, options, item.OptionIDs)
+	if err != nil {
+		return OrderItem{}, nil, err
+	}
+
+	var optionsPrice money.Amount
+	for _, option := range selected {
+		optionsPrice = optionsPrice.Add(option.Price)
+	}
+
+	orderItem, err := q.CreateOrderItem(ctx, CreateOrderItemParams{
+		OrderID:      orderID,
+		MenuID:       menuID,
+		VariantID:    variantID,
+		Quantity:     item.Quantity,
+		OptionsPrice: optionsPrice,
+		NoteItem:     item.NoteItem,
+	})
+	if err != nil {
+		return OrderItem{}, nil, err
+	}
+
+	itemOptions := make([]OrderItemOption, 0, len(selected))
+	for _, option := range selected {
+		itemOption, err := q.CreateOrderItemOption(ctx, CreateOrderItemOptionParams{
+			OrderItemID:     orderItem.ID,
+			MenuOptionID:    sql.NullInt64{Int64: option.ID, Valid: true},
+			OptionGroupName: groupNames[option.OptionGroupID],
+			OptionName:      option.Name,
+			Price:           option.Price,
+		})
+		if err != nil {
+			return OrderItem{}, nil, err
+		}
+		itemOptions = append(itemOptions, itemOption)
+	}
+	return orderItem, itemOptions, nil
+}
+
+// resolveVariant returns the menu and variant an item is ordered by. A menu
+// that has variants can only be ordered through one of them. The variant stays
+// locked until the transaction ends so its price cannot change or disappear
+// before the item is stored.
+func resolveVariant(ctx context.Context, q *Queries, item PlaceOrderItem) (int64, sql.NullInt64, error) {
+	if item.VariantID != 0 {
+		variant, err := q.GetMenuVariantForShare(ctx, item.VariantID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return 0, sql.NullInt64{}, fmt.Errorf("%w: variant %d does not exist", ErrInvalidVariant, item.VariantID)
+			}
+			return 0, sql.NullInt64{}, err
+		}
+		if err := CheckVariant(item.MenuID, variant); err != nil {
+			return 0, sql.NullInt64{}, err
+		}
+		return variant.MenuID, sql.NullInt64{Int64: variant.ID, Valid: true}, nil
+	}
+
+	variants, err := q.ListMenuVariantsByMenuIDs(ctx, []int64{item.MenuID})
+	if err != nil {
+		return 0, sql.NullInt64{}, err
+	}
+	if len(variants) > 0 {
+		return 0, sql.NullInt64{}, fmt.Errorf("%w: menu %d must be ordered by one of its variants", ErrInvalidVariant, item.MenuID)
+	}
+	return item.MenuID, sql.NullInt64{}, nil
+}
+
+// checkMenuAvailable refuses deactivated menus and applies the availability
+// schedules of the menu and its category.
+func checkMenuAvailable(ctx context.Context, q *Queries, menuID int64, orderedAt time.Time) error {
+	menu, err := q.GetMenu(ctx, menuID)
+	if err != nil {
+		return err
+	}
+
+	if !menu.Status {
+		return fmt.Errorf("%w: %q is deactivated", ErrMenuUnavailable, menu.Name)
+	}
+
+	arg := ListAvailabilityWindowsParams{MenuIds: []int64{menu.ID}, CategoryIds: []int64{menu.CategoryID}}
+	windows, err := q.ListAvailabilityWindows(ctx, arg)
+	if err != nil {
+		return err
+	}
+	exceptions, err := q.ListAvailabilityExceptions(ctx, ListAvailabilityExceptionsParams(arg))
+	if err != nil {
+		return err
+	}
+
+	if !NewAvailability(windows, exceptions).MenuAvailableAt(menu.ID, menu.CategoryID, orderedAt) {
+		return fmt.Errorf("%w: %q cannot be ordered at %s", ErrMenuUnavailable, menu.Name, orderedAt.Format("Mon 15:04"))
+	}
+	return nil
+}
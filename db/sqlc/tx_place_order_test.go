@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomOrderFixtures(t *testing.T) (User, Customer, Table, []Menu) {
+	ctx := context.Background()
+
+	user, err := testQueries.CreateUser(ctx, CreateUserParams{
+		Username:     utils.RandomString(8),
+		HashPassword: "secret",
+		FullName:     utils.RandomString(6),
+		Email:        utils.RandomString(8) + "@gmail.com",
+	})
+	require.NoError(t, err)
+
+	customer, err := testQueries.CreateCustomer(ctx, CreateCustomerParams{
+		FullName:    utils.RandomString(6),
+		PhoneNumber: utils.RandomString(10),
+		Email:       utils.RandomString(8) + "@gmail.com",
+	})
+	require.NoError(t, err)
+
+	table, err := testQueries.CreateTable(ctx, CreateTableParams{
+		Name: utils.RandomString(6),
+	})
+	require.NoError(t, err)
+
+	category, err := testQueries.CreateCategory(ctx, utils.RandomString(8))
+	require.NoError(t, err)
+
+	menus := make([]Menu, 0, 2)
+	for _, price := range []money.Amount{money.MustParse("15000.50"), money.MustParse("20000.25")} {
+		menu, err := testQueries.CreateMenu(ctx, CreateMenuParams{
+			Name:       utils.RandomString(10),
+			Price:      price,
+			CategoryID: category.ID,
+		})
+		require.NoError(t, err)
+		menus = append(menus, menu)
+	}
+
+	return user, customer, table, menus
+}
+
+func TestPlaceOrderTx(t *testing.T) {
+	user, customer, table, menus := createRandomOrderFixtures(t)
+
+	result, err := testStore.PlaceOrderTx(context.Background(), PlaceOrderTxParams{
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		CustomerID: sql.NullInt64{Int64: customer.ID, Valid: true},
+		TableID:    table.ID,
+		Items: []PlaceOrderItem{
+			{MenuID: menus[0].ID, Quantity: 3},
+			{MenuID: menus[1].ID, Quantity: 1, NoteItem: "no ice"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 2)
+
+	require.Equal(t, menus[0].Price, result.Items[0].Price)
+	require.Equal(t, menus[1].Price, result.Items[1].Price)
+	require.Equal(t, "no ice", result.Items[1].NoteItem)
+	require.Equal(t, money.MustParse("65001.75"), result.Order.TotalPrice)
+	require.Equal(t, money.Sum(menus[0].Price.Mul(3), menus[1].Price), result.Order.TotalPrice)
+}
+
+func TestPlaceOrderTxUnknownMenu(t *testing.T) {
+	user, customer, table, menus := createRandomOrderFixtures(t)
+
+	_, err := testStore.PlaceOrderTx(context.Background(), PlaceOrderTxParams{
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		CustomerID: sql.NullInt64{Int64: customer.ID, Valid: true},
+		TableID:    table.ID,
+		Items: []PlaceOrderItem{
+			{MenuID: menus[0].ID, Quantity: 1},
+			{MenuID: menus[1].ID + 1000000, Quantity: 1},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestAddOrderItemTxConcurrent(t *testing.T) {
+	user, customer, table, menus := createRandomOrderFixtures(t)
+
+	placed, err := testStore.PlaceOrderTx(context.Background(), PlaceOrderTxParams{
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		CustomerID: sql.NullInt64{Int64: customer.ID, Valid: true},
+		TableID:    table.ID,
+		Items:      []PlaceOrderItem{{MenuID: menus[0].ID, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	n := 5
+	errs := make(chan error)
+	for i := 0; i < n; i++ {
+		go func() {
+			_, err := testStore.AddOrderItemTx(context.Background(), AddOrderItemTxParams{
+				OrderID:  placed.Order.ID,
+				MenuID:   menus[1].ID,
+				Quantity: 2,
+			})
+			errs <- err
+		}()
+	}
+	for i := 0; i < n; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	order, err := testQueries.GetOrder(context.Background(), placed.Order.ID)
+	require.NoError(t, err)
+	require.Equal(t, money.MustParse("215003.00"), order.TotalPrice)
+}
+
+func TestAddOrderItemTxOrderStatus(t *testing.T) {
+	user, customer, table, menus := createRandomOrderFixtures(t)
+
+	placeOrder := func(status string) Order {
+		placed, err := testStore.PlaceOrderTx(context.Background(), PlaceOrderTxParams{
+			UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+			CustomerID: sql.NullInt64{Int64: customer.ID, Valid: true},
+			TableID:    table.ID,
+			Items:      []PlaceOrderItem{{MenuID: menus[0].ID, Quantity: 1}},
+		})
+		require.NoError(t, err)
+
+		order, err := testQueries.UpdateOrderStatus(context.Background(), UpdateOrderStatusParams{
+			ID:     placed.Order.ID,
+			Status: status,
+		})
+		require.NoError(t, err)
+		return order
+	}
+
+	for _, status := range []string{OrderStatusPaid, OrderStatusCancelled} {
+		order := placeOrder(status)
+
+		_, err := testStore.AddOrderItemTx(context.Background(), AddOrderItemTxParams{
+			OrderID:  order.ID,
+			MenuID:   menus[1].ID,
+			Quantity: 1,
+		})
+		require.ErrorIs(t, err, ErrInvalidOrderStatusTransition)
+
+		unchanged, err := testQueries.GetOrder(context.Background(), order.ID)
+		require.NoError(t, err)
+		require.Equal(t, order.TotalPrice, unchanged.TotalPrice)
+	}
+
+	served := placeOrder(OrderStatusServed)
+	result, err := testStore.AddOrderItemTx(context.Background(), AddOrderItemTxParams{
+		OrderID:   served.ID,
+		MenuID:    menus[1].ID,
+		Quantity:  1,
+		ChangedBy: user.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, OrderStatusPreparing, result.Order.Status)
+	require.Equal(t, money.MustParse("35000.75"), result.Order.TotalPrice)
+	require.Len(t, result.History, 1)
+	require.Equal(t, OrderStatusServed, result.History[0].FromStatus)
+	require.Equal(t, OrderStatusPreparing, result.History[0].ToStatus)
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSessionRevoked       = errors.New("session has been revoked")
+	ErrSessionExpired       = errors.New("session has expired")
+	ErrRefreshTokenMismatch = errors.New("mismatched refresh token")
+	ErrRefreshTokenReused   = errors.New("refresh token has already been used")
+	ErrSessionOutdated      = errors.New("session was issued before the account changed")
+)
+
+const SecurityEventRefreshTokenReuse = "refresh_token_reuse"
+
+type RefreshSessionTxParams struct {
+	SessionID    uuid.UUID
+	RefreshToken string
+	// TokenVersion is the user's token version the new tokens were issued
+	// with. The refresh fails if the account changed in the meantime.
+	TokenVersion int64
+	// NewSession describes the session that replaces SessionID. Its family
+	// and parent are filled in from the rotated session.
+	NewSession CreateSessionParams
+}
+
+type RefreshSessionTxResult struct {
+	Session Session
+	Revoked int64
+}
+
+// RefreshSessionTx rotates a refresh token: the presented session is marked as
+// rotated and a new session in the same family replaces it. Presenting a token
+// that was already rotated revokes the whole family, records a security event
+// and fails with ErrRefreshTokenReused.
+func (store *SQLStore) RefreshSessionTx(ctx context.Context, arg RefreshSessionTxParams) (RefreshSessionTxResult, error) {
+	var result RefreshSessionTxResult
+	reused := false
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		session, err := q.GetSessionForUpdate(ctx, arg.SessionID)
+		if err != nil {
+			return err
+		}
+
+		if session.RefreshToken != arg.RefreshToken || session.UserID != arg.NewSession.UserID {
+			return ErrRefreshTokenMismatch
+		}
+
+		if session.RotatedAt.Valid {
+			reused = true
+			result.Revoked, err = q.BlockSessionFamily(ctx, session.FamilyID)
+			if err != nil {
+				return err
+			}
+
+			_, err = q.CreateSecurityEvent(ctx, CreateSecurityEventParams{
+				UserID:    session.UserID,
+				EventType: SecurityEventRefreshTokenReuse,
+				SessionID: uuid.NullUUID{UUID: session.ID, Valid: true},
+				ClientIp:  arg.NewSession.ClientIp,
+				UserAgent: arg.NewSession.UserAgent,
+			})
+			return err
+		}
+
+		if session.IsBlocked {
+			return ErrSessionRevoked
+		}
+		if session.ExpiresAt.Before(time.Now()) {
+			return ErrSessionExpired
+		}
+
+		user, err := q.GetUserByID(ctx, session.UserID)
+		if err != nil {
+			return err
+		}
+		if user.TokenVersion != arg.TokenVersion {
+			return ErrSessionOutdated
+		}
+
+		_, err = q.RotateSession(ctx, session.ID)
+		if err != nil {
+			return err
+		}
+
+		newSession := arg.NewSession
+		newSession.FamilyID = session.FamilyID
+		newSession.ParentID = uuid.NullUUID{UUID: session.ID, Valid: true}
+
+		result.Session, err = q.CreateSession(ctx, newSession)
+		return err
+	})
+	if err == nil && reused {
+		err = ErrRefreshTokenReused
+	}
+	return result, err
+}
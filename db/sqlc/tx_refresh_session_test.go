@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func refreshRandomSession(t *testing.T, session Session) (RefreshSessionTxResult, error) {
+	return testStore.RefreshSessionTx(context.Background(), RefreshSessionTxParams{
+		SessionID:    session.ID,
+		RefreshToken: session.RefreshToken,
+		TokenVersion: 1,
+		NewSession: CreateSessionParams{
+			ID:           uuid.New(),
+			UserID:       session.UserID,
+			RefreshToken: utils.RandomString(32),
+			UserAgent:    "test",
+			ClientIp:     "127.0.0.1",
+			ExpiresAt:    time.Now().Add(time.Hour),
+		},
+	})
+}
+
+func TestRefreshSessionTx(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user, time.Now().Add(time.Hour))
+
+	result, err := refreshRandomSession(t, session)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, result.Session.UserID)
+	require.Equal(t, session.FamilyID, result.Session.FamilyID)
+	require.Equal(t, session.ID, result.Session.ParentID.UUID)
+
+	rotated, err := testQueries.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, rotated.RotatedAt.Valid)
+	require.False(t, rotated.IsBlocked)
+
+	second, err := refreshRandomSession(t, result.Session)
+	require.NoError(t, err)
+	require.Equal(t, session.FamilyID, second.Session.FamilyID)
+}
+
+func TestRefreshSessionTxReuse(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user, time.Now().Add(time.Hour))
+
+	result, err := refreshRandomSession(t, session)
+	require.NoError(t, err)
+
+	reused, err := refreshRandomSession(t, session)
+	require.ErrorIs(t, err, ErrRefreshTokenReused)
+	require.Equal(t, int64(2), reused.Revoked)
+
+	current, err := testQueries.GetSession(context.Background(), result.Session.ID)
+	require.NoError(t, err)
+	require.True(t, current.IsBlocked)
+
+	_, err = refreshRandomSession(t, current)
+	require.ErrorIs(t, err, ErrSessionRevoked)
+
+	events, err := testQueries.ListUserSecurityEvents(context.Background(), ListUserSecurityEventsParams{
+		UserID: user.ID,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, SecurityEventRefreshTokenReuse, events[0].EventType)
+	require.Equal(t, session.ID, events[0].SessionID.UUID)
+}
+
+func TestRefreshSessionTxRejectsExpiredSession(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user, time.Now().Add(-time.Minute))
+
+	_, err := refreshRandomSession(t, session)
+	require.ErrorIs(t, err, ErrSessionExpired)
+}
+
+func TestRefreshSessionTxRejectsOutdatedSession(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user, time.Now().Add(time.Hour))
+
+	version, err := testQueries.IncrementUserTokenVersion(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), version)
+
+	_, err = refreshRandomSession(t, session)
+	require.ErrorIs(t, err, ErrSessionOutdated)
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type NewAvailabilityWindow struct {
+	DaysOfWeek  int32
+	StartMinute int32
+	EndMinute   int32
+}
+
+type NewAvailabilityException struct {
+	Date      time.Time
+	Available bool
+}
+
+// ReplaceScheduleTxParams targets either a menu or a category.
+type ReplaceScheduleTxParams struct {
+	MenuID     sql.NullInt64
+	CategoryID sql.NullInt64
+	Windows    []NewAvailabilityWindow
+	Exceptions []NewAvailabilityException
+}
+
+type ReplaceScheduleTxResult struct {
+	Schedule Schedule
+}
+
+// ReplaceScheduleTx swaps the whole availability schedule of a menu or
+// category, so it is never checked half updated. Empty windows and exceptions
+// make it always available again.
+func (store *SQLStore) ReplaceScheduleTx(ctx context.Context, arg ReplaceScheduleTxParams) (ReplaceScheduleTxResult, error) {
+	var result ReplaceScheduleTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteAvailabilityWindows(ctx, DeleteAvailabilityWindowsParams{
+			MenuID:     arg.MenuID,
+			CategoryID: arg.CategoryID,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteAvailabilityExceptions(ctx, DeleteAvailabilityExceptionsParams{
+			MenuID:     arg.MenuID,
+			CategoryID: arg.CategoryID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Schedule.Windows = make([]AvailabilityWindow, 0, len(arg.Windows))
+		for _, window := range arg.Windows {
+			availabilityWindow, err := q.CreateAvailabilityWindow(ctx, CreateAvailabilityWindowParams{
+				MenuID:      arg.MenuID,
+				CategoryID:  arg.CategoryID,
+				DaysOfWeek:  window.DaysOfWeek,
+				StartMinute: window.StartMinute,
+				EndMinute:   window.EndMinute,
+			})
+			if err != nil {
+				return err
+			}
+			result.Schedule.Windows = append(result.Schedule.Windows, availabilityWindow)
+		}
+
+		result.Schedule.Exceptions = make([]AvailabilityException, 0, len(arg.Exceptions))
+		for _, exception := range arg.Exceptions {
+			availabilityException, err := q.CreateAvailabilityException(ctx, CreateAvailabilityExceptionParams{
+				MenuID:        arg.MenuID,
+				CategoryID:    arg.CategoryID,
+				ExceptionDate: exception.Date,
+				Available:     exception.Available,
+			})
+			if err != nil {
+				return err
+			}
+			result.Schedule.Exceptions = append(result.Schedule.Exceptions, availabilityException)
+		}
+		return nil
+	})
+	return result, err
+}
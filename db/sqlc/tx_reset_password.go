@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+)
+
+type ResetPasswordTxParams struct {
+	TokenHash    string
+	HashPassword string
+}
+
+type ResetPasswordTxResult struct {
+	User          User
+	PasswordReset PasswordReset
+}
+
+// ResetPasswordTx consumes the reset token, sets the new password, voids the
+// user's other reset tokens and blocks all of their sessions. It fails with
+// sql.ErrNoRows when the token is unknown, used or expired.
+func (store *SQLStore) ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error) {
+	var result ResetPasswordTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.PasswordReset, err = q.UsePasswordReset(ctx, arg.TokenHash)
+		if err != nil {
+			return err
+		}
+
+		result.User, err = q.UpdateUserPasswordByID(ctx, UpdateUserPasswordByIDParams{
+			ID:           result.PasswordReset.UserID,
+			HashPassword: arg.HashPassword,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.InvalidateUserPasswordResets(ctx, result.User.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.BlockUserSessions(ctx, result.User.ID)
+		return err
+	})
+	return result, err
+}
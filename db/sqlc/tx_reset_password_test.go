@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetPasswordTx(t *testing.T) {
+	user := createRandomUser(t)
+
+	session := createRandomSession(t, user, time.Now().Add(time.Hour))
+
+	reset, err := testQueries.CreatePasswordReset(context.Background(), CreatePasswordResetParams{
+		UserID:    user.ID,
+		TokenHash: utils.RandomString(64),
+		ClientIp:  "127.0.0.1",
+		ExpiredAt: time.Now().Add(15 * time.Minute),
+	})
+	require.NoError(t, err)
+
+	other, err := testQueries.CreatePasswordReset(context.Background(), CreatePasswordResetParams{
+		UserID:    user.ID,
+		TokenHash: utils.RandomString(64),
+		ClientIp:  "127.0.0.1",
+		ExpiredAt: time.Now().Add(15 * time.Minute),
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.ResetPasswordTx(context.Background(), ResetPasswordTxParams{
+		TokenHash:    reset.TokenHash,
+		HashPassword: "new-hash",
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, result.User.ID)
+	require.Equal(t, "new-hash", result.User.HashPassword)
+	require.True(t, result.PasswordReset.IsUsed)
+
+	blocked, err := testQueries.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+
+	for _, tokenHash := range []string{reset.TokenHash, other.TokenHash} {
+		_, err = testStore.ResetPasswordTx(context.Background(), ResetPasswordTxParams{
+			TokenHash:    tokenHash,
+			HashPassword: "another-hash",
+		})
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	}
+}
+
+func TestResetPasswordTxExpiredToken(t *testing.T) {
+	user := createRandomUser(t)
+
+	reset, err := testQueries.CreatePasswordReset(context.Background(), CreatePasswordResetParams{
+		UserID:    user.ID,
+		TokenHash: utils.RandomString(64),
+		ClientIp:  "127.0.0.1",
+		ExpiredAt: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.ResetPasswordTx(context.Background(), ResetPasswordTxParams{
+		TokenHash:    reset.TokenHash,
+		HashPassword: "new-hash",
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+)
+
+type UpdateOrderTxParams struct {
+	UpdateOrderParams
+	Status    string
+	ChangedBy string
+}
+
+type UpdateOrderTxResult struct {
+	Order Order
+}
+
+// UpdateOrderTx updates the order details and, when a different status is
+// requested, moves the order through the status lifecycle in the same transaction.
+func (store *SQLStore) UpdateOrderTx(ctx context.Context, arg UpdateOrderTxParams) (UpdateOrderTxResult, error) {
+	var result UpdateOrderTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetOrderForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if arg.Status != "" && arg.Status != order.Status {
+			_, _, err = changeOrderStatus(ctx, q, order, arg.Status, arg.ChangedBy)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Order, err = q.UpdateOrder(ctx, arg.UpdateOrderParams)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+type UpdateOrderItemTxParams struct {
+	ID       int64
+	Quantity int32
+	NoteItem string
+}
+
+type UpdateOrderItemTxResult struct {
+	Order     Order
+	OrderItem OrderItem
+}
+
+// UpdateOrderItemTx changes the quantity and note of an item and recomputes
+// the order total from its items while the order row is locked.
+func (store *SQLStore) UpdateOrderItemTx(ctx context.Context, arg UpdateOrderItemTxParams) (UpdateOrderItemTxResult, error) {
+	var result UpdateOrderItemTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, item, err := lockOrderItem(ctx, q, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		result.OrderItem, err = q.UpdateOrderItem(ctx, UpdateOrderItemParams{
+			ID:       item.ID,
+			Quantity: arg.Quantity,
+			NoteItem: arg.NoteItem,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Order, err = q.UpdateOrderTotalFromItems(ctx, item.OrderID)
+		return err
+	})
+	return result, err
+}
+
+type DeleteOrderItemTxResult struct {
+	Order     Order
+	OrderItem OrderItem
+}
+
+// DeleteOrderItemTx removes an item and recomputes the order total from the
+// remaining items while the order row is locked.
+func (store *SQLStore) DeleteOrderItemTx(ctx context.Context, id int64) (DeleteOrderItemTxResult, error) {
+	var result DeleteOrderItemTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		_, result.OrderItem, err = lockOrderItem(ctx, q, id)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteOrderItem(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		result.Order, err = q.UpdateOrderTotalFromItems(ctx, result.OrderItem.OrderID)
+		return err
+	})
+	return result, err
+}
+
+// lockOrderItem locks the parent order before the item, in the same order as
+// AddOrderItemTx, so concurrent changes to one order cannot deadlock. Items of
+// paid or cancelled orders are left untouched.
+func lockOrderItem(ctx context.Context, q *Queries, id int64) (Order, OrderItem, error) {
+	item, err := q.GetOrderItem(ctx, id)
+	if err != nil {
+		return Order{}, item, err
+	}
+
+	order, err := q.GetOrderForUpdate(ctx, item.OrderID)
+	if err != nil {
+		return order, item, err
+	}
+
+	if !IsOrderOpen(order.Status) {
+		return order, item, fmt.Errorf("%w: cannot change items of a %s order", ErrInvalidOrderStatusTransition, order.Status)
+	}
+
+	item, err = q.GetOrderItemForUpdate(ctx, id)
+	return order, item, err
+}
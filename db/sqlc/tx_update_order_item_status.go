@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+type UpdateOrderItemStatusTxParams struct {
+	OrderItemID int64
+	Status      string
+	ChangedBy   string
+}
+
+type UpdateOrderItemStatusTxResult struct {
+	OrderItem OrderItem
+	Order     Order
+	History   []OrderStatusHistory
+}
+
+// UpdateOrderItemStatusTx bumps a kitchen item to its next status. The parent
+// order moves to preparing when cooking starts and to served once every item
+// is ready, with each automatic change written to the order status history.
+func (store *SQLStore) UpdateOrderItemStatusTx(ctx context.Context, arg UpdateOrderItemStatusTxParams) (UpdateOrderItemStatusTxResult, error) {
+	var result UpdateOrderItemStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		item, err := q.GetOrderItem(ctx, arg.OrderItemID)
+		if err != nil {
+			return err
+		}
+
+		order, err := q.GetOrderForUpdate(ctx, item.OrderID)
+		if err != nil {
+			return err
+		}
+
+		item, err = q.GetOrderItemForUpdate(ctx, arg.OrderItemID)
+		if err != nil {
+			return err
+		}
+
+		switch order.Status {
+		case OrderStatusConfirmed, OrderStatusPreparing, OrderStatusServed:
+		default:
+			return fmt.Errorf("%w: order %d is %s", ErrInvalidOrderItemStatusTransition, order.ID, order.Status)
+		}
+
+		if !CanTransitionOrderItemStatus(item.Status, arg.Status) {
+			return fmt.Errorf("%w: cannot move item from %s to %s", ErrInvalidOrderItemStatusTransition, item.Status, arg.Status)
+		}
+
+		result.OrderItem, err = q.UpdateOrderItemStatus(ctx, UpdateOrderItemStatusParams{
+			ID:     item.ID,
+			Status: arg.Status,
+		})
+		if err != nil {
+			return err
+		}
+
+		if order.Status == OrderStatusConfirmed {
+			var history OrderStatusHistory
+			order, history, err = changeOrderStatus(ctx, q, order, OrderStatusPreparing, arg.ChangedBy)
+			if err != nil {
+				return err
+			}
+			result.History = append(result.History, history)
+		}
+
+		if order.Status == OrderStatusPreparing {
+			notReady, err := q.CountOrderItemsNotReady(ctx, order.ID)
+			if err != nil {
+				return err
+			}
+			if notReady == 0 {
+				var history OrderStatusHistory
+				order, history, err = changeOrderStatus(ctx, q, order, OrderStatusServed, arg.ChangedBy)
+				if err != nil {
+					return err
+				}
+				result.History = append(result.History, history)
+			}
+		}
+
+		result.Order = order
+		return nil
+	})
+	return result, err
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateOrderItemStatusTxAdvancesOrder(t *testing.T) {
+	user, customer, table, menus := createRandomOrderFixtures(t)
+
+	placed, err := testStore.PlaceOrderTx(context.Background(), PlaceOrderTxParams{
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		CustomerID: sql.NullInt64{Int64: customer.ID, Valid: true},
+		TableID:    table.ID,
+		Items: []PlaceOrderItem{
+			{MenuID: menus[0].ID, Quantity: 1},
+			{MenuID: menus[1].ID, Quantity: 1},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.UpdateOrderItemStatusTx(context.Background(), UpdateOrderItemStatusTxParams{
+		OrderItemID: placed.Items[0].ID,
+		Status:      OrderItemStatusCooking,
+		ChangedBy:   user.Username,
+	})
+	require.ErrorIs(t, err, ErrInvalidOrderItemStatusTransition)
+
+	_, err = testStore.UpdateOrderStatusTx(context.Background(), UpdateOrderStatusTxParams{
+		OrderID:   placed.Order.ID,
+		Status:    OrderStatusConfirmed,
+		ChangedBy: user.Username,
+	})
+	require.NoError(t, err)
+
+	bump := func(itemID int64, status string) UpdateOrderItemStatusTxResult {
+		result, err := testStore.UpdateOrderItemStatusTx(context.Background(), UpdateOrderItemStatusTxParams{
+			OrderItemID: itemID,
+			Status:      status,
+			ChangedBy:   user.Username,
+		})
+		require.NoError(t, err)
+		require.Equal(t, status, result.OrderItem.Status)
+		return result
+	}
+
+	result := bump(placed.Items[0].ID, OrderItemStatusCooking)
+	require.Equal(t, OrderStatusPreparing, result.Order.Status)
+
+	result = bump(placed.Items[0].ID, OrderItemStatusReady)
+	require.Equal(t, OrderStatusPreparing, result.Order.Status)
+
+	bump(placed.Items[1].ID, OrderItemStatusCooking)
+	result = bump(placed.Items[1].ID, OrderItemStatusReady)
+	require.Equal(t, OrderStatusServed, result.Order.Status)
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateAndDeleteOrderItemTx(t *testing.T) {
+	user, customer, table, menus := createRandomOrderFixtures(t)
+
+	placed, err := testStore.PlaceOrderTx(context.Background(), PlaceOrderTxParams{
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		CustomerID: sql.NullInt64{Int64: customer.ID, Valid: true},
+		TableID:    table.ID,
+		Items: []PlaceOrderItem{
+			{MenuID: menus[0].ID, Quantity: 1},
+			{MenuID: menus[1].ID, Quantity: 1},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, placed.Items, 2)
+
+	updated, err := testStore.UpdateOrderItemTx(context.Background(), UpdateOrderItemTxParams{
+		ID:       placed.Items[0].ID,
+		Quantity: 3,
+		NoteItem: "no onion",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), updated.OrderItem.Quantity)
+	require.Equal(t, "no onion", updated.OrderItem.NoteItem)
+	require.Equal(t, menus[0].ID, updated.OrderItem.MenuID)
+	require.Equal(t, money.MustParse("65001.75"), updated.Order.TotalPrice)
+
+	deleted, err := testStore.DeleteOrderItemTx(context.Background(), placed.Items[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, placed.Items[1].ID, deleted.OrderItem.ID)
+	require.Equal(t, money.MustParse("45001.50"), deleted.Order.TotalPrice)
+
+	_, err = testQueries.GetOrderItem(context.Background(), placed.Items[1].ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testStore.DeleteOrderItemTx(context.Background(), placed.Items[1].ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"context"
+)
+
+type UpdateOrderStatusTxParams struct {
+	OrderID   int64
+	Status    string
+	ChangedBy string
+}
+
+type UpdateOrderStatusTxResult struct {
+	Order   Order
+	History OrderStatusHistory
+}
+
+func (store *SQLStore) UpdateOrderStatusTx(ctx context.Context, arg UpdateOrderStatusTxParams) (UpdateOrderStatusTxResult, error) {
+	var result UpdateOrderStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		order, err := q.GetOrderForUpdate(ctx, arg.OrderID)
+		if err != nil {
+			return err
+		}
+
+		result.Order, result.History, err = changeOrderStatus(ctx, q, order, arg.Status, arg.ChangedBy)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateOrderStatusTx(t *testing.T) {
+	user, customer, table, menus := createRandomOrderFixtures(t)
+
+	placed, err := testStore.PlaceOrderTx(context.Background(), PlaceOrderTxParams{
+		UserID:     sql.NullInt64{Int64: user.ID, Valid: true},
+		CustomerID: sql.NullInt64{Int64: customer.ID, Valid: true},
+		TableID:    table.ID,
+		Items:      []PlaceOrderItem{{MenuID: menus[0].ID, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.UpdateOrderStatusTx(context.Background(), UpdateOrderStatusTxParams{
+		OrderID:   placed.Order.ID,
+		Status:    OrderStatusConfirmed,
+		ChangedBy: user.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, OrderStatusConfirmed, result.Order.Status)
+	require.Equal(t, OrderStatusPending, result.History.FromStatus)
+	require.Equal(t, OrderStatusConfirmed, result.History.ToStatus)
+	require.Equal(t, user.Username, result.History.ChangedBy)
+
+	_, err = testStore.UpdateOrderStatusTx(context.Background(), UpdateOrderStatusTxParams{
+		OrderID:   placed.Order.ID,
+		Status:    OrderStatusPending,
+		ChangedBy: user.Username,
+	})
+	require.ErrorIs(t, err, ErrInvalidOrderStatusTransition)
+
+	histories, err := testQueries.ListOrderStatusHistory(context.Background(), placed.Order.ID)
+	require.NoError(t, err)
+	require.Len(t, histories, 1)
+}
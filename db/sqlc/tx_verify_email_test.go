@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// createRandomVerifyEmail stores a verification code for user and returns the
+// row together with the plaintext code.
+func createRandomVerifyEmail(t *testing.T, user User, expiredAt time.Time) (VerifyEmail, string) {
+	secretCode := utils.RandomString(32)
+	verifyEmail, err := testQueries.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+		Username:       user.Username,
+		Email:          user.Email,
+		SecretCodeHash: utils.HashSecretCode(secretCode),
+		ExpiredAt:      expiredAt,
+	})
+	require.NoError(t, err)
+	return verifyEmail, secretCode
+}
+
+func createRandomUser(t *testing.T) User {
+	user, err := testQueries.CreateUser(context.Background(), CreateUserParams{
+		Username:     utils.RandomString(6),
+		HashPassword: "secret",
+		FullName:     utils.RandomString(6),
+		Email:        utils.RandomString(6) + "@gmail.com",
+	})
+	require.NoError(t, err)
+	require.False(t, user.IsEmailVerified)
+	return user
+}
+
+func TestVerifyEmailTx(t *testing.T) {
+	user := createRandomUser(t)
+	verifyEmail, secretCode := createRandomVerifyEmail(t, user, time.Now().Add(time.Hour))
+
+	_, err := testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:        verifyEmail.ID,
+		SecretCodeHash: secretCode,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	result, err := testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:        verifyEmail.ID,
+		SecretCodeHash: utils.HashSecretCode(secretCode),
+	})
+	require.NoError(t, err)
+	require.True(t, result.VerifyEmail.IsUsed)
+	require.True(t, result.User.IsEmailVerified)
+	require.Equal(t, user.Username, result.User.Username)
+
+	_, err = testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:        verifyEmail.ID,
+		SecretCodeHash: utils.HashSecretCode(secretCode),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestVerifyEmailTxRejectsInvalidCodes(t *testing.T) {
+	user := createRandomUser(t)
+
+	expired, expiredCode := createRandomVerifyEmail(t, user, time.Now().Add(-time.Minute))
+	_, err := testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:        expired.ID,
+		SecretCodeHash: utils.HashSecretCode(expiredCode),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	valid, validCode := createRandomVerifyEmail(t, user, time.Now().Add(time.Hour))
+	_, err = testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:        valid.ID,
+		SecretCodeHash: utils.HashSecretCode(utils.RandomString(32)),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	newEmail := utils.RandomString(6) + "@gmail.com"
+	_, err = testQueries.UpdateUser(context.Background(), UpdateUserParams{
+		Username: user.Username,
+		Email:    sql.NullString{String: newEmail, Valid: true},
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailID:        valid.ID,
+		SecretCodeHash: utils.HashSecretCode(validCode),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -18,7 +18,7 @@ INSERT INTO users (
     email
 ) VALUES (
   $1, $2, $3, $4
-) RETURNING id, username, hash_password, full_name, role, email, created_at
+) RETURNING id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version
 `
 
 type CreateUserParams struct {
@@ -44,6 +44,8 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Role,
 		&i.Email,
 		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
 	)
 	return i, err
 }
@@ -59,7 +61,7 @@ func (q *Queries) DeleteUser(ctx context.Context, username string) error {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, username, hash_password, full_name, role, email, created_at FROM users
+SELECT id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version FROM users
 WHERE username = $1 LIMIT 1
 `
 
@@ -74,12 +76,58 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.Role,
 		&i.Email,
 		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version FROM users
+WHERE email = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashPassword,
+		&i.FullName,
+		&i.Role,
+		&i.Email,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version FROM users
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashPassword,
+		&i.FullName,
+		&i.Role,
+		&i.Email,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, hash_password, full_name, role, email, created_at FROM users
+SELECT id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version FROM users
 WHERE username = $1 LIMIT 1
 `
 
@@ -94,12 +142,40 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 		&i.Role,
 		&i.Email,
 		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
 	)
 	return i, err
 }
 
+const getUserTokenVersion = `-- name: GetUserTokenVersion :one
+SELECT token_version FROM users
+WHERE username = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserTokenVersion(ctx context.Context, username string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getUserTokenVersion, username)
+	var token_version int64
+	err := row.Scan(&token_version)
+	return token_version, err
+}
+
+const incrementUserTokenVersion = `-- name: IncrementUserTokenVersion :one
+UPDATE users
+SET token_version = token_version + 1
+WHERE id = $1
+RETURNING token_version
+`
+
+func (q *Queries) IncrementUserTokenVersion(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, incrementUserTokenVersion, id)
+	var token_version int64
+	err := row.Scan(&token_version)
+	return token_version, err
+}
+
 const listUser = `-- name: ListUser :many
-SELECT id, username, hash_password, full_name, role, email, created_at FROM users
+SELECT id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version FROM users
 ORDER BY id
 LIMIT $1
 OFFSET $2
@@ -127,6 +203,8 @@ func (q *Queries) ListUser(ctx context.Context, arg ListUserParams) ([]User, err
 			&i.Role,
 			&i.Email,
 			&i.CreatedAt,
+			&i.IsEmailVerified,
+			&i.TokenVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -146,9 +224,11 @@ UPDATE users
 SET 
     full_name = COALESCE($2, full_name),
     role = COALESCE($3, role),
-    email = COALESCE($4, email)
+    email = COALESCE($4, email),
+    is_email_verified = is_email_verified AND email = COALESCE($4, email),
+    token_version = token_version + CASE WHEN role <> COALESCE($3, role) THEN 1 ELSE 0 END
 WHERE username = $1
-RETURNING id, username, hash_password, full_name, role, email, created_at
+RETURNING id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version
 `
 
 type UpdateUserParams struct {
@@ -174,15 +254,46 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Role,
 		&i.Email,
 		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const updateUserPasswordByID = `-- name: UpdateUserPasswordByID :one
+UPDATE users
+SET hash_password = $2, token_version = token_version + 1
+WHERE id = $1
+RETURNING id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version
+`
+
+type UpdateUserPasswordByIDParams struct {
+	ID           int64
+	HashPassword string
+}
+
+func (q *Queries) UpdateUserPasswordByID(ctx context.Context, arg UpdateUserPasswordByIDParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserPasswordByID, arg.ID, arg.HashPassword)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashPassword,
+		&i.FullName,
+		&i.Role,
+		&i.Email,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
 	)
 	return i, err
 }
 
 const updateUserWithPassword = `-- name: UpdateUserWithPassword :one
 UPDATE users
-SET hash_password = $2
+SET hash_password = $2, token_version = token_version + 1
 WHERE username = $1
-RETURNING id, username, hash_password, full_name, role, email, created_at
+RETURNING id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version
 `
 
 type UpdateUserWithPasswordParams struct {
@@ -201,6 +312,37 @@ func (q *Queries) UpdateUserWithPassword(ctx context.Context, arg UpdateUserWith
 		&i.Role,
 		&i.Email,
 		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const verifyUserEmail = `-- name: VerifyUserEmail :one
+UPDATE users
+SET is_email_verified = TRUE
+WHERE username = $1 AND email = $2
+RETURNING id, username, hash_password, full_name, role, email, created_at, is_email_verified, token_version
+`
+
+type VerifyUserEmailParams struct {
+	Username string
+	Email    string
+}
+
+func (q *Queries) VerifyUserEmail(ctx context.Context, arg VerifyUserEmailParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, verifyUserEmail, arg.Username, arg.Email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.HashPassword,
+		&i.FullName,
+		&i.Role,
+		&i.Email,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.TokenVersion,
 	)
 	return i, err
 }
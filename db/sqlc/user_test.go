@@ -2,8 +2,10 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
+	"github.com/datmaithanh/orderfood/rbac"
 	"github.com/datmaithanh/orderfood/utils"
 	"github.com/stretchr/testify/require"
 )
@@ -30,6 +32,7 @@ func TestCreateUser(t *testing.T) {
 	require.Equal(t, user1.HashPassword, user2.HashPassword)
 	require.Equal(t, user1.FullName, user2.FullName)
 	require.Equal(t, user1.Email, user2.Email)
+	require.Equal(t, rbac.RolePending, user2.Role)
 
 	require.NotZero(t, user2.ID)
 	require.NotZero(t, user2.CreatedAt)
@@ -89,3 +92,34 @@ func TestDeleteUser(t *testing.T) {
 	require.Error(t, err)
 	require.Empty(t, userFetched)
 }
+
+func TestUserTokenVersion(t *testing.T) {
+	user := createRandomUser(t)
+	require.Equal(t, int64(1), user.TokenVersion)
+
+	updated, err := testQueries.UpdateUser(context.Background(), UpdateUserParams{
+		Username: user.Username,
+		FullName: sql.NullString{String: utils.RandomString(6), Valid: true},
+		Role:     sql.NullString{String: user.Role, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), updated.TokenVersion, "unchanged role keeps tokens valid")
+
+	updated, err = testQueries.UpdateUser(context.Background(), UpdateUserParams{
+		Username: user.Username,
+		Role:     sql.NullString{String: "kitchen", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.TokenVersion)
+
+	updated, err = testQueries.UpdateUserWithPassword(context.Background(), UpdateUserWithPasswordParams{
+		Username:     user.Username,
+		HashPassword: "new-secret",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), updated.TokenVersion)
+
+	version, err := testQueries.GetUserTokenVersion(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), version)
+}
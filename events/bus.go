@@ -0,0 +1,148 @@
+package events
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const subscriberBufferSize = 64
+
+var (
+	ErrEventExpired = errors.New("requested event is no longer available")
+	ErrBusClosed    = errors.New("event bus is closed")
+)
+
+type Bus interface {
+	Publish(event Event) Event
+	Subscribe(filter Filter, lastEventID uint64) (*Subscription, error)
+	Close()
+}
+
+type Subscription struct {
+	events chan Event
+	filter Filter
+	bus    *MemoryBus
+	once   sync.Once
+}
+
+// Events is closed when the subscription is closed or when the subscriber
+// falls too far behind, in which case it should resubscribe from its last event ID.
+func (sub *Subscription) Events() <-chan Event {
+	return sub.events
+}
+
+func (sub *Subscription) Close() {
+	sub.bus.unsubscribe(sub)
+}
+
+// MemoryBus is an in-process event bus that keeps the most recent events in a
+// ring buffer so that reconnecting clients can resume from an event ID.
+type MemoryBus struct {
+	mu          sync.Mutex
+	lastID      uint64
+	history     []Event
+	next        int
+	size        int
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+func NewMemoryBus(historySize int) Bus {
+	return &MemoryBus{
+		history:     make([]Event, historySize),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+func (bus *MemoryBus) Publish(event Event) Event {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	bus.lastID++
+	event.ID = bus.lastID
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	if len(bus.history) > 0 {
+		bus.history[bus.next] = event
+		bus.next = (bus.next + 1) % len(bus.history)
+		if bus.size < len(bus.history) {
+			bus.size++
+		}
+	}
+
+	for sub := range bus.subscribers {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			bus.closeLocked(sub)
+		}
+	}
+	return event
+}
+
+// Subscribe returns a subscription receiving events published after lastEventID.
+// Pass zero to receive only new events.
+func (bus *MemoryBus) Subscribe(filter Filter, lastEventID uint64) (*Subscription, error) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	if bus.closed {
+		return nil, ErrBusClosed
+	}
+
+	var missed []Event
+	if lastEventID > 0 && lastEventID < bus.lastID {
+		oldestID := bus.lastID - uint64(bus.size) + 1
+		if lastEventID+1 < oldestID {
+			return nil, ErrEventExpired
+		}
+		for i := 0; i < bus.size; i++ {
+			event := bus.history[(bus.next-bus.size+i+len(bus.history))%len(bus.history)]
+			if event.ID > lastEventID && filter.Match(event) {
+				missed = append(missed, event)
+			}
+		}
+	}
+
+	sub := &Subscription{
+		events: make(chan Event, subscriberBufferSize+len(missed)),
+		filter: filter,
+		bus:    bus,
+	}
+	for _, event := range missed {
+		sub.events <- event
+	}
+	bus.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// Close ends every open subscription so that streaming handlers return and
+// servers can shut down. Later calls to Subscribe fail with ErrBusClosed.
+func (bus *MemoryBus) Close() {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	bus.closed = true
+	for sub := range bus.subscribers {
+		bus.closeLocked(sub)
+	}
+}
+
+func (bus *MemoryBus) unsubscribe(sub *Subscription) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	bus.closeLocked(sub)
+}
+
+func (bus *MemoryBus) closeLocked(sub *Subscription) {
+	sub.once.Do(func() {
+		delete(bus.subscribers, sub)
+		close(sub.events)
+	})
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, sub *Subscription) Event {
+	select {
+	case event := <-sub.Events():
+		return event
+	default:
+		t.Fatal("expected an event")
+		return Event{}
+	}
+}
+
+func TestMemoryBusPublishSubscribe(t *testing.T) {
+	bus := NewMemoryBus(10)
+
+	all, err := bus.Subscribe(Filter{}, 0)
+	require.NoError(t, err)
+	defer all.Close()
+
+	table, err := bus.Subscribe(Filter{TableID: 2}, 0)
+	require.NoError(t, err)
+	defer table.Close()
+
+	bus.Publish(Event{Type: TypeOrderCreated, TableID: 1, Status: "pending"})
+	bus.Publish(Event{Type: TypeOrderCreated, TableID: 2, Status: "pending"})
+
+	require.Equal(t, uint64(1), receive(t, all).ID)
+	require.Equal(t, uint64(2), receive(t, all).ID)
+
+	event := receive(t, table)
+	require.Equal(t, uint64(2), event.ID)
+	require.Equal(t, int64(2), event.TableID)
+	require.False(t, event.CreatedAt.IsZero())
+	require.Empty(t, table.Events())
+}
+
+func TestMemoryBusResume(t *testing.T) {
+	bus := NewMemoryBus(3)
+	for i := 0; i < 5; i++ {
+		bus.Publish(Event{Type: TypeOrderStatusChanged, Status: "confirmed"})
+	}
+
+	sub, err := bus.Subscribe(Filter{}, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), receive(t, sub).ID)
+	require.Equal(t, uint64(5), receive(t, sub).ID)
+	sub.Close()
+
+	sub, err = bus.Subscribe(Filter{Status: "paid"}, 2)
+	require.NoError(t, err)
+	require.Empty(t, sub.Events())
+	sub.Close()
+
+	_, err = bus.Subscribe(Filter{}, 1)
+	require.ErrorIs(t, err, ErrEventExpired)
+}
+
+func TestMemoryBusDropsSlowSubscriber(t *testing.T) {
+	bus := NewMemoryBus(0)
+	sub, err := bus.Subscribe(Filter{}, 0)
+	require.NoError(t, err)
+
+	for i := 0; i < subscriberBufferSize+1; i++ {
+		bus.Publish(Event{Type: TypeOrderCreated})
+	}
+
+	count := 0
+	for range sub.Events() {
+		count++
+	}
+	require.Equal(t, subscriberBufferSize, count)
+	sub.Close()
+}
+
+func TestMemoryBusClose(t *testing.T) {
+	bus := NewMemoryBus(10)
+	sub, err := bus.Subscribe(Filter{}, 0)
+	require.NoError(t, err)
+
+	bus.Close()
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+	sub.Close()
+
+	_, err = bus.Subscribe(Filter{}, 0)
+	require.ErrorIs(t, err, ErrBusClosed)
+}
@@ -0,0 +1,43 @@
+package events
+
+import "time"
+
+const (
+	TypeOrderCreated           = "order.created"
+	TypeOrderUpdated           = "order.updated"
+	TypeOrderStatusChanged     = "order.status_changed"
+	TypeOrderDeleted           = "order.deleted"
+	TypeOrderItemCreated       = "order_item.created"
+	TypeOrderItemUpdated       = "order_item.updated"
+	TypeOrderItemStatusChanged = "order_item.status_changed"
+	TypeOrderItemDeleted       = "order_item.deleted"
+	TypePaymentCreated         = "payment.created"
+	TypePaymentStatusChanged   = "payment.status_changed"
+	TypePaymentDeleted         = "payment.deleted"
+	TypeTableStatusChanged     = "table.status_changed"
+)
+
+type Event struct {
+	ID        uint64    `json:"id"`
+	Type      string    `json:"type"`
+	EntityID  int64     `json:"entity_id"`
+	OrderID   int64     `json:"order_id,omitempty"`
+	TableID   int64     `json:"table_id,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Filter struct {
+	TableID int64
+	Status  string
+}
+
+func (filter Filter) Match(event Event) bool {
+	if filter.TableID != 0 && filter.TableID != event.TableID {
+		return false
+	}
+	if filter.Status != "" && filter.Status != event.Status {
+		return false
+	}
+	return true
+}
@@ -2,19 +2,101 @@ package gapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/rbac"
 	"github.com/datmaithanh/orderfood/token"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
+// The authorization scheme is compared in lower case, since clients send
+// "Bearer" as well as "bearer".
 const (
 	authorizationHeader     = "authorization"
-	authorizationTypeBearer = "Bearer"
+	authorizationTypeBearer = "bearer"
 )
 
+var errPermissionDenied = errors.New("permission denied")
+
+// methodPermissions declares the permission required by each protected RPC.
+// Methods that are not listed are public.
+var methodPermissions = map[string]rbac.Permission{
+	pb.OrderFoodService_UpdateUser_FullMethodName:              rbac.PermProfileUpdate,
+	pb.OrderFoodService_UpdatePasswordUser_FullMethodName:      rbac.PermProfileUpdate,
+	pb.OrderFoodService_Logout_FullMethodName:                  rbac.PermProfileUpdate,
+	pb.OrderFoodService_ListMySessions_FullMethodName:          rbac.PermProfileUpdate,
+	pb.OrderFoodService_RevokeSession_FullMethodName:           rbac.PermProfileUpdate,
+	pb.OrderFoodService_RevokeAllSessions_FullMethodName:       rbac.PermProfileUpdate,
+	pb.OrderFoodService_ListUserSessions_FullMethodName:        rbac.PermUserManage,
+	pb.OrderFoodService_RevokeUserSession_FullMethodName:       rbac.PermUserManage,
+	pb.OrderFoodService_RevokeUserSessions_FullMethodName:      rbac.PermUserManage,
+	pb.OrderFoodService_UnlockUser_FullMethodName:              rbac.PermUserManage,
+	pb.OrderFoodService_WatchOrders_FullMethodName:             rbac.PermEventsRead,
+	pb.OrderFoodService_ListCustomers_FullMethodName:           rbac.PermCustomerRead,
+	pb.OrderFoodService_DeleteCustomer_FullMethodName:          rbac.PermCustomerManage,
+	pb.OrderFoodService_CreateCategory_FullMethodName:          rbac.PermMenuManage,
+	pb.OrderFoodService_GetCategory_FullMethodName:             rbac.PermMenuRead,
+	pb.OrderFoodService_ListCategories_FullMethodName:          rbac.PermMenuRead,
+	pb.OrderFoodService_UpdateCategory_FullMethodName:          rbac.PermMenuManage,
+	pb.OrderFoodService_DeleteCategory_FullMethodName:          rbac.PermMenuManage,
+	pb.OrderFoodService_CreateMenu_FullMethodName:              rbac.PermMenuManage,
+	pb.OrderFoodService_GetMenu_FullMethodName:                 rbac.PermMenuRead,
+	pb.OrderFoodService_ListMenus_FullMethodName:               rbac.PermMenuRead,
+	pb.OrderFoodService_UpdateMenu_FullMethodName:              rbac.PermMenuManage,
+	pb.OrderFoodService_DeleteMenu_FullMethodName:              rbac.PermMenuManage,
+	pb.OrderFoodService_CreateOptionGroup_FullMethodName:       rbac.PermMenuManage,
+	pb.OrderFoodService_ListOptionGroups_FullMethodName:        rbac.PermMenuRead,
+	pb.OrderFoodService_DeleteOptionGroup_FullMethodName:       rbac.PermMenuManage,
+	pb.OrderFoodService_CreateMenuOption_FullMethodName:        rbac.PermMenuManage,
+	pb.OrderFoodService_DeleteMenuOption_FullMethodName:        rbac.PermMenuManage,
+	pb.OrderFoodService_CreateMenuVariant_FullMethodName:       rbac.PermMenuManage,
+	pb.OrderFoodService_ListMenuVariants_FullMethodName:        rbac.PermMenuRead,
+	pb.OrderFoodService_UpdateMenuVariant_FullMethodName:       rbac.PermMenuManage,
+	pb.OrderFoodService_DeleteMenuVariant_FullMethodName:       rbac.PermMenuManage,
+	pb.OrderFoodService_GetMenuSchedule_FullMethodName:         rbac.PermMenuRead,
+	pb.OrderFoodService_ReplaceMenuSchedule_FullMethodName:     rbac.PermMenuManage,
+	pb.OrderFoodService_GetCategorySchedule_FullMethodName:     rbac.PermMenuRead,
+	pb.OrderFoodService_ReplaceCategorySchedule_FullMethodName: rbac.PermMenuManage,
+	pb.OrderFoodService_ListAvailableMenus_FullMethodName:      rbac.PermMenuRead,
+	pb.OrderFoodService_CreateTable_FullMethodName:             rbac.PermTableManage,
+	pb.OrderFoodService_GetTable_FullMethodName:                rbac.PermTableRead,
+	pb.OrderFoodService_ListTables_FullMethodName:              rbac.PermTableRead,
+	pb.OrderFoodService_UpdateTableStatus_FullMethodName:       rbac.PermTableStatus,
+	pb.OrderFoodService_DeleteTable_FullMethodName:             rbac.PermTableManage,
+	pb.OrderFoodService_RotateTableQR_FullMethodName:           rbac.PermTableManage,
+	pb.OrderFoodService_ExportTableQR_FullMethodName:           rbac.PermTableManage,
+	pb.OrderFoodService_CreateOrder_FullMethodName:             rbac.PermOrderWrite,
+	pb.OrderFoodService_GetOrder_FullMethodName:                rbac.PermOrderRead,
+	pb.OrderFoodService_ListOrders_FullMethodName:              rbac.PermOrderRead,
+	pb.OrderFoodService_UpdateOrder_FullMethodName:             rbac.PermOrderWrite,
+	pb.OrderFoodService_UpdateOrderStatus_FullMethodName:       rbac.PermOrderStatus,
+	pb.OrderFoodService_DeleteOrder_FullMethodName:             rbac.PermOrderDelete,
+	pb.OrderFoodService_ListOrderStatusHistory_FullMethodName:  rbac.PermOrderRead,
+	pb.OrderFoodService_CreateOrderItem_FullMethodName:         rbac.PermOrderWrite,
+	pb.OrderFoodService_GetOrderItem_FullMethodName:            rbac.PermOrderRead,
+	pb.OrderFoodService_ListOrderItems_FullMethodName:          rbac.PermOrderRead,
+	pb.OrderFoodService_UpdateOrderItem_FullMethodName:         rbac.PermOrderWrite,
+	pb.OrderFoodService_DeleteOrderItem_FullMethodName:         rbac.PermOrderDelete,
+	pb.OrderFoodService_ListKitchenItems_FullMethodName:        rbac.PermKitchen,
+	pb.OrderFoodService_UpdateKitchenItemStatus_FullMethodName: rbac.PermKitchen,
+	pb.OrderFoodService_CreatePayment_FullMethodName:           rbac.PermPaymentWrite,
+	pb.OrderFoodService_GetPayment_FullMethodName:              rbac.PermPaymentRead,
+	pb.OrderFoodService_ListPayments_FullMethodName:            rbac.PermPaymentRead,
+	pb.OrderFoodService_UpdatePaymentStatus_FullMethodName:     rbac.PermPaymentWrite,
+	pb.OrderFoodService_DeletePayment_FullMethodName:           rbac.PermPaymentDelete,
+	pb.OrderFoodService_GetSalesReport_FullMethodName:          rbac.PermReportRead,
+}
+
 func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+	return server.authorizeMethod(ctx, rpcMethod(ctx))
+}
+
+func (server *Server) authorizeMethod(ctx context.Context, method string) (*token.Payload, error) {
 
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -43,6 +125,60 @@ func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error)
 		return nil, fmt.Errorf("invalid access token: %w", err)
 	}
 
+	if payload.Role == token.GuestRole {
+		return nil, fmt.Errorf("%w: guest sessions cannot access staff methods", errPermissionDenied)
+	}
+
+	err = server.tokenChecker.Check(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token: %w", err)
+	}
+
+	permission, ok := methodPermissions[method]
+	if ok && !rbac.Can(payload.Role, permission) {
+		return nil, fmt.Errorf("%w: role %q is not allowed to %s", errPermissionDenied, payload.Role, permission)
+	}
+
 	return payload, nil
 
 }
+
+// rpcMethod returns the full method name for both native gRPC calls and calls
+// served in-process by the HTTP gateway, which bypass the interceptors.
+func rpcMethod(ctx context.Context) string {
+	if method, ok := grpc.Method(ctx); ok && method != "" {
+		return method
+	}
+	method, _ := runtime.RPCMethod(ctx)
+	return method
+}
+
+func (server *Server) AuthorizationInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if _, ok := methodPermissions[info.FullMethod]; ok {
+		_, err := server.authorizeMethod(ctx, info.FullMethod)
+		if err != nil {
+			return nil, unauthenticatedError(err)
+		}
+	}
+	return handler(ctx, req)
+}
+
+func (server *Server) AuthorizationStreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if _, ok := methodPermissions[info.FullMethod]; ok {
+		_, err := server.authorizeMethod(stream.Context(), info.FullMethod)
+		if err != nil {
+			return unauthenticatedError(err)
+		}
+	}
+	return handler(srv, stream)
+}
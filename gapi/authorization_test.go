@@ -0,0 +1,202 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/lockout"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/revocation"
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestServer(t *testing.T) *Server {
+	config := utils.DefaultConfig()
+	config.TokenSymmetricKey = utils.RandomString(32)
+
+	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+	require.NoError(t, err)
+
+	return &Server{
+		config:       config,
+		tokenMaker:   tokenMaker,
+		tokenChecker: revocation.NewChecker(revocation.NewMemoryList(), nil),
+		loginGuard:   lockout.NewGuard(lockout.NewMemoryStore(), lockout.Policy{}, lockout.Policy{}),
+		location:     time.UTC,
+	}
+}
+
+func newContextWithBearerToken(t *testing.T, tokenMaker token.Maker, role string) context.Context {
+	accessToken, _, err := tokenMaker.CreateToken(utils.RandomString(6), role, 1, time.Minute)
+	require.NoError(t, err)
+
+	md := metadata.MD{
+		authorizationHeader: []string{fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken)},
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func okHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	server := newTestServer(t)
+	roles := []string{rbac.RoleAdmin, rbac.RoleManager, rbac.RoleCashier, rbac.RoleWaiter, rbac.RoleKitchen, rbac.RolePending, "staff", token.GuestRole}
+
+	for method, permission := range methodPermissions {
+		for _, role := range roles {
+			t.Run(fmt.Sprintf("%s/%s", method, role), func(t *testing.T) {
+				ctx := newContextWithBearerToken(t, server.tokenMaker, role)
+				info := &grpc.UnaryServerInfo{FullMethod: method}
+
+				res, err := server.AuthorizationInterceptor(ctx, nil, info, okHandler)
+				if rbac.Can(role, permission) {
+					require.NoError(t, err)
+					require.Equal(t, "ok", res)
+				} else {
+					require.Equal(t, codes.PermissionDenied, status.Code(err))
+				}
+			})
+		}
+
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, err := server.AuthorizationInterceptor(context.Background(), nil, info, okHandler)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+}
+
+func TestAuthorizationInterceptorPublicMethod(t *testing.T) {
+	server := newTestServer(t)
+
+	info := &grpc.UnaryServerInfo{FullMethod: pb.OrderFoodService_LoginUser_FullMethodName}
+	res, err := server.AuthorizationInterceptor(context.Background(), nil, info, okHandler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+}
+
+func TestAuthorizeMethodIgnoresSchemeCase(t *testing.T) {
+	server := newTestServer(t)
+	method := pb.OrderFoodService_ListMySessions_FullMethodName
+
+	accessToken, _, err := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 1, time.Minute)
+	require.NoError(t, err)
+
+	for _, scheme := range []string{"Bearer", "bearer", "BEARER"} {
+		md := metadata.MD{authorizationHeader: []string{scheme + " " + accessToken}}
+		_, err := server.authorizeMethod(metadata.NewIncomingContext(context.Background(), md), method)
+		require.NoError(t, err, scheme)
+	}
+
+	md := metadata.MD{authorizationHeader: []string{"Basic " + accessToken}}
+	_, err = server.authorizeMethod(metadata.NewIncomingContext(context.Background(), md), method)
+	require.Error(t, err)
+}
+
+func TestAuthorizeUserThroughGateway(t *testing.T) {
+	server := newTestServer(t)
+
+	for _, role := range rbac.Roles {
+		accessToken, _, err := server.tokenMaker.CreateToken(utils.RandomString(6), role, 1, time.Minute)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "/v1/update_user", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+
+		ctx, err := runtime.AnnotateIncomingContext(context.Background(), runtime.NewServeMux(), req, pb.OrderFoodService_UpdateUser_FullMethodName)
+		require.NoError(t, err)
+
+		payload, err := server.authorizeUser(ctx)
+		require.NoError(t, err)
+		require.Equal(t, role, payload.Role)
+	}
+
+	accessToken, _, err := server.tokenMaker.CreateToken(utils.RandomString(6), "staff", 1, time.Minute)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "/v1/update_user", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	ctx, err := runtime.AnnotateIncomingContext(context.Background(), runtime.NewServeMux(), req, pb.OrderFoodService_UpdateUser_FullMethodName)
+	require.NoError(t, err)
+
+	_, err = server.authorizeUser(ctx)
+	require.ErrorIs(t, err, errPermissionDenied)
+}
+
+func TestEveryMethodIsDeclared(t *testing.T) {
+	publicMethods := map[string]bool{
+		pb.OrderFoodService_CreateUser_FullMethodName:       true,
+		pb.OrderFoodService_LoginUser_FullMethodName:        true,
+		pb.OrderFoodService_RenewAccessToken_FullMethodName: true,
+		pb.OrderFoodService_CreateCustomer_FullMethodName:   true,
+		pb.OrderFoodService_GetCustomer_FullMethodName:      true,
+		pb.OrderFoodService_VerifyEmail_FullMethodName:      true,
+		pb.OrderFoodService_ForgotPassword_FullMethodName:   true,
+		pb.OrderFoodService_ResetPassword_FullMethodName:    true,
+		pb.OrderFoodService_GetTokenKeys_FullMethodName:     true,
+	}
+
+	desc := pb.OrderFoodService_ServiceDesc
+	var methods []string
+	for _, method := range desc.Methods {
+		methods = append(methods, fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName))
+	}
+	for _, stream := range desc.Streams {
+		methods = append(methods, fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName))
+	}
+
+	for _, method := range methods {
+		_, protected := methodPermissions[method]
+		require.True(t, protected || publicMethods[method], "method %s has no declared permission", method)
+	}
+}
+
+type tokenVersionStore struct {
+	db.Store
+	version int64
+}
+
+func (store *tokenVersionStore) GetUserTokenVersion(ctx context.Context, username string) (int64, error) {
+	return store.version, nil
+}
+
+func TestAuthorizeMethodRejectsRevokedAndOutdatedTokens(t *testing.T) {
+	server := newTestServer(t)
+	store := &tokenVersionStore{version: 1}
+	server.tokenChecker = revocation.NewChecker(revocation.NewMemoryList(), store)
+	method := pb.OrderFoodService_ListMySessions_FullMethodName
+
+	authorize := func(accessToken string) error {
+		md := metadata.MD{
+			authorizationHeader: []string{fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken)},
+		}
+		_, err := server.authorizeMethod(metadata.NewIncomingContext(context.Background(), md), method)
+		return err
+	}
+
+	accessToken, payload, err := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 1, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, authorize(accessToken))
+
+	store.version = 2
+	require.ErrorIs(t, authorize(accessToken), revocation.ErrTokenOutdated)
+
+	store.version = 1
+	require.NoError(t, server.tokenChecker.Revoke(context.Background(), payload))
+	require.ErrorIs(t, authorize(accessToken), revocation.ErrTokenRevoked)
+}
@@ -0,0 +1,206 @@
+package gapi
+
+import (
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertCustomer(customer db.Customer) *pb.Customer {
+	return &pb.Customer{
+		Id:          customer.ID,
+		FullName:    customer.FullName,
+		PhoneNumber: customer.PhoneNumber,
+		Email:       customer.Email,
+		CreatedAt:   timestamppb.New(customer.CreatedAt),
+	}
+}
+
+func convertCategory(category db.Category) *pb.Category {
+	return &pb.Category{
+		Id:        category.ID,
+		Name:      category.Name,
+		CreatedAt: timestamppb.New(category.CreatedAt),
+	}
+}
+
+func convertMenu(menu db.Menu) *pb.Menu {
+	return &pb.Menu{
+		Id:         menu.ID,
+		Name:       menu.Name,
+		Price:      menu.Price.String(),
+		CategoryId: menu.CategoryID,
+		Status:     menu.Status,
+		CreatedAt:  timestamppb.New(menu.CreatedAt),
+	}
+}
+
+func convertTable(table db.Table) *pb.Table {
+	return &pb.Table{
+		Id:         table.ID,
+		Name:       table.Name,
+		QrText:     table.QrText,
+		QrImageUrl: table.QrImageUrl,
+		Status:     table.Status,
+		CreatedAt:  timestamppb.New(table.CreatedAt),
+	}
+}
+
+func convertOrder(order db.Order) *pb.Order {
+	return &pb.Order{
+		Id:         order.ID,
+		CustomerId: order.CustomerID.Int64,
+		UserId:     order.UserID.Int64,
+		TableId:    order.TableID,
+		TotalPrice: order.TotalPrice.String(),
+		Status:     order.Status,
+		CreatedAt:  timestamppb.New(order.CreatedAt),
+	}
+}
+
+func convertOrderStatusHistory(history db.OrderStatusHistory) *pb.OrderStatusHistory {
+	return &pb.OrderStatusHistory{
+		Id:         history.ID,
+		OrderId:    history.OrderID,
+		FromStatus: history.FromStatus,
+		ToStatus:   history.ToStatus,
+		ChangedBy:  history.ChangedBy,
+		CreatedAt:  timestamppb.New(history.CreatedAt),
+	}
+}
+
+func convertOrderItem(item db.OrderItem, options []db.OrderItemOption) *pb.OrderItem {
+	return &pb.OrderItem{
+		Id:           item.ID,
+		OrderId:      item.OrderID,
+		MenuId:       item.MenuID,
+		Quantity:     item.Quantity,
+		Price:        item.Price.String(),
+		NoteItem:     item.NoteItem,
+		Status:       item.Status,
+		CreatedAt:    timestamppb.New(item.CreatedAt),
+		OptionsPrice: item.OptionsPrice.String(),
+		TotalPrice:   item.Total().String(),
+		Options:      convertOrderItemOptions(item.ID, options),
+		VariantId:    item.VariantID.Int64,
+		VariantName:  item.VariantName,
+	}
+}
+
+// convertOrderItemOptions picks the options chosen for one item out of the
+// options of several.
+func convertOrderItemOptions(itemID int64, options []db.OrderItemOption) []*pb.OrderItemOption {
+	converted := make([]*pb.OrderItemOption, 0)
+	for _, option := range options {
+		if option.OrderItemID != itemID {
+			continue
+		}
+		converted = append(converted, &pb.OrderItemOption{
+			OptionId:  option.MenuOptionID.Int64,
+			GroupName: option.OptionGroupName,
+			Name:      option.OptionName,
+			Price:     option.Price.String(),
+		})
+	}
+	return converted
+}
+
+func convertOptionGroups(groups []db.OptionGroup, options []db.MenuOption) []*pb.OptionGroup {
+	converted := make([]*pb.OptionGroup, 0, len(groups))
+	for _, group := range groups {
+		optionGroup := &pb.OptionGroup{
+			Id:            group.ID,
+			MenuId:        group.MenuID,
+			Name:          group.Name,
+			Required:      group.Required(),
+			MinSelections: group.MinSelections,
+			MaxSelections: group.MaxSelections,
+			CreatedAt:     timestamppb.New(group.CreatedAt),
+		}
+		for _, option := range options {
+			if option.OptionGroupID == group.ID {
+				optionGroup.Options = append(optionGroup.Options, convertMenuOption(option))
+			}
+		}
+		converted = append(converted, optionGroup)
+	}
+	return converted
+}
+
+func convertMenuOption(option db.MenuOption) *pb.MenuOption {
+	return &pb.MenuOption{
+		Id:            option.ID,
+		OptionGroupId: option.OptionGroupID,
+		Name:          option.Name,
+		Price:         option.Price.String(),
+		CreatedAt:     timestamppb.New(option.CreatedAt),
+	}
+}
+
+func convertMenuVariant(variant db.MenuVariant) *pb.MenuVariant {
+	return &pb.MenuVariant{
+		Id:        variant.ID,
+		MenuId:    variant.MenuID,
+		Name:      variant.Name,
+		Price:     variant.Price.String(),
+		Available: variant.Available,
+		CreatedAt: timestamppb.New(variant.CreatedAt),
+	}
+}
+
+func convertSchedule(schedule db.Schedule, timezone string) *pb.Schedule {
+	converted := &pb.Schedule{
+		Timezone:   timezone,
+		Windows:    make([]*pb.AvailabilityWindow, 0, len(schedule.Windows)),
+		Exceptions: make([]*pb.AvailabilityException, 0, len(schedule.Exceptions)),
+	}
+	for _, window := range schedule.Windows {
+		converted.Windows = append(converted.Windows, &pb.AvailabilityWindow{
+			Id:    window.ID,
+			Days:  db.WeekdayNames(window.DaysOfWeek),
+			Start: db.FormatClock(window.StartMinute),
+			End:   db.FormatClock(window.EndMinute),
+		})
+	}
+	for _, exception := range schedule.Exceptions {
+		converted.Exceptions = append(converted.Exceptions, &pb.AvailabilityException{
+			Id:        exception.ID,
+			Date:      exception.ExceptionDate.Format(time.DateOnly),
+			Available: exception.Available,
+		})
+	}
+	return converted
+}
+
+func convertPayment(payment db.Payment) *pb.Payment {
+	return &pb.Payment{
+		Id:            payment.ID,
+		OrderId:       payment.OrderID,
+		PaymentMethod: payment.PaymentMethod,
+		Amount:        payment.Amount.String(),
+		Status:        payment.Status,
+		CreatedAt:     timestamppb.New(payment.CreatedAt),
+	}
+}
+
+func convertSession(session db.Session) *pb.Session {
+	return &pb.Session{
+		Id:        session.ID.String(),
+		UserId:    session.UserID,
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		IsBlocked: session.IsBlocked,
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+		CreatedAt: timestamppb.New(session.CreatedAt),
+	}
+}
+
+func convertSessions(sessions []db.Session) []*pb.Session {
+	result := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, convertSession(session))
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+)
+
+func (server *Server) orderTableID(ctx context.Context, orderID int64) int64 {
+	order, err := server.store.GetOrder(ctx, orderID)
+	if err != nil {
+		return 0
+	}
+	return order.TableID
+}
+
+func (server *Server) publishOrderEvent(eventType string, order db.Order) {
+	server.eventBus.Publish(events.Event{
+		Type:     eventType,
+		EntityID: order.ID,
+		OrderID:  order.ID,
+		TableID:  order.TableID,
+		Status:   order.Status,
+	})
+}
+
+func (server *Server) publishOrderItemEvent(eventType string, item db.OrderItem, tableID int64) {
+	server.eventBus.Publish(events.Event{
+		Type:     eventType,
+		EntityID: item.ID,
+		OrderID:  item.OrderID,
+		TableID:  tableID,
+		Status:   item.Status,
+	})
+}
+
+func (server *Server) publishPaymentEvent(eventType string, payment db.Payment, tableID int64) {
+	server.eventBus.Publish(events.Event{
+		Type:     eventType,
+		EntityID: payment.ID,
+		OrderID:  payment.OrderID,
+		TableID:  tableID,
+		Status:   payment.Status,
+	})
+}
+
+func (server *Server) publishTableEvent(eventType string, table db.Table) {
+	server.eventBus.Publish(events.Event{
+		Type:     eventType,
+		EntityID: table.ID,
+		TableID:  table.ID,
+		Status:   table.Status,
+	})
+}
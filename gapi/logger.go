@@ -35,6 +35,30 @@ func GrpcLoger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 	return results, err
 }
 
+func GrpcStreamLoger(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	startTime := time.Now()
+	err := handler(srv, stream)
+	duration := time.Since(startTime)
+
+	statusCode := codes.Unknown
+	if st, ok := status.FromError(err); ok {
+		statusCode = st.Code()
+	}
+
+	logger := log.Info()
+	if err != nil {
+		logger = log.Error().Err(err)
+	}
+
+	logger.Str("protocol", "gRPC").
+		Int("status_code", int(statusCode)).
+		Str("status_text", statusCode.String()).
+		Dur("duration", duration).
+		Str("method", info.FullMethod).
+		Msg("gRPC stream closed")
+	return err
+}
+
 type responseWriter struct {
 	http.ResponseWriter
 	statusCode int
@@ -64,7 +88,7 @@ func HTTPLoger(handle http.Handler) http.Handler {
 		}
 		logger.Str("protocol", "HTTP").
 		Str("method", req.Method).
-		Str("path", req.RequestURI).
+		Str("path", req.URL.Path).
 		Int("status_code", rec.statusCode).
 		Str("status_text", http.StatusText(rec.statusCode)).
 		Dur("duration", duration).
@@ -0,0 +1,26 @@
+package gapi
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPLoggerOmitsQuery(t *testing.T) {
+	var output bytes.Buffer
+	logger := log.Logger
+	log.Logger = zerolog.New(&output)
+	defer func() { log.Logger = logger }()
+
+	handler := HTTPLoger(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {}))
+	req := httptest.NewRequest(http.MethodGet, "/v1/verify_email?email_id=1&secret_code=s3cr3t", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Contains(t, output.String(), `"path":"/v1/verify_email"`)
+	require.NotContains(t, output.String(), "s3cr3t")
+}
@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/lockout"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// checkLoginAllowed returns ResourceExhausted with RetryInfo while the
+// username or the client IP has to wait before trying again.
+func (server *Server) checkLoginAllowed(ctx context.Context, username string, mtdt *Metadata) error {
+	err := server.loginGuard.Check(ctx, username, mtdt.ClientIP)
+	if err == nil {
+		return nil
+	}
+
+	var blocked *lockout.BlockedError
+	if !errors.As(err, &blocked) {
+		return status.Errorf(codes.Internal, "failed to check login attempts: %v", err)
+	}
+	if err := server.recordLoginAttempt(ctx, username, nil, db.LoginAttemptBlocked, mtdt); err != nil {
+		return err
+	}
+
+	statusBlocked := status.New(codes.ResourceExhausted, blocked.Error())
+	statusDetails, err := statusBlocked.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(blocked.RetryAfter)})
+	if err != nil {
+		return statusBlocked.Err()
+	}
+	return statusDetails.Err()
+}
+
+// loginFailed counts a failed login against the username and the client IP.
+// user is nil when the username does not exist.
+func (server *Server) loginFailed(ctx context.Context, username string, user *db.User, result string, mtdt *Metadata) error {
+	locked, err := server.loginGuard.Fail(ctx, username, mtdt.ClientIP)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record login failure: %v", err)
+	}
+	if locked && user != nil {
+		_, err = server.store.CreateSecurityEvent(ctx, db.CreateSecurityEventParams{
+			UserID:    user.ID,
+			EventType: db.SecurityEventAccountLocked,
+			ClientIp:  mtdt.ClientIP,
+			UserAgent: mtdt.UserAgent,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to record security event: %v", err)
+		}
+	}
+	return server.recordLoginAttempt(ctx, username, user, result, mtdt)
+}
+
+func (server *Server) recordLoginAttempt(ctx context.Context, username string, user *db.User, result string, mtdt *Metadata) error {
+	arg := db.CreateLoginAttemptParams{
+		Username:  username,
+		Result:    result,
+		ClientIp:  mtdt.ClientIP,
+		UserAgent: mtdt.UserAgent,
+	}
+	if user != nil {
+		arg.UserID = sql.NullInt64{Int64: user.ID, Valid: true}
+	}
+	_, err := server.store.CreateLoginAttempt(ctx, arg)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record login attempt: %v", err)
+	}
+	return nil
+}
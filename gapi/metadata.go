@@ -2,6 +2,8 @@ package gapi
 
 import (
 	"context"
+	"net"
+	"strings"
 
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -30,7 +32,7 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 		}
 		
 		if clientIPs := md.Get(xForwardedForHeader); len(clientIPs) > 0 {
-			mtdt.ClientIP = clientIPs[0]
+			mtdt.ClientIP = server.forwardedClientIP(clientIPs)
 		}
 	}
 
@@ -40,3 +42,34 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 
 	return mtdt
 }
+
+// forwardedClientIP picks the client IP from the X-Forwarded-For values set by
+// the gateway, which appends the address it was called from. Hops are read from
+// the right and the first one that is not a trusted proxy is the client; hops
+// further left were sent by the client and may be forged.
+func (server *Server) forwardedClientIP(values []string) string {
+	hops := strings.Split(strings.Join(values, ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if i == 0 || !server.isTrustedProxy(hop) {
+			return hop
+		}
+	}
+	return ""
+}
+
+func (server *Server) isTrustedProxy(address string) bool {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range server.trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestExtractMetadataClientIP(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		forwardedFor   []string
+		trustedProxies []*net.IPNet
+		clientIP       string
+	}{
+		{"direct", []string{"192.0.2.1"}, nil, "192.0.2.1"},
+		{"forged hops are ignored", []string{"203.0.113.7, 198.51.100.2, 192.0.2.1"}, nil, "192.0.2.1"},
+		{"trusted proxy", []string{"203.0.113.7, 192.0.2.1, 10.0.0.5"}, []*net.IPNet{proxies}, "192.0.2.1"},
+		{"several headers", []string{"203.0.113.7", "192.0.2.1", "10.0.0.5"}, []*net.IPNet{proxies}, "192.0.2.1"},
+		{"only proxies", []string{"10.0.0.6, 10.0.0.5"}, []*net.IPNet{proxies}, "10.0.0.6"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &Server{trustedProxies: tc.trustedProxies}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{xForwardedForHeader: tc.forwardedFor})
+			require.Equal(t, tc.clientIP, server.extractMetadata(ctx).ClientIP)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// listAvailability loads the schedules of the given menus and categories.
+func (server *Server) listAvailability(ctx context.Context, menuIDs []int64, categoryIDs []int64) (db.Availability, error) {
+	windows, err := server.store.ListAvailabilityWindows(ctx, db.ListAvailabilityWindowsParams{
+		MenuIds:     menuIDs,
+		CategoryIds: categoryIDs,
+	})
+	if err != nil {
+		return db.Availability{}, err
+	}
+
+	exceptions, err := server.store.ListAvailabilityExceptions(ctx, db.ListAvailabilityExceptionsParams{
+		MenuIds:     menuIDs,
+		CategoryIds: categoryIDs,
+	})
+	if err != nil {
+		return db.Availability{}, err
+	}
+
+	return db.NewAvailability(windows, exceptions), nil
+}
+
+func (server *Server) GetMenuSchedule(ctx context.Context, req *pb.GetMenuScheduleRequest) (*pb.GetScheduleResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateId(req.GetMenuId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("menu_id", err)})
+	}
+
+	_, err = server.store.GetMenu(ctx, req.GetMenuId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get menu: %v", err)
+	}
+
+	availability, err := server.listAvailability(ctx, []int64{req.GetMenuId()}, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list availability: %v", err)
+	}
+
+	return &pb.GetScheduleResponse{Schedule: convertSchedule(availability.MenuSchedule(req.GetMenuId()), server.location.String())}, nil
+}
+
+func (server *Server) ReplaceMenuSchedule(ctx context.Context, req *pb.ReplaceMenuScheduleRequest) (*pb.ReplaceScheduleResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidateId(req.GetMenuId()); err != nil {
+		violations = append(violations, fieldViolation("menu_id", err))
+	}
+	arg, scheduleViolations := validateSchedule(req.GetWindows(), req.GetExceptions())
+	violations = append(violations, scheduleViolations...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetMenu(ctx, req.GetMenuId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get menu: %v", err)
+	}
+
+	arg.MenuID = sql.NullInt64{Int64: req.GetMenuId(), Valid: true}
+	result, err := server.store.ReplaceScheduleTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replace menu schedule: %v", err)
+	}
+
+	return &pb.ReplaceScheduleResponse{Schedule: convertSchedule(result.Schedule, server.location.String())}, nil
+}
+
+func (server *Server) GetCategorySchedule(ctx context.Context, req *pb.GetCategoryScheduleRequest) (*pb.GetScheduleResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateId(req.GetCategoryId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("category_id", err)})
+	}
+
+	_, err = server.store.GetCategory(ctx, req.GetCategoryId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "category not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get category: %v", err)
+	}
+
+	availability, err := server.listAvailability(ctx, nil, []int64{req.GetCategoryId()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list availability: %v", err)
+	}
+
+	return &pb.GetScheduleResponse{Schedule: convertSchedule(availability.CategorySchedule(req.GetCategoryId()), server.location.String())}, nil
+}
+
+func (server *Server) ReplaceCategorySchedule(ctx context.Context, req *pb.ReplaceCategoryScheduleRequest) (*pb.ReplaceScheduleResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidateId(req.GetCategoryId()); err != nil {
+		violations = append(violations, fieldViolation("category_id", err))
+	}
+	arg, scheduleViolations := validateSchedule(req.GetWindows(), req.GetExceptions())
+	violations = append(violations, scheduleViolations...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetCategory(ctx, req.GetCategoryId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "category not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get category: %v", err)
+	}
+
+	arg.CategoryID = sql.NullInt64{Int64: req.GetCategoryId(), Valid: true}
+	result, err := server.store.ReplaceScheduleTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replace category schedule: %v", err)
+	}
+
+	return &pb.ReplaceScheduleResponse{Schedule: convertSchedule(result.Schedule, server.location.String())}, nil
+}
+
+func validateSchedule(windows []*pb.AvailabilityWindow, exceptions []*pb.AvailabilityException) (arg db.ReplaceScheduleTxParams, violations []*errdetails.BadRequest_FieldViolation) {
+	for i, window := range windows {
+		newWindow, err := db.ParseWindow(window.GetDays(), window.GetStart(), window.GetEnd())
+		if err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("windows[%d]", i), err))
+			continue
+		}
+		arg.Windows = append(arg.Windows, newWindow)
+	}
+
+	dates := make(map[time.Time]bool, len(exceptions))
+	for i, exception := range exceptions {
+		date, err := time.Parse(time.DateOnly, exception.GetDate())
+		if err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("exceptions[%d].date", i), fmt.Errorf("must be a YYYY-MM-DD date")))
+			continue
+		}
+		if dates[date] {
+			violations = append(violations, fieldViolation(fmt.Sprintf("exceptions[%d].date", i), fmt.Errorf("%s is listed more than once", exception.GetDate())))
+			continue
+		}
+		dates[date] = true
+		arg.Exceptions = append(arg.Exceptions, db.NewAvailabilityException{Date: date, Available: exception.GetAvailable()})
+	}
+
+	return arg, violations
+}
+
+func (server *Server) ListAvailableMenus(ctx context.Context, req *pb.ListAvailableMenusRequest) (*pb.ListAvailableMenusResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	activeMenus, err := server.store.ListActiveMenu(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list menus: %v", err)
+	}
+
+	menuIDs := make([]int64, 0, len(activeMenus))
+	categoryIDs := make([]int64, 0, len(activeMenus))
+	for _, menu := range activeMenus {
+		menuIDs = append(menuIDs, menu.ID)
+		categoryIDs = append(categoryIDs, menu.CategoryID)
+	}
+	availability, err := server.listAvailability(ctx, menuIDs, categoryIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list availability: %v", err)
+	}
+
+	now := server.now()
+	menus := make([]db.ListActiveMenuRow, 0, len(activeMenus))
+	menuIDs = menuIDs[:0]
+	for _, menu := range activeMenus {
+		if availability.MenuAvailableAt(menu.ID, menu.CategoryID, now) {
+			menus = append(menus, menu)
+			menuIDs = append(menuIDs, menu.ID)
+		}
+	}
+
+	variants, err := server.store.ListMenuVariantsByMenuIDs(ctx, menuIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list menu variants: %v", err)
+	}
+	groups, err := server.store.ListOptionGroupsByMenuIDs(ctx, menuIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list option groups: %v", err)
+	}
+	groupIDs := make([]int64, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+	options, err := server.store.ListMenuOptionsByGroupIDs(ctx, groupIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list menu options: %v", err)
+	}
+	optionGroups := convertOptionGroups(groups, options)
+
+	rsp := &pb.ListAvailableMenusResponse{Menus: make([]*pb.AvailableMenu, 0, len(menus))}
+	for _, menu := range menus {
+		availableMenu := &pb.AvailableMenu{
+			Menu: &pb.Menu{
+				Id:         menu.ID,
+				Name:       menu.Name,
+				Price:      menu.Price.String(),
+				CategoryId: menu.CategoryID,
+				Status:     menu.Status,
+				CreatedAt:  timestamppb.New(menu.CreatedAt),
+			},
+			CategoryName: menu.CategoryName,
+		}
+		for _, variant := range variants {
+			if variant.MenuID == menu.ID && variant.Available {
+				availableMenu.Variants = append(availableMenu.Variants, convertMenuVariant(variant))
+			}
+		}
+		for _, group := range optionGroups {
+			if group.GetMenuId() == menu.ID {
+				availableMenu.OptionGroups = append(availableMenu.OptionGroups, group)
+			}
+		}
+		rsp.Menus = append(rsp.Menus, availableMenu)
+	}
+	return rsp, nil
+}
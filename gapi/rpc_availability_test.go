@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	windows := []*pb.AvailabilityWindow{{Days: []string{"mon", "tue"}, Start: "06:00", End: "10:30"}}
+	exceptions := []*pb.AvailabilityException{{Date: "2026-12-25", Available: false}}
+
+	arg, violations := validateSchedule(windows, exceptions)
+	require.Empty(t, violations)
+	require.Equal(t, []db.NewAvailabilityWindow{{DaysOfWeek: db.WeekdayMask(time.Monday, time.Tuesday), StartMinute: 360, EndMinute: 630}}, arg.Windows)
+	require.Equal(t, []db.NewAvailabilityException{{Date: time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)}}, arg.Exceptions)
+
+	windows = append(windows, &pb.AvailabilityWindow{Days: []string{"mon"}, Start: "25:00", End: "10:00"})
+	exceptions = append(exceptions, &pb.AvailabilityException{Date: "2026-12-25", Available: true}, &pb.AvailabilityException{Date: "tomorrow"})
+	_, violations = validateSchedule(windows, exceptions)
+	var fields []string
+	for _, violation := range violations {
+		fields = append(fields, violation.GetField())
+	}
+	require.Equal(t, []string{"windows[1]", "exceptions[1].date", "exceptions[2].date"}, fields)
+}
@@ -0,0 +1,139 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCategoryName(req.GetName())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	category, err := server.store.CreateCategory(ctx, req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create category: %v", err)
+	}
+
+	return &pb.CreateCategoryResponse{Category: convertCategory(category)}, nil
+}
+
+func (server *Server) GetCategory(ctx context.Context, req *pb.GetCategoryRequest) (*pb.GetCategoryResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	category, err := server.store.GetCategory(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "category not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get category: %v", err)
+	}
+
+	return &pb.GetCategoryResponse{Category: convertCategory(category)}, nil
+}
+
+func (server *Server) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePagination(req.GetPageId(), req.GetPageSize())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	categories, err := server.store.ListCategory(ctx, db.ListCategoryParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
+	}
+
+	rsp := &pb.ListCategoriesResponse{Categories: make([]*pb.Category, 0, len(categories))}
+	for _, category := range categories {
+		rsp.Categories = append(rsp.Categories, convertCategory(category))
+	}
+	return rsp, nil
+}
+
+func (server *Server) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := append(validateID(req.GetId()), validateCategoryName(req.GetName())...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	category, err := server.store.UpdateCategory(ctx, db.UpdateCategoryParams{
+		ID:   req.GetId(),
+		Name: req.GetName(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "category not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update category: %v", err)
+	}
+
+	return &pb.UpdateCategoryResponse{Category: convertCategory(category)}, nil
+}
+
+func (server *Server) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetCategory(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "category not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get category: %v", err)
+	}
+
+	err = server.store.DeleteCategory(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete category: %v", err)
+	}
+
+	return &pb.DeleteCategoryResponse{Message: "category deleted successfully"}, nil
+}
+
+func validateCategoryName(name string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(name, 1, 100); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+	return violations
+}
@@ -55,10 +55,11 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 
 	userResponse := &pb.CreateUserResponse{
 		User: &pb.User{
-			Username:  user.User.Username,
-			FullName:  user.User.FullName,
-			Email:     user.User.Email,
-			CreatedAt: timestamppb.New(user.User.CreatedAt),
+			Username:        user.User.Username,
+			FullName:        user.User.FullName,
+			Email:           user.User.Email,
+			CreatedAt:       timestamppb.New(user.User.CreatedAt),
+			IsEmailVerified: user.User.IsEmailVerified,
 		},
 	}
 	return userResponse, nil
@@ -0,0 +1,117 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CreateCustomerResponse, error) {
+	violations := validateCreateCustomerRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	customer, err := server.store.CreateCustomer(ctx, db.CreateCustomerParams{
+		FullName:    req.GetFullName(),
+		PhoneNumber: req.GetPhoneNumber(),
+		Email:       req.GetEmail(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create customer: %v", err)
+	}
+
+	return &pb.CreateCustomerResponse{Customer: convertCustomer(customer)}, nil
+}
+
+func validateCreateCustomerRequest(req *pb.CreateCustomerRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateFullName(req.GetFullName()); err != nil {
+		violations = append(violations, fieldViolation("full_name", err))
+	}
+
+	if err := val.ValidatePhoneNumber(req.GetPhoneNumber()); err != nil {
+		violations = append(violations, fieldViolation("phone_number", err))
+	}
+
+	if err := val.ValidateEmail(req.GetEmail()); err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+
+	return violations
+}
+
+func (server *Server) GetCustomer(ctx context.Context, req *pb.GetCustomerRequest) (*pb.GetCustomerResponse, error) {
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	customer, err := server.store.GetCustomer(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "customer not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	return &pb.GetCustomerResponse{Customer: convertCustomer(customer)}, nil
+}
+
+func (server *Server) ListCustomers(ctx context.Context, req *pb.ListCustomersRequest) (*pb.ListCustomersResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePagination(req.GetPageId(), req.GetPageSize())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	customers, err := server.store.ListCustomer(ctx, db.ListCustomerParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list customers: %v", err)
+	}
+
+	rsp := &pb.ListCustomersResponse{Customers: make([]*pb.Customer, 0, len(customers))}
+	for _, customer := range customers {
+		rsp.Customers = append(rsp.Customers, convertCustomer(customer))
+	}
+	return rsp, nil
+}
+
+func (server *Server) DeleteCustomer(ctx context.Context, req *pb.DeleteCustomerRequest) (*pb.DeleteCustomerResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetCustomer(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "customer not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	err = server.store.DeleteCustomer(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete customer: %v", err)
+	}
+
+	return &pb.DeleteCustomerResponse{Message: "customer deleted successfully"}, nil
+}
@@ -20,9 +20,17 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		return nil, invalidArgumentError(violations)
 	}
 
+	mtdt := server.extractMetadata(ctx)
+	if err := server.checkLoginAllowed(ctx, req.Username, mtdt); err != nil {
+		return nil, err
+	}
+
 	user, err := server.store.GetUserByUsername(ctx, req.Username)
 	if err != nil {
 		if err == sql.ErrNoRows {
+			if err := server.loginFailed(ctx, req.Username, nil, db.LoginAttemptUnknownUser, mtdt); err != nil {
+				return nil, err
+			}
 			return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
@@ -30,22 +38,32 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 
 	err = utils.CheckPassword(req.Password, user.HashPassword)
 	if err != nil {
+		if err := server.loginFailed(ctx, req.Username, &user, db.LoginAttemptInvalidPassword, mtdt); err != nil {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, "incorrect password: %v", err)
 	}
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, utils.TokenDuration)
+	if err := server.loginGuard.Succeed(ctx, user.Username, mtdt.ClientIP); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset login attempts: %v", err)
+	}
+	if server.config.RequireVerifiedEmail && !user.IsEmailVerified {
+		if err := server.recordLoginAttempt(ctx, req.Username, &user, db.LoginAttemptEmailNotVerified, mtdt); err != nil {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.PermissionDenied, "email address is not verified")
+	}
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, user.TokenVersion, server.config.AccessTokenDuration)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create access tonken: %v", err)
 
 	}
 
-	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, utils.RefreshTokenDuration)
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, user.TokenVersion, server.config.RefreshTokenDuration)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create refresh tonken : %v", err)
 
 	}
 
-	mtdt := server.extractMetadata(ctx)
-
 	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
 		ID:           refreshPayload.ID,
 		UserID:       user.ID,
@@ -54,11 +72,16 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		ClientIp:     mtdt.ClientIP,
 		IsBlocked:    false,
 		ExpiresAt:    refreshPayload.ExpiredAt,
+		FamilyID:     refreshPayload.ID,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
 	}
 
+	if err := server.recordLoginAttempt(ctx, req.Username, &user, db.LoginAttemptSucceeded, mtdt); err != nil {
+		return nil, err
+	}
+
 	loginUserResponse := &pb.LoginUserResponse{
 		SessionId:             session.ID.String(),
 		AccessToken:           accessToken,
@@ -66,10 +89,11 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		RefreshToken:          refreshToken,
 		RefreshTokenExpiresAt: timestamppb.New(refreshPayload.ExpiredAt),
 		User: &pb.User{
-			Username:  user.Username,
-			FullName:  user.FullName,
-			Email:     user.Email,
-			CreatedAt: timestamppb.New(user.CreatedAt),
+			Username:        user.Username,
+			FullName:        user.FullName,
+			Email:           user.Email,
+			CreatedAt:       timestamppb.New(user.CreatedAt),
+			IsEmailVerified: user.IsEmailVerified,
 		},
 	}
 	return loginUserResponse, nil
@@ -0,0 +1,93 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/lockout"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type loginStore struct {
+	db.Store
+	user     db.User
+	attempts []db.CreateLoginAttemptParams
+	events   []db.CreateSecurityEventParams
+}
+
+func (store *loginStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	if username != store.user.Username {
+		return db.User{}, sql.ErrNoRows
+	}
+	return store.user, nil
+}
+
+func (store *loginStore) GetUserByID(ctx context.Context, id int64) (db.User, error) {
+	if id != store.user.ID {
+		return db.User{}, sql.ErrNoRows
+	}
+	return store.user, nil
+}
+
+func (store *loginStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+	return db.Session{ID: arg.ID, UserID: arg.UserID}, nil
+}
+
+func (store *loginStore) CreateLoginAttempt(ctx context.Context, arg db.CreateLoginAttemptParams) (db.LoginAttempt, error) {
+	store.attempts = append(store.attempts, arg)
+	return db.LoginAttempt{Username: arg.Username, Result: arg.Result}, nil
+}
+
+func (store *loginStore) CreateSecurityEvent(ctx context.Context, arg db.CreateSecurityEventParams) (db.SecurityEvent, error) {
+	store.events = append(store.events, arg)
+	return db.SecurityEvent{UserID: arg.UserID, EventType: arg.EventType}, nil
+}
+
+func TestLoginUserLockout(t *testing.T) {
+	hashedPassword, err := utils.HashPassword("secret123")
+	require.NoError(t, err)
+
+	store := &loginStore{user: db.User{ID: 1, Username: utils.RandomString(8), HashPassword: hashedPassword, Role: rbac.RoleWaiter}}
+	server := newTestServer(t)
+	server.store = store
+	server.loginGuard = lockout.NewGuard(lockout.NewMemoryStore(),
+		lockout.Policy{MaxFailures: 2, LockDuration: time.Minute, Window: time.Hour},
+		lockout.Policy{MaxFailures: 100, LockDuration: time.Minute, Window: time.Hour},
+	)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(xForwardedForHeader, "192.0.2.1"))
+	login := func(password string) error {
+		_, err := server.LoginUser(ctx, &pb.LoginUserRequest{Username: store.user.Username, Password: password})
+		return err
+	}
+
+	require.Error(t, login("wrong-password"))
+	require.Error(t, login("wrong-password"))
+	require.Len(t, store.events, 1)
+	require.Equal(t, db.SecurityEventAccountLocked, store.events[0].EventType)
+	require.Equal(t, "192.0.2.1", store.events[0].ClientIp)
+
+	err = login("secret123")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	require.InDelta(t, time.Minute, details[0].(*errdetails.RetryInfo).GetRetryDelay().AsDuration(), float64(time.Second))
+	require.Equal(t, db.LoginAttemptBlocked, store.attempts[len(store.attempts)-1].Result)
+
+	_, err = server.UnlockUser(newContextWithBearerToken(t, server.tokenMaker, rbac.RoleAdmin), &pb.UnlockUserRequest{UserId: store.user.ID})
+	require.NoError(t, err)
+	require.Equal(t, db.SecurityEventAccountUnlocked, store.events[1].EventType)
+
+	require.NoError(t, login("secret123"))
+	require.Equal(t, db.LoginAttemptSucceeded, store.attempts[len(store.attempts)-1].Result)
+}
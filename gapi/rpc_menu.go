@@ -0,0 +1,180 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateMenu(ctx context.Context, req *pb.CreateMenuRequest) (*pb.CreateMenuResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	price, violations := validateMenuFields(req.GetName(), req.GetPrice(), req.GetCategoryId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	menu, err := server.store.CreateMenu(ctx, db.CreateMenuParams{
+		Name:       req.GetName(),
+		Price:      price,
+		CategoryID: req.GetCategoryId(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create menu: %v", err)
+	}
+
+	return &pb.CreateMenuResponse{Menu: convertMenu(menu)}, nil
+}
+
+func (server *Server) GetMenu(ctx context.Context, req *pb.GetMenuRequest) (*pb.GetMenuResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	menu, err := server.store.GetMenu(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get menu: %v", err)
+	}
+
+	return &pb.GetMenuResponse{Menu: convertMenu(menu)}, nil
+}
+
+func (server *Server) ListMenus(ctx context.Context, req *pb.ListMenusRequest) (*pb.ListMenusResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePagination(req.GetPageId(), req.GetPageSize())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	menus, err := server.store.ListMenu(ctx, db.ListMenuParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list menus: %v", err)
+	}
+
+	rsp := &pb.ListMenusResponse{Menus: make([]*pb.Menu, 0, len(menus))}
+	for _, menu := range menus {
+		rsp.Menus = append(rsp.Menus, convertMenu(menu))
+	}
+	return rsp, nil
+}
+
+func (server *Server) UpdateMenu(ctx context.Context, req *pb.UpdateMenuRequest) (*pb.UpdateMenuResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	price, menuViolations := validateMenuFields(req.GetName(), req.GetPrice(), req.GetCategoryId())
+	violations := append(validateID(req.GetId()), menuViolations...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	menu, err := server.store.GetMenu(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get menu: %v", err)
+	}
+
+	active := menu.Status
+	if req.Status != nil {
+		active = req.GetStatus()
+	}
+
+	menu, err = server.store.UpdateMenu(ctx, db.UpdateMenuParams{
+		ID:         req.GetId(),
+		Name:       req.GetName(),
+		Price:      price,
+		CategoryID: req.GetCategoryId(),
+		Status:     active,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update menu: %v", err)
+	}
+
+	return &pb.UpdateMenuResponse{Menu: convertMenu(menu)}, nil
+}
+
+func (server *Server) DeleteMenu(ctx context.Context, req *pb.DeleteMenuRequest) (*pb.DeleteMenuResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetMenu(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get menu: %v", err)
+	}
+
+	err = server.store.DeleteMenu(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete menu: %v", err)
+	}
+
+	return &pb.DeleteMenuResponse{Message: "menu deleted successfully"}, nil
+}
+
+func validateMenuFields(name string, rawPrice string, categoryID int64) (price money.Amount, violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(name, 1, 100); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	price, priceViolations := validatePrice("price", rawPrice)
+	violations = append(violations, priceViolations...)
+
+	if err := val.ValidateId(categoryID); err != nil {
+		violations = append(violations, fieldViolation("category_id", err))
+	}
+
+	return price, violations
+}
+
+func validatePrice(field string, rawPrice string) (money.Amount, []*errdetails.BadRequest_FieldViolation) {
+	price, err := money.Parse(rawPrice)
+	if err == nil {
+		err = val.ValidatePrice(price)
+	}
+	if err != nil {
+		return 0, []*errdetails.BadRequest_FieldViolation{fieldViolation(field, err)}
+	}
+	return price, nil
+}
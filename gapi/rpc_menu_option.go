@@ -0,0 +1,203 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateOptionGroup(ctx context.Context, req *pb.CreateOptionGroupRequest) (*pb.CreateOptionGroupResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	options, violations := validateCreateOptionGroupRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.CreateOptionGroupTx(ctx, db.CreateOptionGroupTxParams{
+		CreateOptionGroupParams: db.CreateOptionGroupParams{
+			MenuID:        req.GetMenuId(),
+			Name:          req.GetName(),
+			MinSelections: req.GetMinSelections(),
+			MaxSelections: req.GetMaxSelections(),
+		},
+		Options: options,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create option group: %v", err)
+	}
+
+	groups := convertOptionGroups([]db.OptionGroup{result.OptionGroup}, result.Options)
+	return &pb.CreateOptionGroupResponse{OptionGroup: groups[0]}, nil
+}
+
+func validateCreateOptionGroupRequest(req *pb.CreateOptionGroupRequest) (options []db.NewMenuOption, violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetMenuId()); err != nil {
+		violations = append(violations, fieldViolation("menu_id", err))
+	}
+
+	if err := val.ValidateString(req.GetName(), 1, 100); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	if req.GetMinSelections() < 0 || int(req.GetMinSelections()) > len(req.GetOptions()) {
+		violations = append(violations, fieldViolation("min_selections", fmt.Errorf("must be between 0 and the number of options")))
+	}
+
+	if req.GetMaxSelections() < 1 || req.GetMaxSelections() < req.GetMinSelections() {
+		violations = append(violations, fieldViolation("max_selections", fmt.Errorf("must be at least 1 and not less than min_selections")))
+	}
+
+	if len(req.GetOptions()) == 0 {
+		violations = append(violations, fieldViolation("options", fmt.Errorf("option group must contain at least one option")))
+	}
+
+	for i, option := range req.GetOptions() {
+		price, optionViolations := validateMenuOptionFields(fmt.Sprintf("options[%d].", i), option.GetName(), option.GetPrice())
+		violations = append(violations, optionViolations...)
+		options = append(options, db.NewMenuOption{Name: option.GetName(), Price: price})
+	}
+
+	return options, violations
+}
+
+func validateMenuOptionFields(prefix string, name string, rawPrice string) (price money.Amount, violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(name, 1, 100); err != nil {
+		violations = append(violations, fieldViolation(prefix+"name", err))
+	}
+
+	price, priceViolations := validatePrice(prefix+"price", rawPrice)
+	violations = append(violations, priceViolations...)
+
+	return price, violations
+}
+
+func (server *Server) ListOptionGroups(ctx context.Context, req *pb.ListOptionGroupsRequest) (*pb.ListOptionGroupsResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateId(req.GetMenuId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("menu_id", err)})
+	}
+
+	groups, err := server.store.ListOptionGroupsByMenuIDs(ctx, []int64{req.GetMenuId()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list option groups: %v", err)
+	}
+
+	groupIDs := make([]int64, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+	options, err := server.store.ListMenuOptionsByGroupIDs(ctx, groupIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list menu options: %v", err)
+	}
+
+	return &pb.ListOptionGroupsResponse{OptionGroups: convertOptionGroups(groups, options)}, nil
+}
+
+func (server *Server) DeleteOptionGroup(ctx context.Context, req *pb.DeleteOptionGroupRequest) (*pb.DeleteOptionGroupResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetOptionGroup(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "option group not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get option group: %v", err)
+	}
+
+	err = server.store.DeleteOptionGroup(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete option group: %v", err)
+	}
+
+	return &pb.DeleteOptionGroupResponse{Message: "option group deleted successfully"}, nil
+}
+
+func (server *Server) CreateMenuOption(ctx context.Context, req *pb.CreateMenuOptionRequest) (*pb.CreateMenuOptionResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidateId(req.GetOptionGroupId()); err != nil {
+		violations = append(violations, fieldViolation("option_group_id", err))
+	}
+	price, optionViolations := validateMenuOptionFields("", req.GetName(), req.GetPrice())
+	violations = append(violations, optionViolations...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetOptionGroup(ctx, req.GetOptionGroupId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "option group not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get option group: %v", err)
+	}
+
+	option, err := server.store.CreateMenuOption(ctx, db.CreateMenuOptionParams{
+		OptionGroupID: req.GetOptionGroupId(),
+		Name:          req.GetName(),
+		Price:         price,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create menu option: %v", err)
+	}
+
+	return &pb.CreateMenuOptionResponse{Option: convertMenuOption(option)}, nil
+}
+
+func (server *Server) DeleteMenuOption(ctx context.Context, req *pb.DeleteMenuOptionRequest) (*pb.DeleteMenuOptionResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.store.DeleteMenuOptionTx(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrOptionRequired) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu option not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete menu option: %v", err)
+	}
+
+	return &pb.DeleteMenuOptionResponse{Message: "menu option deleted successfully"}, nil
+}
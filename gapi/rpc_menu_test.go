@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"testing"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMenuFieldsPrice(t *testing.T) {
+	price, violations := validateMenuFields("pho", "15000.5", 1)
+	require.Empty(t, violations)
+	require.Equal(t, money.MustParse("15000.50"), price)
+
+	for _, raw := range []string{"", "-1", "1.005", "1e3", "100000000"} {
+		_, violations := validateMenuFields("pho", raw, 1)
+		require.Len(t, violations, 1, raw)
+		require.Equal(t, "price", violations[0].GetField())
+	}
+}
+
+func TestValidateCreateOptionGroupRequest(t *testing.T) {
+	req := &pb.CreateOptionGroupRequest{
+		MenuId:        1,
+		Name:          "Size",
+		MinSelections: 1,
+		MaxSelections: 1,
+		Options: []*pb.MenuOptionInput{
+			{Name: "M", Price: "0"},
+			{Name: "L", Price: "5000"},
+		},
+	}
+	options, violations := validateCreateOptionGroupRequest(req)
+	require.Empty(t, violations)
+	require.Equal(t, []db.NewMenuOption{
+		{Name: "M", Price: 0},
+		{Name: "L", Price: money.MustParse("5000")},
+	}, options)
+
+	req.MinSelections = 3
+	req.Options[1].Price = "-1"
+	_, violations = validateCreateOptionGroupRequest(req)
+	var fields []string
+	for _, violation := range violations {
+		fields = append(fields, violation.GetField())
+	}
+	require.Equal(t, []string{"min_selections", "max_selections", "options[1].price"}, fields)
+}
+
+func TestValidateCreateOrderRequestVariant(t *testing.T) {
+	req := &pb.CreateOrderRequest{
+		CustomerId: 1,
+		UserId:     1,
+		TableId:    1,
+		Items: []*pb.CreateOrderItemCart{
+			{VariantId: 4, Quantity: 1},
+			{MenuId: 2, VariantId: 5, Quantity: 1},
+			{MenuId: 3, Quantity: 1},
+		},
+	}
+	require.Empty(t, validateCreateOrderRequest(req))
+
+	req.Items = []*pb.CreateOrderItemCart{
+		{Quantity: 1},
+		{VariantId: -1, Quantity: 1},
+	}
+	var fields []string
+	for _, violation := range validateCreateOrderRequest(req) {
+		fields = append(fields, violation.GetField())
+	}
+	require.Equal(t, []string{"items[0].menu_id", "items[1].variant_id"}, fields)
+}
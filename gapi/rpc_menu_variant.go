@@ -0,0 +1,150 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateMenuVariant(ctx context.Context, req *pb.CreateMenuVariantRequest) (*pb.CreateMenuVariantResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if err := val.ValidateId(req.GetMenuId()); err != nil {
+		violations = append(violations, fieldViolation("menu_id", err))
+	}
+	price, variantViolations := validateMenuVariantFields(req.GetName(), req.GetPrice())
+	violations = append(violations, variantViolations...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetMenu(ctx, req.GetMenuId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get menu: %v", err)
+	}
+
+	variant, err := server.store.CreateMenuVariant(ctx, db.CreateMenuVariantParams{
+		MenuID:    req.GetMenuId(),
+		Name:      req.GetName(),
+		Price:     price,
+		Available: req.Available == nil || req.GetAvailable(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create menu variant: %v", err)
+	}
+
+	return &pb.CreateMenuVariantResponse{Variant: convertMenuVariant(variant)}, nil
+}
+
+func validateMenuVariantFields(name string, rawPrice string) (price money.Amount, violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(name, 1, 100); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	price, priceViolations := validatePrice("price", rawPrice)
+	violations = append(violations, priceViolations...)
+
+	return price, violations
+}
+
+func (server *Server) ListMenuVariants(ctx context.Context, req *pb.ListMenuVariantsRequest) (*pb.ListMenuVariantsResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateId(req.GetMenuId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("menu_id", err)})
+	}
+
+	variants, err := server.store.ListMenuVariantsByMenuIDs(ctx, []int64{req.GetMenuId()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list menu variants: %v", err)
+	}
+
+	rsp := &pb.ListMenuVariantsResponse{Variants: make([]*pb.MenuVariant, 0, len(variants))}
+	for _, variant := range variants {
+		rsp.Variants = append(rsp.Variants, convertMenuVariant(variant))
+	}
+	return rsp, nil
+}
+
+func (server *Server) UpdateMenuVariant(ctx context.Context, req *pb.UpdateMenuVariantRequest) (*pb.UpdateMenuVariantResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	price, variantViolations := validateMenuVariantFields(req.GetName(), req.GetPrice())
+	violations = append(violations, variantViolations...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	variant, err := server.store.GetMenuVariant(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu variant not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get menu variant: %v", err)
+	}
+
+	available := variant.Available
+	if req.Available != nil {
+		available = req.GetAvailable()
+	}
+
+	variant, err = server.store.UpdateMenuVariant(ctx, db.UpdateMenuVariantParams{
+		ID:        req.GetId(),
+		Name:      req.GetName(),
+		Price:     price,
+		Available: available,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update menu variant: %v", err)
+	}
+
+	return &pb.UpdateMenuVariantResponse{Variant: convertMenuVariant(variant)}, nil
+}
+
+func (server *Server) DeleteMenuVariant(ctx context.Context, req *pb.DeleteMenuVariantRequest) (*pb.DeleteMenuVariantResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetMenuVariant(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu variant not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get menu variant: %v", err)
+	}
+
+	err = server.store.DeleteMenuVariant(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete menu variant: %v", err)
+	}
+
+	return &pb.DeleteMenuVariantResponse{Message: "menu variant deleted successfully"}, nil
+}
@@ -0,0 +1,311 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var orderStatuses = []string{
+	db.OrderStatusPending,
+	db.OrderStatusConfirmed,
+	db.OrderStatusPreparing,
+	db.OrderStatusServed,
+	db.OrderStatusPaid,
+	db.OrderStatusCancelled,
+}
+
+func (server *Server) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	items := make([]db.PlaceOrderItem, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		items = append(items, db.PlaceOrderItem{
+			MenuID:    item.GetMenuId(),
+			VariantID: item.GetVariantId(),
+			Quantity:  item.GetQuantity(),
+			NoteItem:  item.GetNoteItem(),
+			OptionIDs: item.GetOptionIds(),
+		})
+	}
+
+	result, err := server.store.PlaceOrderTx(ctx, db.PlaceOrderTxParams{
+		CustomerID: sql.NullInt64{Int64: req.GetCustomerId(), Valid: true},
+		UserID:     sql.NullInt64{Int64: req.GetUserId(), Valid: true},
+		TableID:    req.GetTableId(),
+		Items:      items,
+		OrderedAt:  server.now(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidOptionSelection) || errors.Is(err, db.ErrInvalidVariant) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, db.ErrMenuUnavailable) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create order: %v", err)
+	}
+
+	order := result.Order
+	server.publishOrderEvent(events.TypeOrderCreated, order)
+	for _, item := range result.Items {
+		server.publishOrderItemEvent(events.TypeOrderItemCreated, item, order.TableID)
+	}
+
+	rsp := &pb.CreateOrderResponse{
+		Order: convertOrder(order),
+		Items: make([]*pb.OrderItem, 0, len(result.Items)),
+	}
+	for _, item := range result.Items {
+		rsp.Items = append(rsp.Items, convertOrderItem(item, result.Options))
+	}
+	return rsp, nil
+}
+
+func validateCreateOrderRequest(req *pb.CreateOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetCustomerId()); err != nil {
+		violations = append(violations, fieldViolation("customer_id", err))
+	}
+
+	if err := val.ValidateId(req.GetUserId()); err != nil {
+		violations = append(violations, fieldViolation("user_id", err))
+	}
+
+	if err := val.ValidateId(req.GetTableId()); err != nil {
+		violations = append(violations, fieldViolation("table_id", err))
+	}
+
+	if len(req.GetItems()) == 0 {
+		violations = append(violations, fieldViolation("items", fmt.Errorf("order must contain at least one item")))
+	}
+
+	for i, item := range req.GetItems() {
+		violations = append(violations, validateOrderedMenu(fmt.Sprintf("items[%d].", i), item.GetMenuId(), item.GetVariantId())...)
+
+		if err := val.ValidateQuantity(item.GetQuantity()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("items[%d].quantity", i), err))
+		}
+
+		if err := val.ValidateString(item.GetNoteItem(), 0, 255); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("items[%d].note_item", i), err))
+		}
+
+		violations = append(violations, validateOptionIDs(fmt.Sprintf("items[%d].option_ids", i), item.GetOptionIds())...)
+	}
+
+	return violations
+}
+
+func (server *Server) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.store.GetOrder(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get order: %v", err)
+	}
+
+	return &pb.GetOrderResponse{Order: convertOrder(order)}, nil
+}
+
+func (server *Server) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePagination(req.GetPageId(), req.GetPageSize())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	orders, err := server.store.ListOrder(ctx, db.ListOrderParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list orders: %v", err)
+	}
+
+	rsp := &pb.ListOrdersResponse{Orders: make([]*pb.Order, 0, len(orders))}
+	for _, order := range orders {
+		rsp.Orders = append(rsp.Orders, convertOrder(order))
+	}
+	return rsp, nil
+}
+
+func (server *Server) UpdateOrder(ctx context.Context, req *pb.UpdateOrderRequest) (*pb.UpdateOrderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.UpdateOrderTx(ctx, db.UpdateOrderTxParams{
+		UpdateOrderParams: db.UpdateOrderParams{
+			ID:         req.GetId(),
+			UserID:     sql.NullInt64{Int64: req.GetUserId(), Valid: true},
+			CustomerID: sql.NullInt64{Int64: req.GetCustomerId(), Valid: true},
+			TableID:    req.GetTableId(),
+		},
+		Status:    req.GetStatus(),
+		ChangedBy: authPayload.Username,
+	})
+	if err != nil {
+		return nil, orderStatusError(err, "failed to update order")
+	}
+
+	order := result.Order
+	if req.Status != nil {
+		server.publishOrderEvent(events.TypeOrderStatusChanged, order)
+	}
+	server.publishOrderEvent(events.TypeOrderUpdated, order)
+
+	return &pb.UpdateOrderResponse{Order: convertOrder(order)}, nil
+}
+
+func validateUpdateOrderRequest(req *pb.UpdateOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateID(req.GetId())
+
+	if err := val.ValidateId(req.GetUserId()); err != nil {
+		violations = append(violations, fieldViolation("user_id", err))
+	}
+
+	if err := val.ValidateId(req.GetTableId()); err != nil {
+		violations = append(violations, fieldViolation("table_id", err))
+	}
+
+	if err := val.ValidateId(req.GetCustomerId()); err != nil {
+		violations = append(violations, fieldViolation("customer_id", err))
+	}
+
+	if req.Status != nil {
+		if err := val.ValidateOneOf(req.GetStatus(), orderStatuses...); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	return violations
+}
+
+func (server *Server) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if err := val.ValidateOneOf(req.GetStatus(), orderStatuses...); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.UpdateOrderStatusTx(ctx, db.UpdateOrderStatusTxParams{
+		OrderID:   req.GetId(),
+		Status:    req.GetStatus(),
+		ChangedBy: authPayload.Username,
+	})
+	if err != nil {
+		return nil, orderStatusError(err, "failed to update order status")
+	}
+	server.publishOrderEvent(events.TypeOrderStatusChanged, result.Order)
+
+	return &pb.UpdateOrderStatusResponse{Order: convertOrder(result.Order)}, nil
+}
+
+func (server *Server) DeleteOrder(ctx context.Context, req *pb.DeleteOrderRequest) (*pb.DeleteOrderResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.store.GetOrder(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get order: %v", err)
+	}
+
+	err = server.store.DeleteOrder(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete order: %v", err)
+	}
+	server.publishOrderEvent(events.TypeOrderDeleted, order)
+
+	return &pb.DeleteOrderResponse{Message: "order deleted successfully"}, nil
+}
+
+func (server *Server) ListOrderStatusHistory(ctx context.Context, req *pb.ListOrderStatusHistoryRequest) (*pb.ListOrderStatusHistoryResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	histories, err := server.store.ListOrderStatusHistory(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list order status history: %v", err)
+	}
+
+	rsp := &pb.ListOrderStatusHistoryResponse{Histories: make([]*pb.OrderStatusHistory, 0, len(histories))}
+	for _, history := range histories {
+		rsp.Histories = append(rsp.Histories, convertOrderStatusHistory(history))
+	}
+	return rsp, nil
+}
+
+func orderStatusError(err error, msg string) error {
+	if errors.Is(err, db.ErrInvalidOrderStatusTransition) || errors.Is(err, db.ErrInvalidOrderItemStatusTransition) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
@@ -0,0 +1,320 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) CreateOrderItem(ctx context.Context, req *pb.CreateOrderItemRequest) (*pb.CreateOrderItemResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateOrderItemFields(req.GetOrderId(), req.GetMenuId(), req.GetVariantId(), req.GetQuantity(), req.GetNoteItem())
+	violations = append(violations, validateOptionIDs("option_ids", req.GetOptionIds())...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.AddOrderItemTx(ctx, db.AddOrderItemTxParams{
+		OrderID:   req.GetOrderId(),
+		MenuID:    req.GetMenuId(),
+		VariantID: req.GetVariantId(),
+		Quantity:  req.GetQuantity(),
+		NoteItem:  req.GetNoteItem(),
+		OptionIDs: req.GetOptionIds(),
+		OrderedAt: server.now(),
+		ChangedBy: authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidOptionSelection) || errors.Is(err, db.ErrInvalidVariant) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, db.ErrMenuUnavailable) || errors.Is(err, db.ErrInvalidOrderStatusTransition) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "order or menu not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create order item: %v", err)
+	}
+
+	server.publishOrderItemEvent(events.TypeOrderItemCreated, result.OrderItem, result.Order.TableID)
+	if len(result.History) > 0 {
+		server.publishOrderEvent(events.TypeOrderStatusChanged, result.Order)
+	}
+	server.publishOrderEvent(events.TypeOrderUpdated, result.Order)
+
+	return &pb.CreateOrderItemResponse{OrderItem: convertOrderItem(result.OrderItem, result.Options)}, nil
+}
+
+func (server *Server) GetOrderItem(ctx context.Context, req *pb.GetOrderItemRequest) (*pb.GetOrderItemResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	orderItem, err := server.store.GetOrderItem(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "order item not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get order item: %v", err)
+	}
+
+	options, err := server.store.ListOrderItemOptionsByOrderItemIDs(ctx, []int64{orderItem.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list order item options: %v", err)
+	}
+
+	return &pb.GetOrderItemResponse{OrderItem: convertOrderItem(orderItem, options)}, nil
+}
+
+func (server *Server) ListOrderItems(ctx context.Context, req *pb.ListOrderItemsRequest) (*pb.ListOrderItemsResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePagination(req.GetPageId(), req.GetPageSize())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	items, err := server.store.ListOrderItem(ctx, db.ListOrderItemParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list order items: %v", err)
+	}
+
+	itemIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ID)
+	}
+	options, err := server.store.ListOrderItemOptionsByOrderItemIDs(ctx, itemIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list order item options: %v", err)
+	}
+
+	rsp := &pb.ListOrderItemsResponse{OrderItems: make([]*pb.OrderItem, 0, len(items))}
+	for _, item := range items {
+		rsp.OrderItems = append(rsp.OrderItems, convertOrderItem(item, options))
+	}
+	return rsp, nil
+}
+
+func (server *Server) UpdateOrderItem(ctx context.Context, req *pb.UpdateOrderItemRequest) (*pb.UpdateOrderItemResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if err := val.ValidateQuantity(req.GetQuantity()); err != nil {
+		violations = append(violations, fieldViolation("quantity", err))
+	}
+	if err := val.ValidateString(req.GetNoteItem(), 0, 255); err != nil {
+		violations = append(violations, fieldViolation("note_item", err))
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.UpdateOrderItemTx(ctx, db.UpdateOrderItemTxParams{
+		ID:       req.GetId(),
+		Quantity: req.GetQuantity(),
+		NoteItem: req.GetNoteItem(),
+	})
+	if err != nil {
+		return nil, orderStatusError(err, "failed to update order item")
+	}
+	server.publishOrderItemEvent(events.TypeOrderItemUpdated, result.OrderItem, result.Order.TableID)
+	server.publishOrderEvent(events.TypeOrderUpdated, result.Order)
+
+	options, err := server.store.ListOrderItemOptionsByOrderItemIDs(ctx, []int64{result.OrderItem.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list order item options: %v", err)
+	}
+
+	return &pb.UpdateOrderItemResponse{OrderItem: convertOrderItem(result.OrderItem, options)}, nil
+}
+
+func (server *Server) DeleteOrderItem(ctx context.Context, req *pb.DeleteOrderItemRequest) (*pb.DeleteOrderItemResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.DeleteOrderItemTx(ctx, req.GetId())
+	if err != nil {
+		return nil, orderStatusError(err, "failed to delete order item")
+	}
+	server.publishOrderItemEvent(events.TypeOrderItemDeleted, result.OrderItem, result.Order.TableID)
+	server.publishOrderEvent(events.TypeOrderUpdated, result.Order)
+
+	return &pb.DeleteOrderItemResponse{Message: "order item deleted successfully"}, nil
+}
+
+func (server *Server) ListKitchenItems(ctx context.Context, req *pb.ListKitchenItemsRequest) (*pb.ListKitchenItemsResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if req.GetCategoryId() != 0 {
+		if err := val.ValidateId(req.GetCategoryId()); err != nil {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("category_id", err)})
+		}
+	}
+
+	items, err := server.store.ListKitchenOrderItems(ctx, sql.NullInt64{
+		Int64: req.GetCategoryId(),
+		Valid: req.GetCategoryId() > 0,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list kitchen items: %v", err)
+	}
+
+	itemIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ID)
+	}
+	options, err := server.store.ListOrderItemOptionsByOrderItemIDs(ctx, itemIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list order item options: %v", err)
+	}
+
+	rsp := &pb.ListKitchenItemsResponse{Stations: make([]*pb.KitchenStation, 0)}
+	for _, item := range items {
+		n := len(rsp.Stations)
+		if n == 0 || rsp.Stations[n-1].CategoryId != item.CategoryID {
+			rsp.Stations = append(rsp.Stations, &pb.KitchenStation{
+				CategoryId:   item.CategoryID,
+				CategoryName: item.CategoryName,
+			})
+			n++
+		}
+		rsp.Stations[n-1].Items = append(rsp.Stations[n-1].Items, &pb.KitchenItem{
+			Id:          item.ID,
+			OrderId:     item.OrderID,
+			TableId:     item.TableID,
+			MenuId:      item.MenuID,
+			MenuName:    item.MenuName,
+			Quantity:    item.Quantity,
+			NoteItem:    item.NoteItem,
+			Status:      item.Status,
+			CreatedAt:   timestamppb.New(item.CreatedAt),
+			Options:     convertOrderItemOptions(item.ID, options),
+			VariantName: item.VariantName,
+		})
+	}
+	return rsp, nil
+}
+
+func (server *Server) UpdateKitchenItemStatus(ctx context.Context, req *pb.UpdateKitchenItemStatusRequest) (*pb.UpdateKitchenItemStatusResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if err := val.ValidateOneOf(req.GetStatus(), db.OrderItemStatusCooking, db.OrderItemStatusReady, db.OrderItemStatusServed); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.UpdateOrderItemStatusTx(ctx, db.UpdateOrderItemStatusTxParams{
+		OrderItemID: req.GetId(),
+		Status:      req.GetStatus(),
+		ChangedBy:   authPayload.Username,
+	})
+	if err != nil {
+		return nil, orderStatusError(err, "failed to update kitchen item status")
+	}
+
+	server.publishOrderItemEvent(events.TypeOrderItemStatusChanged, result.OrderItem, result.Order.TableID)
+	if len(result.History) > 0 {
+		server.publishOrderEvent(events.TypeOrderStatusChanged, result.Order)
+	}
+
+	options, err := server.store.ListOrderItemOptionsByOrderItemIDs(ctx, []int64{result.OrderItem.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list order item options: %v", err)
+	}
+
+	return &pb.UpdateKitchenItemStatusResponse{
+		Item:        convertOrderItem(result.OrderItem, options),
+		OrderStatus: result.Order.Status,
+	}, nil
+}
+
+func validateOrderItemFields(orderID int64, menuID int64, variantID int64, quantity int32, noteItem string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(orderID); err != nil {
+		violations = append(violations, fieldViolation("order_id", err))
+	}
+
+	violations = append(violations, validateOrderedMenu("", menuID, variantID)...)
+
+	if err := val.ValidateQuantity(quantity); err != nil {
+		violations = append(violations, fieldViolation("quantity", err))
+	}
+
+	if err := val.ValidateString(noteItem, 0, 255); err != nil {
+		violations = append(violations, fieldViolation("note_item", err))
+	}
+
+	return violations
+}
+
+// validateOrderedMenu checks the menu and variant an item is ordered by. The
+// menu may be left out when a variant is given.
+func validateOrderedMenu(prefix string, menuID int64, variantID int64) (violations []*errdetails.BadRequest_FieldViolation) {
+	if menuID != 0 || variantID == 0 {
+		if err := val.ValidateId(menuID); err != nil {
+			violations = append(violations, fieldViolation(prefix+"menu_id", err))
+		}
+	}
+
+	if variantID != 0 {
+		if err := val.ValidateId(variantID); err != nil {
+			violations = append(violations, fieldViolation(prefix+"variant_id", err))
+		}
+	}
+
+	return violations
+}
+
+func validateOptionIDs(field string, optionIDs []int64) (violations []*errdetails.BadRequest_FieldViolation) {
+	for i, optionID := range optionIDs {
+		if err := val.ValidateId(optionID); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("%s[%d]", field, i), err))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"strings"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/ratelimit"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/datmaithanh/orderfood/val"
+	"github.com/datmaithanh/orderfood/worker"
+	"github.com/hibiken/asynq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequest) (*pb.ForgotPasswordResponse, error) {
+	violations := validateForgotPasswordRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	clientIP := server.extractMetadata(ctx).ClientIP
+	if host, _, err := net.SplitHostPort(clientIP); err == nil {
+		clientIP = host
+	}
+
+	for _, check := range []struct {
+		limiter ratelimit.Limiter
+		key     string
+	}{
+		{server.resetLimiters.IP, clientIP},
+		{server.resetLimiters.Email, strings.ToLower(req.GetEmail())},
+	} {
+		allowed, err := check.limiter.Allow(ctx, check.key)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check rate limit: %v", err)
+		}
+		if !allowed {
+			return nil, status.Errorf(codes.ResourceExhausted, "too many password reset requests, try again later")
+		}
+	}
+
+	err := server.taskDistributor.DistributeTaskSendResetPassword(ctx, &worker.PayloadSendResetPassword{
+		Email:    req.GetEmail(),
+		ClientIP: clientIP,
+	}, asynq.MaxRetry(5), asynq.Queue(worker.QueueCritical))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send reset password email: %v", err)
+	}
+
+	return &pb.ForgotPasswordResponse{
+		Message: "if the email belongs to an account, a reset link has been sent",
+	}, nil
+}
+
+func validateForgotPasswordRequest(req *pb.ForgotPasswordRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateEmail(req.GetEmail()); err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+
+	return violations
+}
+
+func (server *Server) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	violations := validateResetPasswordRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	hashedPassword, err := utils.HashPassword(req.GetPassword())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "fail to hash password: %v", err)
+	}
+
+	_, err = server.store.ResetPasswordTx(ctx, db.ResetPasswordTxParams{
+		TokenHash:    utils.HashSecretCode(req.GetToken()),
+		HashPassword: hashedPassword,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "reset link is invalid or has expired")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reset password: %v", err)
+	}
+
+	return &pb.ResetPasswordResponse{Message: "password has been reset, please log in again"}, nil
+}
+
+func validateResetPasswordRequest(req *pb.ResetPasswordRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateSecretCode(req.GetToken()); err != nil {
+		violations = append(violations, fieldViolation("token", err))
+	}
+
+	if err := val.ValidatePassword(req.GetPassword()); err != nil {
+		violations = append(violations, fieldViolation("password", err))
+	}
+
+	return violations
+}
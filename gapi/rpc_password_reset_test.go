@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/ratelimit"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/datmaithanh/orderfood/worker"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type stubDistributor struct {
+	worker.TaskDistributor
+	resetPasswords []*worker.PayloadSendResetPassword
+}
+
+func (distributor *stubDistributor) DistributeTaskSendResetPassword(ctx context.Context, payload *worker.PayloadSendResetPassword, opts ...asynq.Option) error {
+	distributor.resetPasswords = append(distributor.resetPasswords, payload)
+	return nil
+}
+
+type resetPasswordStore struct {
+	db.Store
+	tokenHash string
+	params    []db.ResetPasswordTxParams
+}
+
+func (store *resetPasswordStore) ResetPasswordTx(ctx context.Context, arg db.ResetPasswordTxParams) (db.ResetPasswordTxResult, error) {
+	if arg.TokenHash != store.tokenHash {
+		return db.ResetPasswordTxResult{}, sql.ErrNoRows
+	}
+	store.params = append(store.params, arg)
+	return db.ResetPasswordTxResult{}, nil
+}
+
+func TestForgotPasswordRateLimit(t *testing.T) {
+	server := newTestServer(t)
+	distributor := &stubDistributor{}
+	server.taskDistributor = distributor
+	server.resetLimiters = ratelimit.PasswordResetLimiters{
+		Email: ratelimit.NewMemoryLimiter(2, server.config.PasswordResetWindow),
+		IP:    ratelimit.NewMemoryLimiter(3, server.config.PasswordResetWindow),
+	}
+
+	email := utils.RandomString(6) + "@example.com"
+	for i := 0; i < 2; i++ {
+		_, err := server.ForgotPassword(context.Background(), &pb.ForgotPasswordRequest{Email: email})
+		require.NoError(t, err)
+	}
+
+	_, err := server.ForgotPassword(context.Background(), &pb.ForgotPasswordRequest{Email: email})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = server.ForgotPassword(context.Background(), &pb.ForgotPasswordRequest{Email: "other@example.com"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err), "the client IP has used its quota")
+
+	require.Len(t, distributor.resetPasswords, 2)
+	require.Equal(t, email, distributor.resetPasswords[0].Email)
+
+	_, err = server.ForgotPassword(context.Background(), &pb.ForgotPasswordRequest{Email: "not-an-email"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestResetPassword(t *testing.T) {
+	token, err := utils.GenerateSecretCode()
+	require.NoError(t, err)
+
+	server := newTestServer(t)
+	store := &resetPasswordStore{tokenHash: utils.HashSecretCode(token)}
+	server.store = store
+
+	_, err = server.ResetPassword(context.Background(), &pb.ResetPasswordRequest{Token: token, Password: "new-password"})
+	require.NoError(t, err)
+	require.Len(t, store.params, 1)
+	require.NoError(t, utils.CheckPassword("new-password", store.params[0].HashPassword))
+
+	other, err := utils.GenerateSecretCode()
+	require.NoError(t, err)
+	_, err = server.ResetPassword(context.Background(), &pb.ResetPasswordRequest{Token: other, Password: "new-password"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.ResetPassword(context.Background(), &pb.ResetPasswordRequest{Token: "short", Password: "x"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
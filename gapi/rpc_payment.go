@@ -0,0 +1,163 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreatePaymentRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.store.GetOrder(ctx, req.GetOrderId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "order not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get order: %v", err)
+	}
+
+	payment, err := server.store.CreatePayment(ctx, db.CreatePaymentParams{
+		OrderID:       order.ID,
+		PaymentMethod: req.GetPaymentMethod(),
+		Amount:        order.TotalPrice,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create payment: %v", err)
+	}
+	server.publishPaymentEvent(events.TypePaymentCreated, payment, order.TableID)
+
+	return &pb.CreatePaymentResponse{Payment: convertPayment(payment)}, nil
+}
+
+func validateCreatePaymentRequest(req *pb.CreatePaymentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateId(req.GetOrderId()); err != nil {
+		violations = append(violations, fieldViolation("order_id", err))
+	}
+
+	if err := val.ValidateOneOf(req.GetPaymentMethod(), "Cash", "Card", "Online"); err != nil {
+		violations = append(violations, fieldViolation("payment_method", err))
+	}
+
+	return violations
+}
+
+func (server *Server) GetPayment(ctx context.Context, req *pb.GetPaymentRequest) (*pb.GetPaymentResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payment, err := server.store.GetPayment(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "payment not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get payment: %v", err)
+	}
+
+	return &pb.GetPaymentResponse{Payment: convertPayment(payment)}, nil
+}
+
+func (server *Server) ListPayments(ctx context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePagination(req.GetPageId(), req.GetPageSize())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payments, err := server.store.ListPayment(ctx, db.ListPaymentParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payments: %v", err)
+	}
+
+	rsp := &pb.ListPaymentsResponse{Payments: make([]*pb.Payment, 0, len(payments))}
+	for _, payment := range payments {
+		rsp.Payments = append(rsp.Payments, convertPayment(payment))
+	}
+	return rsp, nil
+}
+
+func (server *Server) UpdatePaymentStatus(ctx context.Context, req *pb.UpdatePaymentStatusRequest) (*pb.UpdatePaymentStatusResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if err := val.ValidateOneOf(req.GetStatus(), "Pending", "Completed", "Failed"); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payment, err := server.store.UpdatePaymentStatus(ctx, db.UpdatePaymentStatusParams{
+		ID:     req.GetId(),
+		Status: req.GetStatus(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "payment not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update payment status: %v", err)
+	}
+	server.publishPaymentEvent(events.TypePaymentStatusChanged, payment, server.orderTableID(ctx, payment.OrderID))
+
+	return &pb.UpdatePaymentStatusResponse{Payment: convertPayment(payment)}, nil
+}
+
+func (server *Server) DeletePayment(ctx context.Context, req *pb.DeletePaymentRequest) (*pb.DeletePaymentResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payment, err := server.store.GetPayment(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "payment not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get payment: %v", err)
+	}
+
+	err = server.store.DeletePayment(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete payment: %v", err)
+	}
+	server.publishPaymentEvent(events.TypePaymentDeleted, payment, server.orderTableID(ctx, payment.OrderID))
+
+	return &pb.DeletePaymentResponse{Message: "payment deleted successfully"}, nil
+}
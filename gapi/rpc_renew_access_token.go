@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) RenewAccessToken(ctx context.Context, req *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			{Field: "refresh_token", Description: "must not be empty"},
+		})
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.GetRefreshToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token: %v", err)
+	}
+
+	user, err := server.store.GetUserByUsername(ctx, refreshPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.Unauthenticated, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+	if refreshPayload.Version != user.TokenVersion {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", db.ErrSessionOutdated)
+	}
+
+	refreshToken, newRefreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, user.TokenVersion, server.config.RefreshTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create refresh token: %v", err)
+	}
+
+	mtdt := server.extractMetadata(ctx)
+	result, err := server.store.RefreshSessionTx(ctx, db.RefreshSessionTxParams{
+		SessionID:    refreshPayload.ID,
+		RefreshToken: req.GetRefreshToken(),
+		TokenVersion: user.TokenVersion,
+		NewSession: db.CreateSessionParams{
+			ID:           newRefreshPayload.ID,
+			UserID:       user.ID,
+			RefreshToken: refreshToken,
+			UserAgent:    mtdt.UserAgent,
+			ClientIp:     mtdt.ClientIP,
+			ExpiresAt:    newRefreshPayload.ExpiredAt,
+		},
+	})
+	if err != nil {
+		switch {
+		case err == sql.ErrNoRows:
+			return nil, status.Errorf(codes.Unauthenticated, "session not found")
+		case errors.Is(err, db.ErrRefreshTokenReused),
+			errors.Is(err, db.ErrRefreshTokenMismatch),
+			errors.Is(err, db.ErrSessionRevoked),
+			errors.Is(err, db.ErrSessionExpired),
+			errors.Is(err, db.ErrSessionOutdated):
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to refresh session: %v", err)
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, user.TokenVersion, server.config.AccessTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token: %v", err)
+	}
+
+	return &pb.RenewAccessTokenResponse{
+		SessionId:             result.Session.ID.String(),
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  timestamppb.New(accessPayload.ExpiredAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(newRefreshPayload.ExpiredAt),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type refreshSessionStore struct {
+	db.Store
+	err error
+}
+
+func (store *refreshSessionStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	return db.User{ID: 1, Username: username, Role: rbac.RoleManager, TokenVersion: 1}, nil
+}
+
+func (store *refreshSessionStore) RefreshSessionTx(ctx context.Context, arg db.RefreshSessionTxParams) (db.RefreshSessionTxResult, error) {
+	if store.err != nil {
+		return db.RefreshSessionTxResult{}, store.err
+	}
+	return db.RefreshSessionTxResult{Session: db.Session{ID: arg.NewSession.ID}}, nil
+}
+
+func TestRenewAccessToken(t *testing.T) {
+	server := newTestServer(t)
+	server.store = &refreshSessionStore{}
+
+	refreshToken, _, err := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 1, time.Hour)
+	require.NoError(t, err)
+
+	res, err := server.RenewAccessToken(context.Background(), &pb.RenewAccessTokenRequest{RefreshToken: refreshToken})
+	require.NoError(t, err)
+	require.NotEqual(t, refreshToken, res.GetRefreshToken())
+
+	payload, err := server.tokenMaker.VerifyToken(res.GetRefreshToken())
+	require.NoError(t, err)
+	require.Equal(t, res.GetSessionId(), payload.ID.String())
+
+	accessPayload, err := server.tokenMaker.VerifyToken(res.GetAccessToken())
+	require.NoError(t, err)
+	require.Equal(t, rbac.RoleManager, accessPayload.Role)
+}
+
+func TestRenewAccessTokenReuse(t *testing.T) {
+	server := newTestServer(t)
+	server.store = &refreshSessionStore{err: db.ErrRefreshTokenReused}
+
+	refreshToken, _, err := server.tokenMaker.CreateToken(utils.RandomString(6), rbac.RoleWaiter, 1, time.Hour)
+	require.NoError(t, err)
+
+	_, err = server.RenewAccessToken(context.Background(), &pb.RenewAccessTokenRequest{RefreshToken: refreshToken})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetSalesReport(ctx context.Context, req *pb.GetSalesReportRequest) (*pb.GetSalesReportResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetSalesReportRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rows, err := server.store.ListSalesByVariant(ctx, db.ListSalesByVariantParams{
+		FromTime: req.GetFrom().AsTime(),
+		ToTime:   req.GetTo().AsTime(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sales: %v", err)
+	}
+
+	var revenue money.Amount
+	rsp := &pb.GetSalesReportResponse{Menus: make([]*pb.MenuSales, 0)}
+	for _, menu := range db.SummarizeSales(rows) {
+		rsp.Quantity += menu.Quantity
+		revenue = revenue.Add(menu.Revenue)
+
+		menuSales := &pb.MenuSales{
+			MenuId:   menu.MenuID,
+			MenuName: menu.MenuName,
+			Quantity: menu.Quantity,
+			Revenue:  menu.Revenue.String(),
+			Variants: make([]*pb.VariantSales, 0, len(menu.Variants)),
+		}
+		for _, variant := range menu.Variants {
+			menuSales.Variants = append(menuSales.Variants, &pb.VariantSales{
+				VariantId:   variant.VariantID.Int64,
+				VariantName: variant.VariantName,
+				Quantity:    variant.Quantity,
+				Revenue:     variant.Revenue.String(),
+			})
+		}
+		rsp.Menus = append(rsp.Menus, menuSales)
+	}
+	rsp.Revenue = revenue.String()
+
+	return rsp, nil
+}
+
+func validateGetSalesReportRequest(req *pb.GetSalesReportRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetFrom() == nil {
+		violations = append(violations, fieldViolation("from", fmt.Errorf("must be provided")))
+	}
+
+	if req.GetTo() == nil {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be provided")))
+	} else if req.GetFrom() != nil && !req.GetTo().AsTime().After(req.GetFrom().AsTime()) {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be after from")))
+	}
+
+	return violations
+}
@@ -0,0 +1,222 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if req.GetRefreshToken() == "" {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			{Field: "refresh_token", Description: "must not be empty"},
+		})
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.GetRefreshToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token: %v", err)
+	}
+	if refreshPayload.Username != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "refresh token belongs to another user")
+	}
+
+	user, err := server.getSessionOwner(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.revokeSession(ctx, user.ID, refreshPayload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.tokenChecker.Revoke(ctx, authPayload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access token: %v", err)
+	}
+
+	return &pb.LogoutResponse{Message: "logged out"}, nil
+}
+
+func (server *Server) ListMySessions(ctx context.Context, req *pb.ListMySessionsRequest) (*pb.ListMySessionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	user, err := server.getSessionOwner(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := server.store.ListUserSessions(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
+	}
+
+	return &pb.ListMySessionsResponse{Sessions: convertSessions(sessions)}, nil
+}
+
+func (server *Server) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSessionID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.getSessionOwner(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := server.revokeSession(ctx, user.ID, uuid.MustParse(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeSessionResponse{Session: convertSession(session)}, nil
+}
+
+func (server *Server) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*pb.RevokeAllSessionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	user, err := server.getSessionOwner(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := server.revokeAllSessions(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeAllSessionsResponse{Revoked: revoked}, nil
+}
+
+func (server *Server) ListUserSessions(ctx context.Context, req *pb.ListUserSessionsRequest) (*pb.ListUserSessionsResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateId(req.GetUserId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("user_id", err)})
+	}
+
+	sessions, err := server.store.ListUserSessions(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
+	}
+
+	return &pb.ListUserSessionsResponse{Sessions: convertSessions(sessions)}, nil
+}
+
+func (server *Server) RevokeUserSession(ctx context.Context, req *pb.RevokeUserSessionRequest) (*pb.RevokeUserSessionResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSessionID(req.GetId())
+	if err := val.ValidateId(req.GetUserId()); err != nil {
+		violations = append(violations, fieldViolation("user_id", err))
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	session, err := server.revokeSession(ctx, req.GetUserId(), uuid.MustParse(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeUserSessionResponse{Session: convertSession(session)}, nil
+}
+
+func (server *Server) RevokeUserSessions(ctx context.Context, req *pb.RevokeUserSessionsRequest) (*pb.RevokeUserSessionsResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateId(req.GetUserId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("user_id", err)})
+	}
+
+	revoked, err := server.revokeAllSessions(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeUserSessionsResponse{Revoked: revoked}, nil
+}
+
+func (server *Server) getSessionOwner(ctx context.Context, username string) (db.User, error) {
+	user, err := server.store.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.User{}, status.Errorf(codes.NotFound, "user not found: %v", err)
+		}
+		return db.User{}, status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+	return user, nil
+}
+
+func (server *Server) revokeSession(ctx context.Context, userID int64, sessionID uuid.UUID) (db.Session, error) {
+	session, err := server.store.BlockUserSession(ctx, db.BlockUserSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Session{}, status.Errorf(codes.NotFound, "session not found")
+		}
+		return db.Session{}, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
+	}
+	return session, nil
+}
+
+// revokeAllSessions blocks every refresh token of the user and bumps the token
+// version so that access tokens already handed out stop working too.
+func (server *Server) revokeAllSessions(ctx context.Context, userID int64) (int64, error) {
+	revoked, err := server.store.BlockUserSessions(ctx, userID)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to revoke sessions: %v", err)
+	}
+
+	_, err = server.store.IncrementUserTokenVersion(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, status.Errorf(codes.NotFound, "user not found: %v", err)
+		}
+		return 0, status.Errorf(codes.Internal, "failed to revoke access tokens: %v", err)
+	}
+	return revoked, nil
+}
+
+func validateSessionID(id string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUUID(id); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	return violations
+}
@@ -0,0 +1,254 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/qrsheet"
+	"github.com/datmaithanh/orderfood/tableqr"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTable(ctx context.Context, req *pb.CreateTableRequest) (*pb.CreateTableResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	maxTableID, err := server.store.GetMaxTableID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get max table id: %v", err)
+	}
+
+	table, err := server.store.CreateTable(ctx, db.CreateTableParams{
+		Name: fmt.Sprintf("Table-%d", maxTableID.(int64)+1),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create table: %v", err)
+	}
+
+	table, err = tableqr.Generate(ctx, server.store, server.imageStore, server.config.TokenSymmetricKey, server.config.WebsiteURL, table)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate table QR: %v", err)
+	}
+
+	return &pb.CreateTableResponse{Table: convertTable(table)}, nil
+}
+
+func (server *Server) GetTable(ctx context.Context, req *pb.GetTableRequest) (*pb.GetTableResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	table, err := server.store.GetTable(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "table not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get table: %v", err)
+	}
+
+	return &pb.GetTableResponse{Table: convertTable(table)}, nil
+}
+
+func (server *Server) ListTables(ctx context.Context, req *pb.ListTablesRequest) (*pb.ListTablesResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validatePagination(req.GetPageId(), req.GetPageSize())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	tables, err := server.store.ListTable(ctx, db.ListTableParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tables: %v", err)
+	}
+
+	rsp := &pb.ListTablesResponse{Tables: make([]*pb.Table, 0, len(tables))}
+	for _, table := range tables {
+		rsp.Tables = append(rsp.Tables, convertTable(table))
+	}
+	return rsp, nil
+}
+
+func (server *Server) UpdateTableStatus(ctx context.Context, req *pb.UpdateTableStatusRequest) (*pb.UpdateTableStatusResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateTableStatusRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	table, err := server.store.UpdateTableStatus(ctx, db.UpdateTableStatusParams{
+		ID:     req.GetId(),
+		Status: req.GetStatus(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "table not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update table status: %v", err)
+	}
+	server.publishTableEvent(events.TypeTableStatusChanged, table)
+
+	return &pb.UpdateTableStatusResponse{Table: convertTable(table)}, nil
+}
+
+func validateUpdateTableStatusRequest(req *pb.UpdateTableStatusRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateID(req.GetId())
+
+	if err := val.ValidateOneOf(req.GetStatus(), "available", "occupied", "reserved"); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	return violations
+}
+
+func (server *Server) DeleteTable(ctx context.Context, req *pb.DeleteTableRequest) (*pb.DeleteTableResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.store.GetTable(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "table not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get table: %v", err)
+	}
+
+	err = server.store.DeleteTable(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete table: %v", err)
+	}
+
+	return &pb.DeleteTableResponse{Message: "table deleted successfully"}, nil
+}
+
+func (server *Server) RotateTableQR(ctx context.Context, req *pb.RotateTableQRRequest) (*pb.RotateTableQRResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateID(req.GetId())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	table, err := server.store.GetTable(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "table not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get table: %v", err)
+	}
+
+	table, err = tableqr.Generate(ctx, server.store, server.imageStore, server.config.TokenSymmetricKey, server.config.WebsiteURL, table)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate table QR: %v", err)
+	}
+
+	return &pb.RotateTableQRResponse{Table: convertTable(table)}, nil
+}
+
+func (server *Server) ExportTableQR(ctx context.Context, req *pb.ExportTableQRRequest) (*httpbody.HttpBody, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateExportTableQRRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	format := req.GetFormat()
+	if format == "" {
+		format = "pdf"
+	}
+	sizeName := req.GetSize()
+	if sizeName == "" {
+		sizeName = qrsheet.SizeMedium
+	}
+	size, _ := qrsheet.SizeByName(sizeName)
+
+	tables, err := server.store.ListAllTables(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tables: %v", err)
+	}
+
+	entries := make([]qrsheet.Entry, 0, len(tables))
+	for _, table := range tables {
+		if table.QrText == "" {
+			continue
+		}
+		entries = append(entries, qrsheet.Entry{
+			Name:   table.Name,
+			QRText: table.QrText,
+		})
+	}
+
+	var buf bytes.Buffer
+	contentType := "application/pdf"
+	if format == "zip" {
+		contentType = "application/zip"
+		err = qrsheet.WriteZIP(&buf, entries, size)
+	} else {
+		err = qrsheet.WritePDF(&buf, entries, size, server.config.RestaurantName)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render table QR codes: %v", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: contentType,
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+func validateExportTableQRRequest(req *pb.ExportTableQRRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetFormat() != "" {
+		if err := val.ValidateOneOf(req.GetFormat(), "pdf", "zip"); err != nil {
+			violations = append(violations, fieldViolation("format", err))
+		}
+	}
+
+	if req.GetSize() != "" {
+		if err := val.ValidateOneOf(req.GetSize(), qrsheet.SizeSmall, qrsheet.SizeMedium, qrsheet.SizeLarge); err != nil {
+			violations = append(violations, fieldViolation("size", err))
+		}
+	}
+
+	return violations
+}
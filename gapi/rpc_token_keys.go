@@ -0,0 +1,36 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTokenKeys(ctx context.Context, req *pb.GetTokenKeysRequest) (*pb.GetTokenKeysResponse, error) {
+	var keys []token.PublicKey
+	if keySet, ok := server.tokenMaker.(token.KeySet); ok {
+		keys = keySet.PublicKeys()
+	}
+	if len(keys) == 0 {
+		return nil, status.Errorf(codes.NotFound, "tokens are not signed with public keys")
+	}
+
+	rsp := &pb.GetTokenKeysResponse{}
+	for _, key := range keys {
+		jwk := key.JWK()
+		rsp.Keys = append(rsp.Keys, &pb.TokenKey{
+			Kty: jwk.KeyType,
+			Crv: jwk.Curve,
+			X:   jwk.X,
+			N:   jwk.N,
+			E:   jwk.E,
+			Kid: jwk.KeyID,
+			Use: jwk.Use,
+			Alg: jwk.Algorithm,
+		})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetTokenKeys(t *testing.T) {
+	server := newTestServer(t)
+
+	_, err := server.GetTokenKeys(context.Background(), &pb.GetTokenKeysRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, oldKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	server.tokenMaker, err = token.NewPublicPasetoMaker(newKey, oldKey.Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+
+	rsp, err := server.GetTokenKeys(context.Background(), &pb.GetTokenKeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, rsp.GetKeys(), 2)
+	for _, key := range rsp.GetKeys() {
+		require.Equal(t, "OKP", key.GetKty())
+		require.Equal(t, "EdDSA", key.GetAlg())
+		require.NotEmpty(t, key.GetX())
+	}
+}
@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnlockUser lets an administrator lift a login lockout before it runs out.
+func (server *Server) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*pb.UnlockUserResponse, error) {
+	_, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := val.ValidateId(req.GetUserId()); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("user_id", err)})
+	}
+
+	user, err := server.store.GetUserByID(ctx, req.GetUserId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+
+	if err := server.loginGuard.Unlock(ctx, user.Username); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unlock user: %v", err)
+	}
+
+	mtdt := server.extractMetadata(ctx)
+	_, err = server.store.CreateSecurityEvent(ctx, db.CreateSecurityEventParams{
+		UserID:    user.ID,
+		EventType: db.SecurityEventAccountUnlocked,
+		ClientIp:  mtdt.ClientIP,
+		UserAgent: mtdt.UserAgent,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record security event: %v", err)
+	}
+
+	return &pb.UnlockUserResponse{Username: user.Username}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
 	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/rbac"
 	"github.com/datmaithanh/orderfood/val"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -24,9 +25,13 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
-	if authPayload.Username != req.GetUsername() {
+	canManageUsers := rbac.Can(authPayload.Role, rbac.PermUserManage)
+	if !canManageUsers && authPayload.Username != req.GetUsername() {
 		return nil, status.Errorf(codes.PermissionDenied, "you can only update your own account")
 	}
+	if req.Role != nil && !canManageUsers {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to change roles")
+	}
 
 	arg := db.UpdateUserParams{
 		Username: req.Username,
@@ -53,11 +58,12 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 
 	userResponse := &pb.UpdateUserResponse{
 		User: &pb.User{
-			Username:  user.Username,
-			FullName:  user.FullName,
-			Role:      user.Role,
-			Email:     user.Email,
-			CreatedAt: timestamppb.New(user.CreatedAt),
+			Username:        user.Username,
+			FullName:        user.FullName,
+			Role:            user.Role,
+			Email:           user.Email,
+			CreatedAt:       timestamppb.New(user.CreatedAt),
+			IsEmailVerified: user.IsEmailVerified,
 		},
 	}
 	return userResponse, nil
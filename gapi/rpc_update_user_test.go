@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/datmaithanh/orderfood/revocation"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type updateUserStore struct {
+	tokenVersionStore
+	updated []db.UpdateUserParams
+}
+
+func (store *updateUserStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
+	store.updated = append(store.updated, arg)
+	return db.User{Username: arg.Username, Role: arg.Role.String}, nil
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	server := newTestServer(t)
+	store := &updateUserStore{tokenVersionStore: tokenVersionStore{version: 1}}
+	server.store = store
+	server.tokenChecker = revocation.NewChecker(revocation.NewMemoryList(), store)
+
+	fullName, email, role := "New Comer", "newcomer@example.com", rbac.RoleWaiter
+	req := &pb.UpdateUserRequest{Username: "newcomer", FullName: &fullName, Email: &email, Role: &role}
+
+	ctx := newContextWithBearerToken(t, server.tokenMaker, rbac.RoleManager)
+	_, err := server.UpdateUser(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Empty(t, store.updated)
+
+	ctx = newContextWithBearerToken(t, server.tokenMaker, rbac.RoleAdmin)
+	res, err := server.UpdateUser(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, rbac.RoleWaiter, res.GetUser().GetRole())
+	require.Len(t, store.updated, 1)
+}
@@ -6,6 +6,7 @@ import (
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
 	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/rbac"
 	"github.com/datmaithanh/orderfood/utils"
 	"github.com/datmaithanh/orderfood/val"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -16,17 +17,25 @@ import (
 
 func (server *Server) UpdatePasswordUser(ctx context.Context, req *pb.UpdatePasswordUserRequest) (*pb.UpdatePasswordUserResponse, error) {
 
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
 	violations := validateUpdatePasswordUserRequest(req)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
+	if authPayload.Username != req.GetUsername() && !rbac.Can(authPayload.Role, rbac.PermUserManage) {
+		return nil, status.Errorf(codes.PermissionDenied, "you can only update your own password")
+	}
 
 	hashedPassword, err := utils.HashPassword(req.GetPassword())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "fail to hash password: %v", err)
 	}
 	user, err := server.store.UpdateUserWithPassword(ctx, db.UpdateUserWithPasswordParams{
-		Username:           req.GetUsername(),
+		Username:     req.GetUsername(),
 		HashPassword: hashedPassword,
 	})
 	if err != nil {
@@ -38,11 +47,12 @@ func (server *Server) UpdatePasswordUser(ctx context.Context, req *pb.UpdatePass
 
 	userResponse := &pb.UpdatePasswordUserResponse{
 		User: &pb.User{
-			Username:  user.Username,
-			FullName:  user.FullName,
-			Role:      user.Role,
-			Email:     user.Email,
-			CreatedAt: timestamppb.New(user.CreatedAt),
+			Username:        user.Username,
+			FullName:        user.FullName,
+			Role:            user.Role,
+			Email:           user.Email,
+			CreatedAt:       timestamppb.New(user.CreatedAt),
+			IsEmailVerified: user.IsEmailVerified,
 		},
 	}
 	return userResponse, nil
@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"errors"
+
+	"github.com/datmaithanh/orderfood/events"
+	"github.com/datmaithanh/orderfood/pb"
+	"github.com/datmaithanh/orderfood/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) WatchOrders(req *pb.WatchOrdersRequest, stream pb.OrderFoodService_WatchOrdersServer) error {
+	_, err := server.authorizeUser(stream.Context())
+	if err != nil {
+		return unauthenticatedError(err)
+	}
+
+	violations := validateWatchOrdersRequest(req)
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	sub, err := server.eventBus.Subscribe(events.Filter{
+		TableID: req.GetTableId(),
+		Status:  req.GetStatus(),
+	}, req.GetLastEventId())
+	if err != nil {
+		if errors.Is(err, events.ErrEventExpired) {
+			return status.Errorf(codes.OutOfRange, "cannot resume stream: %v", err)
+		}
+		if errors.Is(err, events.ErrBusClosed) {
+			return status.Errorf(codes.Unavailable, "server is shutting down: %v", err)
+		}
+		return status.Errorf(codes.Internal, "failed to subscribe to order events: %v", err)
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return status.Errorf(codes.Unavailable, "event stream fell behind, resubscribe from the last event id")
+			}
+			err := stream.Send(&pb.OrderEvent{
+				Id:        event.ID,
+				Type:      event.Type,
+				EntityId:  event.EntityID,
+				OrderId:   event.OrderID,
+				TableId:   event.TableID,
+				Status:    event.Status,
+				CreatedAt: timestamppb.New(event.CreatedAt),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func validateWatchOrdersRequest(req *pb.WatchOrdersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetTableId() != 0 {
+		if err := val.ValidateId(req.GetTableId()); err != nil {
+			violations = append(violations, fieldViolation("table_id", err))
+		}
+	}
+
+	if req.GetStatus() != "" {
+		if err := val.ValidateString(req.GetStatus(), 1, 20); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	return violations
+}
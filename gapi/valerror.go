@@ -1,6 +1,9 @@
 package gapi
 
 import (
+	"errors"
+
+	"github.com/datmaithanh/orderfood/val"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,5 +30,27 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 }
 
 func unauthenticatedError(err error) error {
+	if errors.Is(err, errPermissionDenied) {
+		return status.Errorf(codes.PermissionDenied, "%s", err)
+	}
 	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
 }
+
+func validatePagination(pageID int32, pageSize int32) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(pageID); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(pageSize); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
+
+func validateID(id int64) []*errdetails.BadRequest_FieldViolation {
+	if err := val.ValidateId(id); err != nil {
+		return []*errdetails.BadRequest_FieldViolation{fieldViolation("id", err)}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// Policy decides how failed logins of a single username or client IP are
+// throttled. After DelayAfter failures every further attempt must wait
+// BaseDelay, doubling per failure up to MaxDelay, and MaxFailures failures
+// lock the key for LockDuration. Failures are forgotten after Window without
+// a new one.
+type Policy struct {
+	DelayAfter   int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	MaxFailures  int
+	LockDuration time.Duration
+	Window       time.Duration
+}
+
+func (policy Policy) delay(failures int) time.Duration {
+	if policy.BaseDelay <= 0 || failures < policy.DelayAfter {
+		return 0
+	}
+	delay := policy.BaseDelay
+	for i := policy.DelayAfter; i < failures && delay < policy.MaxDelay; i++ {
+		delay *= 2
+	}
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	return delay
+}
+
+// BlockedError is returned by Guard.Check while a login must not be attempted.
+type BlockedError struct {
+	Locked     bool
+	RetryAfter time.Duration
+}
+
+func (err *BlockedError) Error() string {
+	retryAfter := err.RetryAfter.Round(time.Second)
+	if err.Locked {
+		return fmt.Sprintf("too many failed login attempts, login is locked for %s", retryAfter)
+	}
+	return fmt.Sprintf("too many failed login attempts, try again in %s", retryAfter)
+}
+
+// Guard tracks failed logins per username and per client IP.
+type Guard struct {
+	store    Store
+	username Policy
+	ip       Policy
+	now      func() time.Time
+}
+
+func NewGuard(store Store, username Policy, ip Policy) *Guard {
+	return &Guard{
+		store:    store,
+		username: username,
+		ip:       ip,
+		now:      time.Now,
+	}
+}
+
+type guardKey struct {
+	key    string
+	policy Policy
+}
+
+func (guard *Guard) keys(username string, clientIP string) []guardKey {
+	keys := []guardKey{{usernameKey(username), guard.username}}
+	if ip := normalizeIP(clientIP); ip != "" {
+		keys = append(keys, guardKey{"ip:" + ip, guard.ip})
+	}
+	return keys
+}
+
+// Check reserves a login attempt against the username and the client IP and
+// returns a *BlockedError while either is locked or has not yet waited out the
+// delay since its last attempt. The attempt counts as a failure from the start,
+// so parallel attempts cannot all pass before the first one fails. Unless
+// Check returns an error, it must be settled with Fail or Succeed; an attempt
+// that is never settled stays counted as a failure.
+func (guard *Guard) Check(ctx context.Context, username string, clientIP string) error {
+	now := guard.now()
+	keys := guard.keys(username, clientIP)
+
+	var blocked *BlockedError
+	for i, k := range keys {
+		attempts, err := guard.store.Reserve(ctx, k.key, now, k.policy.Window)
+		if err != nil {
+			guard.release(ctx, keys[:i])
+			return err
+		}
+
+		var candidate *BlockedError
+		if now.Before(attempts.LockedUntil) {
+			candidate = &BlockedError{Locked: true, RetryAfter: attempts.LockedUntil.Sub(now)}
+		} else if wait := attempts.LastFailure.Add(k.policy.delay(attempts.Failures)); attempts.Failures > 0 && now.Before(wait) {
+			candidate = &BlockedError{RetryAfter: wait.Sub(now)}
+		}
+		if candidate != nil && (blocked == nil || candidate.RetryAfter > blocked.RetryAfter) {
+			blocked = candidate
+		}
+	}
+
+	if blocked != nil {
+		if err := guard.release(ctx, keys); err != nil {
+			return err
+		}
+		return blocked
+	}
+	return nil
+}
+
+// Fail settles an attempt reserved by Check as a failed login and reports
+// whether it locked the username.
+func (guard *Guard) Fail(ctx context.Context, username string, clientIP string) (bool, error) {
+	now := guard.now()
+
+	usernameLocked := false
+	for i, k := range guard.keys(username, clientIP) {
+		if k.policy.MaxFailures <= 0 {
+			continue
+		}
+		attempts, err := guard.store.Get(ctx, k.key)
+		if err != nil {
+			return false, err
+		}
+		if attempts.Failures < k.policy.MaxFailures {
+			continue
+		}
+
+		err = guard.store.Lock(ctx, k.key, now.Add(k.policy.LockDuration))
+		if err != nil {
+			return false, err
+		}
+		usernameLocked = usernameLocked || i == 0
+	}
+	return usernameLocked, nil
+}
+
+// Succeed settles an attempt reserved by Check as a successful login. It
+// forgets the failures of username, while those of the client IP are kept, so
+// that logging into an account of one's own does not reset them.
+func (guard *Guard) Succeed(ctx context.Context, username string, clientIP string) error {
+	keys := guard.keys(username, clientIP)
+	if err := guard.store.Reset(ctx, keys[0].key); err != nil {
+		return err
+	}
+	return guard.release(ctx, keys[1:])
+}
+
+// release takes back the attempts reserved against keys.
+func (guard *Guard) release(ctx context.Context, keys []guardKey) error {
+	for _, k := range keys {
+		if err := guard.store.Release(ctx, k.key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unlock lifts a lock on username and forgets its failures.
+func (guard *Guard) Unlock(ctx context.Context, username string) error {
+	return guard.store.Reset(ctx, usernameKey(username))
+}
+
+func usernameKey(username string) string {
+	return "user:" + strings.ToLower(username)
+}
+
+// normalizeIP strips the port of peer addresses so that every request from a
+// client counts against one key. Of a forwarded list only the last hop is kept,
+// since every earlier one could have been sent by the client itself.
+func normalizeIP(clientIP string) string {
+	if i := strings.LastIndex(clientIP, ","); i >= 0 {
+		clientIP = clientIP[i+1:]
+	}
+	clientIP = strings.TrimSpace(clientIP)
+	if host, _, err := net.SplitHostPort(clientIP); err == nil {
+		return host
+	}
+	return clientIP
+}
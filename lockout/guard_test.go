@@ -0,0 +1,131 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGuard(username Policy, ip Policy) (*Guard, *time.Time) {
+	now := time.Now()
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	guard := NewGuard(store, username, ip)
+	guard.now = func() time.Time { return now }
+	return guard, &now
+}
+
+// fail makes a login attempt that fails.
+func fail(t *testing.T, guard *Guard, username string, clientIP string) bool {
+	ctx := context.Background()
+	require.NoError(t, guard.Check(ctx, username, clientIP))
+	locked, err := guard.Fail(ctx, username, clientIP)
+	require.NoError(t, err)
+	return locked
+}
+
+func requireBlocked(t *testing.T, err error, locked bool, retryAfter time.Duration) {
+	var blocked *BlockedError
+	require.ErrorAs(t, err, &blocked)
+	require.Equal(t, locked, blocked.Locked)
+	require.Equal(t, retryAfter, blocked.RetryAfter)
+}
+
+func TestGuardProgressiveDelay(t *testing.T) {
+	ctx := context.Background()
+	guard, now := newTestGuard(Policy{
+		DelayAfter: 2,
+		BaseDelay:  time.Second,
+		MaxDelay:   3 * time.Second,
+		Window:     time.Hour,
+	}, Policy{Window: time.Hour})
+
+	for i := 0; i < 2; i++ {
+		fail(t, guard, "alice", "10.0.0.1")
+	}
+	requireBlocked(t, guard.Check(ctx, "Alice", "10.0.0.2"), false, time.Second)
+
+	*now = now.Add(time.Second)
+	fail(t, guard, "alice", "10.0.0.1")
+	requireBlocked(t, guard.Check(ctx, "alice", "10.0.0.1"), false, 2*time.Second)
+
+	*now = now.Add(2 * time.Second)
+	fail(t, guard, "alice", "10.0.0.1")
+	requireBlocked(t, guard.Check(ctx, "alice", "10.0.0.1"), false, 3*time.Second)
+
+	require.NoError(t, guard.Check(ctx, "bob", "10.0.0.1"), "other users are not delayed")
+
+	require.NoError(t, guard.Succeed(ctx, "bob", "10.0.0.1"))
+	require.NoError(t, guard.Succeed(ctx, "alice", "10.0.0.1"))
+	require.NoError(t, guard.Check(ctx, "alice", "10.0.0.1"))
+}
+
+func TestGuardLockout(t *testing.T) {
+	ctx := context.Background()
+	guard, now := newTestGuard(
+		Policy{MaxFailures: 3, LockDuration: time.Minute, Window: time.Hour},
+		Policy{MaxFailures: 5, LockDuration: 10 * time.Minute, Window: time.Hour},
+	)
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, i == 2, fail(t, guard, "alice", "10.0.0.1:5432"))
+	}
+	requireBlocked(t, guard.Check(ctx, "alice", "10.0.0.9"), true, time.Minute)
+	require.NoError(t, guard.Check(ctx, "bob", "10.0.0.9"))
+	require.NoError(t, guard.Succeed(ctx, "bob", "10.0.0.9"))
+
+	require.NoError(t, guard.Unlock(ctx, "alice"))
+	require.NoError(t, guard.Check(ctx, "alice", "10.0.0.9"))
+	require.NoError(t, guard.Succeed(ctx, "alice", "10.0.0.9"))
+
+	for i := 0; i < 2; i++ {
+		locked := fail(t, guard, "user"+string(rune('a'+i)), "203.0.113.9, 10.0.0.1")
+		require.False(t, locked, "a locked client IP does not lock the username")
+	}
+	requireBlocked(t, guard.Check(ctx, "carol", "10.0.0.1"), true, 10*time.Minute)
+
+	*now = now.Add(10 * time.Minute)
+	require.NoError(t, guard.Check(ctx, "carol", "10.0.0.1"))
+}
+
+func TestGuardForgetsOldFailures(t *testing.T) {
+	guard, now := newTestGuard(Policy{MaxFailures: 2, LockDuration: time.Minute, Window: time.Minute}, Policy{})
+
+	require.False(t, fail(t, guard, "alice", ""))
+
+	*now = now.Add(time.Minute)
+	require.False(t, fail(t, guard, "alice", ""))
+	require.Len(t, guard.store.(*MemoryStore).entries, 1, "expired entries are swept")
+}
+
+func TestGuardParallelAttempts(t *testing.T) {
+	ctx := context.Background()
+	guard, _ := newTestGuard(Policy{
+		DelayAfter: 2,
+		BaseDelay:  time.Minute,
+		MaxDelay:   time.Hour,
+		Window:     time.Hour,
+	}, Policy{Window: time.Hour})
+
+	var wg sync.WaitGroup
+	var passed atomic.Int32
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if guard.Check(ctx, "alice", "10.0.0.1") == nil {
+				passed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), passed.Load(), "a burst must not pass before the first attempts fail")
+
+	attempts, err := guard.store.Get(ctx, "user:alice")
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts.Failures, "blocked attempts are released")
+}
@@ -0,0 +1,104 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryEntry struct {
+	Attempts
+	expiresAt time.Time
+}
+
+// MemoryStore keeps login attempts in process memory. Counts are per
+// instance, so a deployment with several replicas allows proportionally more.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]*memoryEntry
+	now     func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]*memoryEntry),
+		now:     time.Now,
+	}
+}
+
+func (store *MemoryStore) Get(ctx context.Context, key string) (Attempts, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	entry, ok := store.entries[key]
+	if !ok || !store.now().Before(entry.expiresAt) {
+		return Attempts{}, nil
+	}
+	return entry.Attempts, nil
+}
+
+func (store *MemoryStore) Reserve(ctx context.Context, key string, at time.Time, window time.Duration) (Attempts, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	now := store.now()
+	entry, ok := store.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		store.sweep(now)
+		entry = &memoryEntry{}
+		store.entries[key] = entry
+	}
+
+	previous := entry.Attempts
+	entry.Failures++
+	entry.LastFailure = at
+	entry.expiresAt = at.Add(window)
+	if entry.LockedUntil.After(entry.expiresAt) {
+		entry.expiresAt = entry.LockedUntil
+	}
+	return previous, nil
+}
+
+func (store *MemoryStore) Release(ctx context.Context, key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	entry, ok := store.entries[key]
+	if ok && store.now().Before(entry.expiresAt) && entry.Failures > 0 {
+		entry.Failures--
+	}
+	return nil
+}
+
+func (store *MemoryStore) Lock(ctx context.Context, key string, until time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	entry, ok := store.entries[key]
+	if !ok {
+		entry = &memoryEntry{}
+		store.entries[key] = entry
+	}
+	entry.Failures = 0
+	entry.LockedUntil = until
+	entry.expiresAt = until
+	return nil
+}
+
+func (store *MemoryStore) Reset(ctx context.Context, key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	delete(store.entries, key)
+	return nil
+}
+
+// sweep drops expired entries so that keys such as client IPs do not
+// accumulate forever.
+func (store *MemoryStore) sweep(now time.Time) {
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisKeyPrefix   = "login_attempts:"
+	redisFailures    = "failures"
+	redisLastFailure = "last_failure"
+	redisLockedUntil = "locked_until"
+)
+
+// reserveScript counts an attempt as a failure, expires the hash after
+// window, or once a running lock has run out if that is later, and returns
+// the fields as they were before. Times are in milliseconds, except
+// last_failure and locked_until which are stored in nanoseconds.
+var reserveScript = redis.NewScript(`
+local previous = redis.call("HMGET", KEYS[1], "failures", "last_failure", "locked_until")
+redis.call("HINCRBY", KEYS[1], "failures", 1)
+redis.call("HSET", KEYS[1], "last_failure", ARGV[1])
+local expires_at = tonumber(ARGV[2]) + tonumber(ARGV[3])
+local locked_until = redis.call("HGET", KEYS[1], "locked_until")
+if locked_until then
+	locked_until = math.floor(tonumber(locked_until) / 1000000)
+	if locked_until > expires_at then
+		expires_at = locked_until
+	end
+end
+redis.call("PEXPIREAT", KEYS[1], expires_at)
+return previous
+`)
+
+// releaseScript takes back a reserved failure. It leaves missing keys alone,
+// so that it never creates a hash without an expiry.
+var releaseScript = redis.NewScript(`
+local failures = tonumber(redis.call("HGET", KEYS[1], "failures") or "0")
+if failures > 0 then
+	redis.call("HINCRBY", KEYS[1], "failures", -1)
+end
+return failures
+`)
+
+// RedisStore keeps login attempts in a Redis hash per key, so every replica
+// counts the same failures.
+type RedisStore struct {
+	client redis.UniversalClient
+}
+
+func NewRedisStore(client redis.UniversalClient) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (store *RedisStore) Get(ctx context.Context, key string) (Attempts, error) {
+	fields, err := store.client.HGetAll(ctx, redisKeyPrefix+key).Result()
+	if err != nil {
+		return Attempts{}, fmt.Errorf("failed to get login attempts: %w", err)
+	}
+
+	var attempts Attempts
+	attempts.Failures, _ = strconv.Atoi(fields[redisFailures])
+	attempts.LastFailure = parseUnixNano(fields[redisLastFailure])
+	attempts.LockedUntil = parseUnixNano(fields[redisLockedUntil])
+	return attempts, nil
+}
+
+func (store *RedisStore) Reserve(ctx context.Context, key string, at time.Time, window time.Duration) (Attempts, error) {
+	values, err := reserveScript.Run(ctx, store.client, []string{redisKeyPrefix + key},
+		at.UnixNano(), at.UnixMilli(), window.Milliseconds()).Slice()
+	if err != nil {
+		return Attempts{}, fmt.Errorf("failed to reserve login attempt: %w", err)
+	}
+
+	fields := make([]string, 3)
+	for i := range fields {
+		if i < len(values) {
+			fields[i], _ = values[i].(string)
+		}
+	}
+
+	var attempts Attempts
+	attempts.Failures, _ = strconv.Atoi(fields[0])
+	attempts.LastFailure = parseUnixNano(fields[1])
+	attempts.LockedUntil = parseUnixNano(fields[2])
+	return attempts, nil
+}
+
+func (store *RedisStore) Release(ctx context.Context, key string) error {
+	err := releaseScript.Run(ctx, store.client, []string{redisKeyPrefix + key}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to release login attempt: %w", err)
+	}
+	return nil
+}
+
+func (store *RedisStore) Lock(ctx context.Context, key string, until time.Time) error {
+	_, err := store.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, redisKeyPrefix+key, redisFailures, 0, redisLockedUntil, until.UnixNano())
+		pipe.PExpireAt(ctx, redisKeyPrefix+key, until)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to lock login: %w", err)
+	}
+	return nil
+}
+
+func (store *RedisStore) Reset(ctx context.Context, key string) error {
+	err := store.client.Del(ctx, redisKeyPrefix+key).Err()
+	if err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
+
+func parseUnixNano(value string) time.Time {
+	nanos, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
@@ -0,0 +1,29 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+// Attempts is the failed login state of a username or a client IP.
+type Attempts struct {
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+// Store keeps Attempts per key. State expires on its own once window has
+// passed without failures and any lock has run out.
+type Store interface {
+	Get(ctx context.Context, key string) (Attempts, error)
+	// Reserve counts an attempt made at the given time as a failure before its
+	// outcome is known and returns the Attempts as they were before it. The
+	// state is kept for window, or until a running lock has run out.
+	Reserve(ctx context.Context, key string, at time.Time, window time.Duration) (Attempts, error)
+	// Release takes back a reserved attempt that did not fail.
+	Release(ctx context.Context, key string) error
+	// Lock locks key until the given time and clears its failure count, so
+	// that counting starts over once the lock has run out.
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+}
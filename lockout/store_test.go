@@ -0,0 +1,102 @@
+package lockout
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// testStores returns every Store implementation. The Redis store is only
+// included when REDIS_ADDR points at a running server.
+func testStores(t *testing.T) map[string]Store {
+	stores := map[string]Store{"memory": NewMemoryStore()}
+
+	address := os.Getenv("REDIS_ADDR")
+	if address == "" {
+		t.Log("REDIS_ADDR is not set, skipping the Redis store")
+		return stores
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: address})
+	t.Cleanup(func() { client.Close() })
+	require.NoError(t, client.Ping(context.Background()).Err())
+	stores["redis"] = NewRedisStore(client)
+	return stores
+}
+
+func TestStoreCountsFailures(t *testing.T) {
+	for name, store := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			key := "user:" + utils.RandomString(8)
+			at := time.Now()
+
+			for i := 0; i < 3; i++ {
+				previous, err := store.Reserve(ctx, key, at.Add(time.Duration(i)*time.Second), time.Minute)
+				require.NoError(t, err)
+				require.Equal(t, i, previous.Failures)
+				if i > 0 {
+					require.WithinDuration(t, at.Add(time.Duration(i-1)*time.Second), previous.LastFailure, time.Microsecond)
+				}
+			}
+
+			attempts, err := store.Get(ctx, key)
+			require.NoError(t, err)
+			require.Equal(t, 3, attempts.Failures)
+			require.WithinDuration(t, at.Add(2*time.Second), attempts.LastFailure, time.Microsecond)
+
+			require.NoError(t, store.Release(ctx, key))
+			attempts, err = store.Get(ctx, key)
+			require.NoError(t, err)
+			require.Equal(t, 2, attempts.Failures)
+
+			require.NoError(t, store.Reset(ctx, key))
+			attempts, err = store.Get(ctx, key)
+			require.NoError(t, err)
+			require.Zero(t, attempts.Failures)
+		})
+	}
+}
+
+func TestStoreFailureKeepsLock(t *testing.T) {
+	for name, store := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			key := "user:" + utils.RandomString(8)
+			until := time.Now().Add(time.Minute)
+
+			require.NoError(t, store.Lock(ctx, key, until))
+
+			previous, err := store.Reserve(ctx, key, time.Now(), 50*time.Millisecond)
+			require.NoError(t, err)
+			require.Zero(t, previous.Failures)
+			require.WithinDuration(t, until, previous.LockedUntil, time.Microsecond)
+
+			// The failure window runs out long before the lock does.
+			time.Sleep(150 * time.Millisecond)
+
+			attempts, err := store.Get(ctx, key)
+			require.NoError(t, err)
+			require.WithinDuration(t, until, attempts.LockedUntil, time.Microsecond)
+		})
+	}
+}
+
+func TestStoreReleaseMissingKey(t *testing.T) {
+	for name, store := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			key := "ip:" + utils.RandomString(8)
+
+			require.NoError(t, store.Release(ctx, key))
+			attempts, err := store.Get(ctx, key)
+			require.NoError(t, err)
+			require.Zero(t, attempts.Failures)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrNoRecipients = errors.New("email has no recipients")
+
+type Message struct {
+	To       []string
+	Subject  string
+	HTMLBody string
+	TextBody string
+}
+
+// Sender delivers emails. Implementations must be safe for concurrent use.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,78 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderVerifyEmail(t *testing.T) {
+	msg, err := Render(TemplateVerifyEmail, "alice@example.com", "Verify your email", VerifyEmailData{
+		RestaurantName: "OrderFood",
+		FullName:       "<Alice>",
+		VerifyURL:      "https://example.com/verify-email?email_id=1&secret_code=abc",
+		ExpiresIn:      "24h0m0s",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"alice@example.com"}, msg.To)
+	require.Contains(t, msg.HTMLBody, "&lt;Alice&gt;")
+	require.Contains(t, msg.HTMLBody, "email_id=1&amp;secret_code=abc")
+	require.Contains(t, msg.TextBody, "Hello <Alice>,")
+	require.Contains(t, msg.TextBody, "https://example.com/verify-email?email_id=1&secret_code=abc")
+}
+
+func TestBuildMessage(t *testing.T) {
+	from := mail.Address{Name: "OrderFood", Address: "no-reply@example.com"}
+	data, err := buildMessage(from, Message{
+		To:       []string{"alice@example.com"},
+		Subject:  "Xác minh email",
+		HTMLBody: "<p>hello</p>",
+		TextBody: "hello",
+	}, time.Now())
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", parsed.Header.Get("To"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Xác minh email", subject)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	var bodies []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+	}
+	require.Equal(t, []string{"hello", "<p>hello</p>"}, bodies)
+}
+
+func TestFakeSender(t *testing.T) {
+	sender := NewFakeSender()
+
+	err := sender.Send(context.Background(), Message{})
+	require.ErrorIs(t, err, ErrNoRecipients)
+
+	msg := Message{To: []string{"alice@example.com"}, Subject: "hi"}
+	require.NoError(t, sender.Send(context.Background(), msg))
+	require.Equal(t, []Message{msg}, sender.Sent())
+}
@@ -0,0 +1,53 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var (
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html"))
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.txt"))
+)
+
+const (
+	TemplateVerifyEmail   = "verify_email"
+	TemplateResetPassword = "reset_password"
+)
+
+type VerifyEmailData struct {
+	RestaurantName string
+	FullName       string
+	VerifyURL      string
+	ExpiresIn      string
+}
+
+type ResetPasswordData struct {
+	RestaurantName string
+	FullName       string
+	ResetURL       string
+	ExpiresIn      string
+}
+
+// Render builds a message from templates/<name>.html and templates/<name>.txt.
+func Render(name string, to string, subject string, data any) (Message, error) {
+	var html, text bytes.Buffer
+	if err := htmlTemplates.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return Message{}, err
+	}
+	if err := textTemplates.ExecuteTemplate(&text, name+".txt", data); err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		To:       []string{to},
+		Subject:  subject,
+		HTMLBody: html.String(),
+		TextBody: text.String(),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package money
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Scan reads a numeric column, which lib/pq returns as text.
+func (a *Amount) Scan(src any) error {
+	var err error
+	switch value := src.(type) {
+	case []byte:
+		*a, err = Parse(string(value))
+	case string:
+		*a, err = Parse(value)
+	case int64:
+		*a = FromUnits(value)
+	default:
+		return fmt.Errorf("money: cannot scan %T into Amount", src)
+	}
+	return err
+}
+
+// Value writes the amount as a decimal string so Postgres never sees a float.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// MarshalJSON encodes the amount as a decimal string, e.g. "15000.50", the
+// format prices have always had in the API.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON accepts a decimal string or a JSON number. Numbers are read
+// from their text, never through a float.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	text := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	}
+	amount, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
@@ -0,0 +1,251 @@
+// Package money represents prices and totals exactly. An Amount is a whole
+// number of hundredths of the currency unit, matching the numeric(10,2)
+// columns, so that no value ever passes through a float.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Amount is a monetary amount in hundredths of the currency unit.
+type Amount int64
+
+const (
+	// Scale is the number of decimal places an Amount keeps.
+	Scale = 2
+	// MaxColumnAmount is the largest value a numeric(10,2) column holds.
+	MaxColumnAmount Amount = 99999999_99
+
+	unit = 100
+)
+
+var (
+	ErrInvalidAmount = errors.New("invalid amount")
+	ErrTooPrecise    = fmt.Errorf("amount has more than %d decimal places", Scale)
+)
+
+// FromMinor returns the Amount of the given number of hundredths.
+func FromMinor(minor int64) Amount {
+	return Amount(minor)
+}
+
+// FromUnits returns the Amount of a whole number of currency units.
+func FromUnits(units int64) Amount {
+	return Amount(units).Mul(unit)
+}
+
+// Parse reads a decimal string such as "15000", "-3.5" or "20000.25". It
+// rejects exponents and more than Scale decimal places instead of rounding;
+// use ParseRound where rounding is intended.
+func Parse(value string) (Amount, error) {
+	amount, rest, err := parse(value)
+	if err != nil {
+		return 0, err
+	}
+	if strings.Trim(rest, "0") != "" {
+		return 0, ErrTooPrecise
+	}
+	return amount, nil
+}
+
+// ParseRound reads a decimal string like Parse and rounds any decimal places
+// beyond Scale with the given rounding.
+func ParseRound(value string, rounding Rounding) (Amount, error) {
+	amount, rest, err := parse(value)
+	if err != nil {
+		return 0, err
+	}
+	rest = strings.TrimRight(rest, "0")
+	if rest == "" {
+		return amount, nil
+	}
+
+	// Only the first dropped digit and whether any non-zero digit follows
+	// matter to every rounding, so the remainder is reduced to that.
+	remainder := int64(rest[0]-'0') * 10
+	if len(rest) > 1 {
+		remainder++
+	}
+	if amount < 0 || strings.HasPrefix(strings.TrimSpace(value), "-") {
+		remainder = -remainder
+	}
+	return amount.add(Amount(rounding.round(int64(amount), remainder, 100))), nil
+}
+
+// MustParse is like Parse but panics on error. It is meant for constants and
+// tests.
+func MustParse(value string) Amount {
+	amount, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// parse returns the Amount of the first Scale decimal places of value and the
+// digits that follow them.
+func parse(value string) (Amount, string, error) {
+	s := strings.TrimSpace(value)
+	negative := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	whole, fraction, hasPoint := strings.Cut(s, ".")
+	if whole == "" && fraction == "" || !isDigits(whole) || !isDigits(fraction) || hasPoint && fraction == "" {
+		return 0, "", fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+
+	rest := ""
+	if len(fraction) > Scale {
+		fraction, rest = fraction[:Scale], fraction[Scale:]
+	}
+	fraction += strings.Repeat("0", Scale-len(fraction))
+
+	if whole == "" {
+		whole = "0"
+	}
+	minor, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: %q is out of range", ErrInvalidAmount, value)
+	}
+	if negative {
+		minor = -minor
+	}
+	return Amount(minor), rest, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Minor returns the amount in hundredths.
+func (a Amount) Minor() int64 {
+	return int64(a)
+}
+
+// String formats the amount with exactly Scale decimal places, the format
+// numeric(10,2) columns use.
+func (a Amount) String() string {
+	minor := int64(a)
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+	}
+	abs := uint64(minor)
+	if minor < 0 {
+		abs = uint64(-(minor + 1)) + 1
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, abs/unit, abs%unit)
+}
+
+func (a Amount) IsNegative() bool {
+	return a < 0
+}
+
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+// Add returns a + b. Like Sub and Mul it panics on int64 overflow, which lies
+// far beyond any amount the database can store.
+func (a Amount) Add(b Amount) Amount {
+	return a.add(b)
+}
+
+func (a Amount) add(b Amount) Amount {
+	sum := a + b
+	if (sum > a) != (b > 0) {
+		panic("money: overflow")
+	}
+	return sum
+}
+
+func (a Amount) Sub(b Amount) Amount {
+	if b == math.MinInt64 {
+		panic("money: overflow")
+	}
+	return a.add(-b)
+}
+
+// Mul returns the amount multiplied by a whole number, such as a quantity.
+func (a Amount) Mul(n int64) Amount {
+	if a == 0 || n == 0 {
+		return 0
+	}
+	product := a * Amount(n)
+	if product/Amount(n) != a || (a == -1 && n == math.MinInt64) || (n == -1 && a == math.MinInt64) {
+		panic("money: overflow")
+	}
+	return product
+}
+
+// MulRatio returns a * numerator / denominator rounded to a hundredth, for
+// rates such as taxes and discounts: a 10% discount is a.MulRatio(10, 100,
+// RoundHalfUp).
+func (a Amount) MulRatio(numerator int64, denominator int64, rounding Rounding) Amount {
+	if denominator == 0 {
+		panic("money: division by zero")
+	}
+	if denominator < 0 {
+		numerator, denominator = -numerator, -denominator
+	}
+	product := a.Mul(numerator)
+	quotient := int64(product) / denominator
+	remainder := int64(product) % denominator
+	return Amount(quotient).add(Amount(rounding.round(quotient, remainder, denominator)))
+}
+
+// RoundTo rounds the amount to a multiple of step, for example to whole
+// thousands when a bill is settled in cash.
+func (a Amount) RoundTo(step Amount, rounding Rounding) Amount {
+	if step <= 0 {
+		panic("money: rounding step must be positive")
+	}
+	quotient := int64(a / step)
+	remainder := int64(a % step)
+	quotient += rounding.round(quotient, remainder, int64(step))
+	return step.Mul(quotient)
+}
+
+// Split divides the amount into n parts that differ by at most a hundredth
+// and add up to exactly the amount; earlier parts receive the extra
+// hundredths.
+func (a Amount) Split(n int) []Amount {
+	if n <= 0 {
+		panic("money: split into fewer than one part")
+	}
+	parts := make([]Amount, n)
+	share := a / Amount(n)
+	remainder := a % Amount(n)
+	step := Amount(1)
+	if remainder < 0 {
+		remainder, step = -remainder, -1
+	}
+	for i := range parts {
+		parts[i] = share
+		if Amount(i) < remainder {
+			parts[i] += step
+		}
+	}
+	return parts
+}
+
+// Sum adds up amounts.
+func Sum(amounts ...Amount) Amount {
+	var total Amount
+	for _, amount := range amounts {
+		total = total.add(amount)
+	}
+	return total
+}
@@ -0,0 +1,144 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  Amount
+	}{
+		{"15000", 1500000},
+		{"15000.5", 1500050},
+		{"20000.25", 2000025},
+		{"0.01", 1},
+		{".5", 50},
+		{"-3.50", -350},
+		{"+7", 700},
+		{"1.250", 125},
+		{"99999999.99", MaxColumnAmount},
+	}
+	for _, tc := range testCases {
+		amount, err := Parse(tc.input)
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.want, amount, tc.input)
+	}
+
+	for _, input := range []string{"", "-", ".", "1.", "abc", "1e5", "1,5", "1.2.3", "0x10", "99999999999999999999"} {
+		_, err := Parse(input)
+		require.ErrorIs(t, err, ErrInvalidAmount, input)
+	}
+
+	_, err := Parse("1.005")
+	require.ErrorIs(t, err, ErrTooPrecise)
+}
+
+func TestParseRound(t *testing.T) {
+	testCases := []struct {
+		input    string
+		rounding Rounding
+		want     string
+	}{
+		{"1.005", RoundHalfUp, "1.01"},
+		{"1.004", RoundHalfUp, "1.00"},
+		{"-1.005", RoundHalfUp, "-1.01"},
+		{"1.005", RoundHalfEven, "1.00"},
+		{"1.015", RoundHalfEven, "1.02"},
+		{"1.0051", RoundHalfEven, "1.01"},
+		{"-0.005", RoundHalfEven, "0.00"},
+		{"-0.015", RoundHalfEven, "-0.02"},
+		{"1.009", RoundDown, "1.00"},
+		{"1.001", RoundUp, "1.01"},
+		{"-1.001", RoundUp, "-1.01"},
+		{"2.50000", RoundUp, "2.50"},
+	}
+	for _, tc := range testCases {
+		amount, err := ParseRound(tc.input, tc.rounding)
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.want, amount.String(), tc.input)
+	}
+}
+
+func TestString(t *testing.T) {
+	require.Equal(t, "0.00", Amount(0).String())
+	require.Equal(t, "0.05", Amount(5).String())
+	require.Equal(t, "-0.05", Amount(-5).String())
+	require.Equal(t, "15000.50", MustParse("15000.5").String())
+	require.Equal(t, "-92233720368547758.08", Amount(-1<<63).String())
+}
+
+func TestArithmetic(t *testing.T) {
+	price := MustParse("15000.50")
+	require.Equal(t, MustParse("45001.50"), price.Mul(3))
+	require.Equal(t, MustParse("65001.75"), Sum(price.Mul(3), MustParse("20000.25")))
+	require.Equal(t, MustParse("-0.50"), MustParse("1.00").Sub(MustParse("1.50")))
+	require.True(t, MustParse("-0.01").IsNegative())
+	require.Equal(t, MustParse("150"), FromUnits(150))
+
+	require.Panics(t, func() { Amount(math.MaxInt64).Add(1) })
+	require.Panics(t, func() { Amount(math.MaxInt64).Mul(2) })
+}
+
+func TestMulRatio(t *testing.T) {
+	// 8% of 10.05 is 0.804 and 10% of 0.05 is exactly half a hundredth.
+	require.Equal(t, "0.80", MustParse("10.05").MulRatio(8, 100, RoundHalfUp).String())
+	require.Equal(t, "0.01", MustParse("0.05").MulRatio(10, 100, RoundHalfUp).String())
+	require.Equal(t, "0.00", MustParse("0.05").MulRatio(10, 100, RoundHalfEven).String())
+	require.Equal(t, "0.00", MustParse("0.05").MulRatio(10, 100, RoundDown).String())
+	require.Equal(t, "-0.01", MustParse("-0.05").MulRatio(10, 100, RoundHalfUp).String())
+	require.Equal(t, "33.34", MustParse("100").MulRatio(1, 3, RoundUp).String())
+	require.Equal(t, "-33.33", MustParse("100").MulRatio(1, -3, RoundDown).String())
+}
+
+func TestRoundTo(t *testing.T) {
+	thousand := FromUnits(1000)
+	require.Equal(t, "65000.00", MustParse("65001.75").RoundTo(thousand, RoundHalfUp).String())
+	require.Equal(t, "66000.00", MustParse("65500").RoundTo(thousand, RoundHalfUp).String())
+	require.Equal(t, "66000.00", MustParse("65000.01").RoundTo(thousand, RoundUp).String())
+	require.Equal(t, "-66000.00", MustParse("-65500").RoundTo(thousand, RoundHalfUp).String())
+}
+
+func TestSplit(t *testing.T) {
+	parts := MustParse("100").Split(3)
+	require.Equal(t, []Amount{3334, 3333, 3333}, parts)
+	require.Equal(t, MustParse("100"), Sum(parts...))
+
+	parts = MustParse("-0.05").Split(2)
+	require.Equal(t, []Amount{-3, -2}, parts)
+}
+
+func TestScanAndValue(t *testing.T) {
+	var amount Amount
+	require.NoError(t, amount.Scan([]byte("15000.50")))
+	require.Equal(t, MustParse("15000.50"), amount)
+	require.NoError(t, amount.Scan(int64(12)))
+	require.Equal(t, FromUnits(12), amount)
+	require.Error(t, amount.Scan(1.5))
+
+	value, err := MustParse("20000.25").Value()
+	require.NoError(t, err)
+	require.Equal(t, "20000.25", value)
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Price Amount `json:"price"`
+	}{MustParse("15000.5")})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"price":"15000.50"}`, string(data))
+
+	var body struct {
+		Price Amount `json:"price"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"price":"15000.50"}`), &body))
+	require.Equal(t, MustParse("15000.50"), body.Price)
+	require.NoError(t, json.Unmarshal([]byte(`{"price":0.1}`), &body))
+	require.Equal(t, MustParse("0.10"), body.Price)
+	require.Error(t, json.Unmarshal([]byte(`{"price":1e3}`), &body))
+	require.Error(t, json.Unmarshal([]byte(`{"price":"0.001"}`), &body))
+}
@@ -0,0 +1,48 @@
+package money
+
+// Rounding decides what happens to a fraction of a hundredth, or of a
+// RoundTo step, that an operation cannot keep.
+type Rounding int
+
+const (
+	// RoundHalfUp rounds to the nearest value and halves away from zero, as
+	// receipts are usually rounded.
+	RoundHalfUp Rounding = iota
+	// RoundHalfEven rounds to the nearest value and halves to the even one,
+	// which keeps long sums unbiased.
+	RoundHalfEven
+	// RoundDown drops the fraction, rounding toward zero.
+	RoundDown
+	// RoundUp rounds any fraction away from zero.
+	RoundUp
+)
+
+// round returns the adjustment, -1, 0 or 1, to apply to quotient given the
+// remainder of a division by denominator. The remainder has the sign of the
+// dividend and denominator is positive.
+func (rounding Rounding) round(quotient int64, remainder int64, denominator int64) int64 {
+	if remainder == 0 {
+		return 0
+	}
+	sign := int64(1)
+	if remainder < 0 {
+		sign, remainder = -1, -remainder
+	}
+
+	switch rounding {
+	case RoundDown:
+		return 0
+	case RoundUp:
+		return sign
+	case RoundHalfEven:
+		if twice := 2 * remainder; twice > denominator || twice == denominator && quotient%2 != 0 {
+			return sign
+		}
+		return 0
+	default:
+		if 2*remainder >= denominator {
+			return sign
+		}
+		return 0
+	}
+}
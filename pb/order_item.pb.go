@@ -0,0 +1,521 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: order_item.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MenuId        int64                  `protobuf:"varint,3,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         string                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	NoteItem      string                 `protobuf:"bytes,6,opt,name=note_item,json=noteItem,proto3" json:"note_item,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OptionsPrice  string                 `protobuf:"bytes,9,opt,name=options_price,json=optionsPrice,proto3" json:"options_price,omitempty"`
+	TotalPrice    string                 `protobuf:"bytes,10,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Options       []*OrderItemOption     `protobuf:"bytes,11,rep,name=options,proto3" json:"options,omitempty"`
+	VariantId     int64                  `protobuf:"varint,12,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantName   string                 `protobuf:"bytes,13,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_order_item_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_item_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_order_item_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *OrderItem) GetNoteItem() string {
+	if x != nil {
+		return x.NoteItem
+	}
+	return ""
+}
+
+func (x *OrderItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrderItem) GetOptionsPrice() string {
+	if x != nil {
+		return x.OptionsPrice
+	}
+	return ""
+}
+
+func (x *OrderItem) GetTotalPrice() string {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return ""
+}
+
+func (x *OrderItem) GetOptions() []*OrderItemOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *OrderItem) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetVariantName() string {
+	if x != nil {
+		return x.VariantName
+	}
+	return ""
+}
+
+type OrderItemOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionId      int64                  `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price         string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItemOption) Reset() {
+	*x = OrderItemOption{}
+	mi := &file_order_item_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItemOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItemOption) ProtoMessage() {}
+
+func (x *OrderItemOption) ProtoReflect() protoreflect.Message {
+	mi := &file_order_item_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItemOption.ProtoReflect.Descriptor instead.
+func (*OrderItemOption) Descriptor() ([]byte, []int) {
+	return file_order_item_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderItemOption) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *OrderItemOption) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *OrderItemOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItemOption) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+type KitchenItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TableId       int64                  `protobuf:"varint,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	MenuId        int64                  `protobuf:"varint,4,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	MenuName      string                 `protobuf:"bytes,5,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	NoteItem      string                 `protobuf:"bytes,7,opt,name=note_item,json=noteItem,proto3" json:"note_item,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Options       []*OrderItemOption     `protobuf:"bytes,10,rep,name=options,proto3" json:"options,omitempty"`
+	VariantName   string                 `protobuf:"bytes,11,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitchenItem) Reset() {
+	*x = KitchenItem{}
+	mi := &file_order_item_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitchenItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitchenItem) ProtoMessage() {}
+
+func (x *KitchenItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_item_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitchenItem.ProtoReflect.Descriptor instead.
+func (*KitchenItem) Descriptor() ([]byte, []int) {
+	return file_order_item_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KitchenItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KitchenItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *KitchenItem) GetTableId() int64 {
+	if x != nil {
+		return x.TableId
+	}
+	return 0
+}
+
+func (x *KitchenItem) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *KitchenItem) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *KitchenItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *KitchenItem) GetNoteItem() string {
+	if x != nil {
+		return x.NoteItem
+	}
+	return ""
+}
+
+func (x *KitchenItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KitchenItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *KitchenItem) GetOptions() []*OrderItemOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *KitchenItem) GetVariantName() string {
+	if x != nil {
+		return x.VariantName
+	}
+	return ""
+}
+
+type KitchenStation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Items         []*KitchenItem         `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitchenStation) Reset() {
+	*x = KitchenStation{}
+	mi := &file_order_item_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitchenStation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitchenStation) ProtoMessage() {}
+
+func (x *KitchenStation) ProtoReflect() protoreflect.Message {
+	mi := &file_order_item_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitchenStation.ProtoReflect.Descriptor instead.
+func (*KitchenStation) Descriptor() ([]byte, []int) {
+	return file_order_item_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KitchenStation) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *KitchenStation) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *KitchenStation) GetItems() []*KitchenItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_order_item_proto protoreflect.FileDescriptor
+
+const file_order_item_proto_rawDesc = "" +
+	"\n" +
+	"\x10order_item.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x03\n" +
+	"\tOrderItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x17\n" +
+	"\amenu_id\x18\x03 \x01(\x03R\x06menuId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\x12\x1b\n" +
+	"\tnote_item\x18\x06 \x01(\tR\bnoteItem\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\roptions_price\x18\t \x01(\tR\foptionsPrice\x12\x1f\n" +
+	"\vtotal_price\x18\n" +
+	" \x01(\tR\n" +
+	"totalPrice\x12-\n" +
+	"\aoptions\x18\v \x03(\v2\x13.pb.OrderItemOptionR\aoptions\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\f \x01(\x03R\tvariantId\x12!\n" +
+	"\fvariant_name\x18\r \x01(\tR\vvariantName\"w\n" +
+	"\x0fOrderItemOption\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\x03R\boptionId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\"\xe7\x02\n" +
+	"\vKitchenItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x19\n" +
+	"\btable_id\x18\x03 \x01(\x03R\atableId\x12\x17\n" +
+	"\amenu_id\x18\x04 \x01(\x03R\x06menuId\x12\x1b\n" +
+	"\tmenu_name\x18\x05 \x01(\tR\bmenuName\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tnote_item\x18\a \x01(\tR\bnoteItem\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12-\n" +
+	"\aoptions\x18\n" +
+	" \x03(\v2\x13.pb.OrderItemOptionR\aoptions\x12!\n" +
+	"\fvariant_name\x18\v \x01(\tR\vvariantName\"}\n" +
+	"\x0eKitchenStation\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12%\n" +
+	"\x05items\x18\x03 \x03(\v2\x0f.pb.KitchenItemR\x05itemsB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
+
+var (
+	file_order_item_proto_rawDescOnce sync.Once
+	file_order_item_proto_rawDescData []byte
+)
+
+func file_order_item_proto_rawDescGZIP() []byte {
+	file_order_item_proto_rawDescOnce.Do(func() {
+		file_order_item_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_order_item_proto_rawDesc), len(file_order_item_proto_rawDesc)))
+	})
+	return file_order_item_proto_rawDescData
+}
+
+var file_order_item_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_order_item_proto_goTypes = []any{
+	(*OrderItem)(nil),             // 0: pb.OrderItem
+	(*OrderItemOption)(nil),       // 1: pb.OrderItemOption
+	(*KitchenItem)(nil),           // 2: pb.KitchenItem
+	(*KitchenStation)(nil),        // 3: pb.KitchenStation
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_order_item_proto_depIdxs = []int32{
+	4, // 0: pb.OrderItem.created_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.OrderItem.options:type_name -> pb.OrderItemOption
+	4, // 2: pb.KitchenItem.created_at:type_name -> google.protobuf.Timestamp
+	1, // 3: pb.KitchenItem.options:type_name -> pb.OrderItemOption
+	2, // 4: pb.KitchenStation.items:type_name -> pb.KitchenItem
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_order_item_proto_init() }
+func file_order_item_proto_init() {
+	if File_order_item_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_item_proto_rawDesc), len(file_order_item_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_order_item_proto_goTypes,
+		DependencyIndexes: file_order_item_proto_depIdxs,
+		MessageInfos:      file_order_item_proto_msgTypes,
+	}.Build()
+	File_order_item_proto = out.File
+	file_order_item_proto_goTypes = nil
+	file_order_item_proto_depIdxs = nil
+}
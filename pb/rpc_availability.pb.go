@@ -0,0 +1,781 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: rpc_availability.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AvailabilityWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Days          []string               `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityWindow) Reset() {
+	*x = AvailabilityWindow{}
+	mi := &file_rpc_availability_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityWindow) ProtoMessage() {}
+
+func (x *AvailabilityWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityWindow.ProtoReflect.Descriptor instead.
+func (*AvailabilityWindow) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AvailabilityWindow) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AvailabilityWindow) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *AvailabilityWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *AvailabilityWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type AvailabilityException struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Available     bool                   `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityException) Reset() {
+	*x = AvailabilityException{}
+	mi := &file_rpc_availability_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityException) ProtoMessage() {}
+
+func (x *AvailabilityException) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityException.ProtoReflect.Descriptor instead.
+func (*AvailabilityException) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AvailabilityException) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AvailabilityException) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AvailabilityException) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type Schedule struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Timezone      string                   `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Windows       []*AvailabilityWindow    `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	Exceptions    []*AvailabilityException `protobuf:"bytes,3,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_rpc_availability_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Schedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Schedule) GetWindows() []*AvailabilityWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *Schedule) GetExceptions() []*AvailabilityException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+type GetMenuScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuId        int64                  `protobuf:"varint,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuScheduleRequest) Reset() {
+	*x = GetMenuScheduleRequest{}
+	mi := &file_rpc_availability_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuScheduleRequest) ProtoMessage() {}
+
+func (x *GetMenuScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMenuScheduleRequest) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+type GetCategoryScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryScheduleRequest) Reset() {
+	*x = GetCategoryScheduleRequest{}
+	mi := &file_rpc_availability_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryScheduleRequest) ProtoMessage() {}
+
+func (x *GetCategoryScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCategoryScheduleRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type GetScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleResponse) Reset() {
+	*x = GetScheduleResponse{}
+	mi := &file_rpc_availability_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleResponse) ProtoMessage() {}
+
+func (x *GetScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ReplaceMenuScheduleRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	MenuId        int64                    `protobuf:"varint,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	Windows       []*AvailabilityWindow    `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	Exceptions    []*AvailabilityException `protobuf:"bytes,3,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceMenuScheduleRequest) Reset() {
+	*x = ReplaceMenuScheduleRequest{}
+	mi := &file_rpc_availability_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceMenuScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceMenuScheduleRequest) ProtoMessage() {}
+
+func (x *ReplaceMenuScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceMenuScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceMenuScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReplaceMenuScheduleRequest) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *ReplaceMenuScheduleRequest) GetWindows() []*AvailabilityWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *ReplaceMenuScheduleRequest) GetExceptions() []*AvailabilityException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+type ReplaceCategoryScheduleRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	CategoryId    int64                    `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Windows       []*AvailabilityWindow    `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	Exceptions    []*AvailabilityException `protobuf:"bytes,3,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceCategoryScheduleRequest) Reset() {
+	*x = ReplaceCategoryScheduleRequest{}
+	mi := &file_rpc_availability_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceCategoryScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceCategoryScheduleRequest) ProtoMessage() {}
+
+func (x *ReplaceCategoryScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceCategoryScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceCategoryScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReplaceCategoryScheduleRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ReplaceCategoryScheduleRequest) GetWindows() []*AvailabilityWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *ReplaceCategoryScheduleRequest) GetExceptions() []*AvailabilityException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+type ReplaceScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceScheduleResponse) Reset() {
+	*x = ReplaceScheduleResponse{}
+	mi := &file_rpc_availability_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceScheduleResponse) ProtoMessage() {}
+
+func (x *ReplaceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplaceScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type AvailableMenu struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Menu          *Menu                  `protobuf:"bytes,1,opt,name=menu,proto3" json:"menu,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Variants      []*MenuVariant         `protobuf:"bytes,3,rep,name=variants,proto3" json:"variants,omitempty"`
+	OptionGroups  []*OptionGroup         `protobuf:"bytes,4,rep,name=option_groups,json=optionGroups,proto3" json:"option_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailableMenu) Reset() {
+	*x = AvailableMenu{}
+	mi := &file_rpc_availability_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableMenu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableMenu) ProtoMessage() {}
+
+func (x *AvailableMenu) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableMenu.ProtoReflect.Descriptor instead.
+func (*AvailableMenu) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AvailableMenu) GetMenu() *Menu {
+	if x != nil {
+		return x.Menu
+	}
+	return nil
+}
+
+func (x *AvailableMenu) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *AvailableMenu) GetVariants() []*MenuVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *AvailableMenu) GetOptionGroups() []*OptionGroup {
+	if x != nil {
+		return x.OptionGroups
+	}
+	return nil
+}
+
+type ListAvailableMenusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAvailableMenusRequest) Reset() {
+	*x = ListAvailableMenusRequest{}
+	mi := &file_rpc_availability_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAvailableMenusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableMenusRequest) ProtoMessage() {}
+
+func (x *ListAvailableMenusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableMenusRequest.ProtoReflect.Descriptor instead.
+func (*ListAvailableMenusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{10}
+}
+
+type ListAvailableMenusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Menus         []*AvailableMenu       `protobuf:"bytes,1,rep,name=menus,proto3" json:"menus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAvailableMenusResponse) Reset() {
+	*x = ListAvailableMenusResponse{}
+	mi := &file_rpc_availability_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAvailableMenusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableMenusResponse) ProtoMessage() {}
+
+func (x *ListAvailableMenusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_availability_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableMenusResponse.ProtoReflect.Descriptor instead.
+func (*ListAvailableMenusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_availability_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAvailableMenusResponse) GetMenus() []*AvailableMenu {
+	if x != nil {
+		return x.Menus
+	}
+	return nil
+}
+
+var File_rpc_availability_proto protoreflect.FileDescriptor
+
+const file_rpc_availability_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_availability.proto\x12\x02pb\x1a\n" +
+	"menu.proto\"`\n" +
+	"\x12AvailabilityWindow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04days\x18\x02 \x03(\tR\x04days\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\"Y\n" +
+	"\x15AvailabilityException\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\bR\tavailable\"\x93\x01\n" +
+	"\bSchedule\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x120\n" +
+	"\awindows\x18\x02 \x03(\v2\x16.pb.AvailabilityWindowR\awindows\x129\n" +
+	"\n" +
+	"exceptions\x18\x03 \x03(\v2\x19.pb.AvailabilityExceptionR\n" +
+	"exceptions\"1\n" +
+	"\x16GetMenuScheduleRequest\x12\x17\n" +
+	"\amenu_id\x18\x01 \x01(\x03R\x06menuId\"=\n" +
+	"\x1aGetCategoryScheduleRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\"?\n" +
+	"\x13GetScheduleResponse\x12(\n" +
+	"\bschedule\x18\x01 \x01(\v2\f.pb.ScheduleR\bschedule\"\xa2\x01\n" +
+	"\x1aReplaceMenuScheduleRequest\x12\x17\n" +
+	"\amenu_id\x18\x01 \x01(\x03R\x06menuId\x120\n" +
+	"\awindows\x18\x02 \x03(\v2\x16.pb.AvailabilityWindowR\awindows\x129\n" +
+	"\n" +
+	"exceptions\x18\x03 \x03(\v2\x19.pb.AvailabilityExceptionR\n" +
+	"exceptions\"\xae\x01\n" +
+	"\x1eReplaceCategoryScheduleRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x120\n" +
+	"\awindows\x18\x02 \x03(\v2\x16.pb.AvailabilityWindowR\awindows\x129\n" +
+	"\n" +
+	"exceptions\x18\x03 \x03(\v2\x19.pb.AvailabilityExceptionR\n" +
+	"exceptions\"C\n" +
+	"\x17ReplaceScheduleResponse\x12(\n" +
+	"\bschedule\x18\x01 \x01(\v2\f.pb.ScheduleR\bschedule\"\xb5\x01\n" +
+	"\rAvailableMenu\x12\x1c\n" +
+	"\x04menu\x18\x01 \x01(\v2\b.pb.MenuR\x04menu\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12+\n" +
+	"\bvariants\x18\x03 \x03(\v2\x0f.pb.MenuVariantR\bvariants\x124\n" +
+	"\roption_groups\x18\x04 \x03(\v2\x0f.pb.OptionGroupR\foptionGroups\"\x1b\n" +
+	"\x19ListAvailableMenusRequest\"E\n" +
+	"\x1aListAvailableMenusResponse\x12'\n" +
+	"\x05menus\x18\x01 \x03(\v2\x11.pb.AvailableMenuR\x05menusB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
+
+var (
+	file_rpc_availability_proto_rawDescOnce sync.Once
+	file_rpc_availability_proto_rawDescData []byte
+)
+
+func file_rpc_availability_proto_rawDescGZIP() []byte {
+	file_rpc_availability_proto_rawDescOnce.Do(func() {
+		file_rpc_availability_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_availability_proto_rawDesc), len(file_rpc_availability_proto_rawDesc)))
+	})
+	return file_rpc_availability_proto_rawDescData
+}
+
+var file_rpc_availability_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_rpc_availability_proto_goTypes = []any{
+	(*AvailabilityWindow)(nil),             // 0: pb.AvailabilityWindow
+	(*AvailabilityException)(nil),          // 1: pb.AvailabilityException
+	(*Schedule)(nil),                       // 2: pb.Schedule
+	(*GetMenuScheduleRequest)(nil),         // 3: pb.GetMenuScheduleRequest
+	(*GetCategoryScheduleRequest)(nil),     // 4: pb.GetCategoryScheduleRequest
+	(*GetScheduleResponse)(nil),            // 5: pb.GetScheduleResponse
+	(*ReplaceMenuScheduleRequest)(nil),     // 6: pb.ReplaceMenuScheduleRequest
+	(*ReplaceCategoryScheduleRequest)(nil), // 7: pb.ReplaceCategoryScheduleRequest
+	(*ReplaceScheduleResponse)(nil),        // 8: pb.ReplaceScheduleResponse
+	(*AvailableMenu)(nil),                  // 9: pb.AvailableMenu
+	(*ListAvailableMenusRequest)(nil),      // 10: pb.ListAvailableMenusRequest
+	(*ListAvailableMenusResponse)(nil),     // 11: pb.ListAvailableMenusResponse
+	(*Menu)(nil),                           // 12: pb.Menu
+	(*MenuVariant)(nil),                    // 13: pb.MenuVariant
+	(*OptionGroup)(nil),                    // 14: pb.OptionGroup
+}
+var file_rpc_availability_proto_depIdxs = []int32{
+	0,  // 0: pb.Schedule.windows:type_name -> pb.AvailabilityWindow
+	1,  // 1: pb.Schedule.exceptions:type_name -> pb.AvailabilityException
+	2,  // 2: pb.GetScheduleResponse.schedule:type_name -> pb.Schedule
+	0,  // 3: pb.ReplaceMenuScheduleRequest.windows:type_name -> pb.AvailabilityWindow
+	1,  // 4: pb.ReplaceMenuScheduleRequest.exceptions:type_name -> pb.AvailabilityException
+	0,  // 5: pb.ReplaceCategoryScheduleRequest.windows:type_name -> pb.AvailabilityWindow
+	1,  // 6: pb.ReplaceCategoryScheduleRequest.exceptions:type_name -> pb.AvailabilityException
+	2,  // 7: pb.ReplaceScheduleResponse.schedule:type_name -> pb.Schedule
+	12, // 8: pb.AvailableMenu.menu:type_name -> pb.Menu
+	13, // 9: pb.AvailableMenu.variants:type_name -> pb.MenuVariant
+	14, // 10: pb.AvailableMenu.option_groups:type_name -> pb.OptionGroup
+	9,  // 11: pb.ListAvailableMenusResponse.menus:type_name -> pb.AvailableMenu
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_rpc_availability_proto_init() }
+func file_rpc_availability_proto_init() {
+	if File_rpc_availability_proto != nil {
+		return
+	}
+	file_menu_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_availability_proto_rawDesc), len(file_rpc_availability_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_availability_proto_goTypes,
+		DependencyIndexes: file_rpc_availability_proto_depIdxs,
+		MessageInfos:      file_rpc_availability_proto_msgTypes,
+	}.Build()
+	File_rpc_availability_proto = out.File
+	file_rpc_availability_proto_goTypes = nil
+	file_rpc_availability_proto_depIdxs = nil
+}
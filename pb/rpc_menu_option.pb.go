@@ -0,0 +1,663 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: rpc_menu_option.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MenuOptionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price         string                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuOptionInput) Reset() {
+	*x = MenuOptionInput{}
+	mi := &file_rpc_menu_option_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuOptionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuOptionInput) ProtoMessage() {}
+
+func (x *MenuOptionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuOptionInput.ProtoReflect.Descriptor instead.
+func (*MenuOptionInput) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MenuOptionInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuOptionInput) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+type CreateOptionGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuId        int64                  `protobuf:"varint,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MinSelections int32                  `protobuf:"varint,3,opt,name=min_selections,json=minSelections,proto3" json:"min_selections,omitempty"`
+	MaxSelections int32                  `protobuf:"varint,4,opt,name=max_selections,json=maxSelections,proto3" json:"max_selections,omitempty"`
+	Options       []*MenuOptionInput     `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOptionGroupRequest) Reset() {
+	*x = CreateOptionGroupRequest{}
+	mi := &file_rpc_menu_option_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOptionGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOptionGroupRequest) ProtoMessage() {}
+
+func (x *CreateOptionGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOptionGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateOptionGroupRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateOptionGroupRequest) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *CreateOptionGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOptionGroupRequest) GetMinSelections() int32 {
+	if x != nil {
+		return x.MinSelections
+	}
+	return 0
+}
+
+func (x *CreateOptionGroupRequest) GetMaxSelections() int32 {
+	if x != nil {
+		return x.MaxSelections
+	}
+	return 0
+}
+
+func (x *CreateOptionGroupRequest) GetOptions() []*MenuOptionInput {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type CreateOptionGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionGroup   *OptionGroup           `protobuf:"bytes,1,opt,name=option_group,json=optionGroup,proto3" json:"option_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOptionGroupResponse) Reset() {
+	*x = CreateOptionGroupResponse{}
+	mi := &file_rpc_menu_option_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOptionGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOptionGroupResponse) ProtoMessage() {}
+
+func (x *CreateOptionGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOptionGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateOptionGroupResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateOptionGroupResponse) GetOptionGroup() *OptionGroup {
+	if x != nil {
+		return x.OptionGroup
+	}
+	return nil
+}
+
+type ListOptionGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuId        int64                  `protobuf:"varint,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOptionGroupsRequest) Reset() {
+	*x = ListOptionGroupsRequest{}
+	mi := &file_rpc_menu_option_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOptionGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptionGroupsRequest) ProtoMessage() {}
+
+func (x *ListOptionGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptionGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListOptionGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOptionGroupsRequest) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+type ListOptionGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionGroups  []*OptionGroup         `protobuf:"bytes,1,rep,name=option_groups,json=optionGroups,proto3" json:"option_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOptionGroupsResponse) Reset() {
+	*x = ListOptionGroupsResponse{}
+	mi := &file_rpc_menu_option_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOptionGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptionGroupsResponse) ProtoMessage() {}
+
+func (x *ListOptionGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptionGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListOptionGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOptionGroupsResponse) GetOptionGroups() []*OptionGroup {
+	if x != nil {
+		return x.OptionGroups
+	}
+	return nil
+}
+
+type DeleteOptionGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOptionGroupRequest) Reset() {
+	*x = DeleteOptionGroupRequest{}
+	mi := &file_rpc_menu_option_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOptionGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOptionGroupRequest) ProtoMessage() {}
+
+func (x *DeleteOptionGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOptionGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOptionGroupRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteOptionGroupRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteOptionGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOptionGroupResponse) Reset() {
+	*x = DeleteOptionGroupResponse{}
+	mi := &file_rpc_menu_option_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOptionGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOptionGroupResponse) ProtoMessage() {}
+
+func (x *DeleteOptionGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOptionGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOptionGroupResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteOptionGroupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateMenuOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionGroupId int64                  `protobuf:"varint,1,opt,name=option_group_id,json=optionGroupId,proto3" json:"option_group_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         string                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMenuOptionRequest) Reset() {
+	*x = CreateMenuOptionRequest{}
+	mi := &file_rpc_menu_option_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuOptionRequest) ProtoMessage() {}
+
+func (x *CreateMenuOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuOptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateMenuOptionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateMenuOptionRequest) GetOptionGroupId() int64 {
+	if x != nil {
+		return x.OptionGroupId
+	}
+	return 0
+}
+
+func (x *CreateMenuOptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMenuOptionRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+type CreateMenuOptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Option        *MenuOption            `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMenuOptionResponse) Reset() {
+	*x = CreateMenuOptionResponse{}
+	mi := &file_rpc_menu_option_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuOptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuOptionResponse) ProtoMessage() {}
+
+func (x *CreateMenuOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuOptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateMenuOptionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateMenuOptionResponse) GetOption() *MenuOption {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+type DeleteMenuOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMenuOptionRequest) Reset() {
+	*x = DeleteMenuOptionRequest{}
+	mi := &file_rpc_menu_option_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuOptionRequest) ProtoMessage() {}
+
+func (x *DeleteMenuOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuOptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMenuOptionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteMenuOptionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteMenuOptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMenuOptionResponse) Reset() {
+	*x = DeleteMenuOptionResponse{}
+	mi := &file_rpc_menu_option_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuOptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuOptionResponse) ProtoMessage() {}
+
+func (x *DeleteMenuOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_option_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuOptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMenuOptionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_option_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteMenuOptionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_rpc_menu_option_proto protoreflect.FileDescriptor
+
+const file_rpc_menu_option_proto_rawDesc = "" +
+	"\n" +
+	"\x15rpc_menu_option.proto\x12\x02pb\x1a\n" +
+	"menu.proto\";\n" +
+	"\x0fMenuOptionInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\tR\x05price\"\xc4\x01\n" +
+	"\x18CreateOptionGroupRequest\x12\x17\n" +
+	"\amenu_id\x18\x01 \x01(\x03R\x06menuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0emin_selections\x18\x03 \x01(\x05R\rminSelections\x12%\n" +
+	"\x0emax_selections\x18\x04 \x01(\x05R\rmaxSelections\x12-\n" +
+	"\aoptions\x18\x05 \x03(\v2\x13.pb.MenuOptionInputR\aoptions\"O\n" +
+	"\x19CreateOptionGroupResponse\x122\n" +
+	"\foption_group\x18\x01 \x01(\v2\x0f.pb.OptionGroupR\voptionGroup\"2\n" +
+	"\x17ListOptionGroupsRequest\x12\x17\n" +
+	"\amenu_id\x18\x01 \x01(\x03R\x06menuId\"P\n" +
+	"\x18ListOptionGroupsResponse\x124\n" +
+	"\roption_groups\x18\x01 \x03(\v2\x0f.pb.OptionGroupR\foptionGroups\"*\n" +
+	"\x18DeleteOptionGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"5\n" +
+	"\x19DeleteOptionGroupResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"k\n" +
+	"\x17CreateMenuOptionRequest\x12&\n" +
+	"\x0foption_group_id\x18\x01 \x01(\x03R\roptionGroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\tR\x05price\"B\n" +
+	"\x18CreateMenuOptionResponse\x12&\n" +
+	"\x06option\x18\x01 \x01(\v2\x0e.pb.MenuOptionR\x06option\")\n" +
+	"\x17DeleteMenuOptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\x18DeleteMenuOptionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessageB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
+
+var (
+	file_rpc_menu_option_proto_rawDescOnce sync.Once
+	file_rpc_menu_option_proto_rawDescData []byte
+)
+
+func file_rpc_menu_option_proto_rawDescGZIP() []byte {
+	file_rpc_menu_option_proto_rawDescOnce.Do(func() {
+		file_rpc_menu_option_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_menu_option_proto_rawDesc), len(file_rpc_menu_option_proto_rawDesc)))
+	})
+	return file_rpc_menu_option_proto_rawDescData
+}
+
+var file_rpc_menu_option_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_rpc_menu_option_proto_goTypes = []any{
+	(*MenuOptionInput)(nil),           // 0: pb.MenuOptionInput
+	(*CreateOptionGroupRequest)(nil),  // 1: pb.CreateOptionGroupRequest
+	(*CreateOptionGroupResponse)(nil), // 2: pb.CreateOptionGroupResponse
+	(*ListOptionGroupsRequest)(nil),   // 3: pb.ListOptionGroupsRequest
+	(*ListOptionGroupsResponse)(nil),  // 4: pb.ListOptionGroupsResponse
+	(*DeleteOptionGroupRequest)(nil),  // 5: pb.DeleteOptionGroupRequest
+	(*DeleteOptionGroupResponse)(nil), // 6: pb.DeleteOptionGroupResponse
+	(*CreateMenuOptionRequest)(nil),   // 7: pb.CreateMenuOptionRequest
+	(*CreateMenuOptionResponse)(nil),  // 8: pb.CreateMenuOptionResponse
+	(*DeleteMenuOptionRequest)(nil),   // 9: pb.DeleteMenuOptionRequest
+	(*DeleteMenuOptionResponse)(nil),  // 10: pb.DeleteMenuOptionResponse
+	(*OptionGroup)(nil),               // 11: pb.OptionGroup
+	(*MenuOption)(nil),                // 12: pb.MenuOption
+}
+var file_rpc_menu_option_proto_depIdxs = []int32{
+	0,  // 0: pb.CreateOptionGroupRequest.options:type_name -> pb.MenuOptionInput
+	11, // 1: pb.CreateOptionGroupResponse.option_group:type_name -> pb.OptionGroup
+	11, // 2: pb.ListOptionGroupsResponse.option_groups:type_name -> pb.OptionGroup
+	12, // 3: pb.CreateMenuOptionResponse.option:type_name -> pb.MenuOption
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_menu_option_proto_init() }
+func file_rpc_menu_option_proto_init() {
+	if File_rpc_menu_option_proto != nil {
+		return
+	}
+	file_menu_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_menu_option_proto_rawDesc), len(file_rpc_menu_option_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_menu_option_proto_goTypes,
+		DependencyIndexes: file_rpc_menu_option_proto_depIdxs,
+		MessageInfos:      file_rpc_menu_option_proto_msgTypes,
+	}.Build()
+	File_rpc_menu_option_proto = out.File
+	file_rpc_menu_option_proto_goTypes = nil
+	file_rpc_menu_option_proto_depIdxs = nil
+}
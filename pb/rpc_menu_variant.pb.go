@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: rpc_menu_variant.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateMenuVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuId        int64                  `protobuf:"varint,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         string                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	Available     *bool                  `protobuf:"varint,4,opt,name=available,proto3,oneof" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMenuVariantRequest) Reset() {
+	*x = CreateMenuVariantRequest{}
+	mi := &file_rpc_menu_variant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuVariantRequest) ProtoMessage() {}
+
+func (x *CreateMenuVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_variant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateMenuVariantRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_variant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateMenuVariantRequest) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *CreateMenuVariantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMenuVariantRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *CreateMenuVariantRequest) GetAvailable() bool {
+	if x != nil && x.Available != nil {
+		return *x.Available
+	}
+	return false
+}
+
+type CreateMenuVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       *MenuVariant           `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMenuVariantResponse) Reset() {
+	*x = CreateMenuVariantResponse{}
+	mi := &file_rpc_menu_variant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuVariantResponse) ProtoMessage() {}
+
+func (x *CreateMenuVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_variant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuVariantResponse.ProtoReflect.Descriptor instead.
+func (*CreateMenuVariantResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_variant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateMenuVariantResponse) GetVariant() *MenuVariant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+type ListMenuVariantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuId        int64                  `protobuf:"varint,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMenuVariantsRequest) Reset() {
+	*x = ListMenuVariantsRequest{}
+	mi := &file_rpc_menu_variant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMenuVariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMenuVariantsRequest) ProtoMessage() {}
+
+func (x *ListMenuVariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_variant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMenuVariantsRequest.ProtoReflect.Descriptor instead.
+func (*ListMenuVariantsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_variant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMenuVariantsRequest) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+type ListMenuVariantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variants      []*MenuVariant         `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMenuVariantsResponse) Reset() {
+	*x = ListMenuVariantsResponse{}
+	mi := &file_rpc_menu_variant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMenuVariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMenuVariantsResponse) ProtoMessage() {}
+
+func (x *ListMenuVariantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_variant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMenuVariantsResponse.ProtoReflect.Descriptor instead.
+func (*ListMenuVariantsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_variant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListMenuVariantsResponse) GetVariants() []*MenuVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type UpdateMenuVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         string                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	Available     *bool                  `protobuf:"varint,4,opt,name=available,proto3,oneof" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMenuVariantRequest) Reset() {
+	*x = UpdateMenuVariantRequest{}
+	mi := &file_rpc_menu_variant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuVariantRequest) ProtoMessage() {}
+
+func (x *UpdateMenuVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_variant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMenuVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMenuVariantRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_variant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateMenuVariantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateMenuVariantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMenuVariantRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *UpdateMenuVariantRequest) GetAvailable() bool {
+	if x != nil && x.Available != nil {
+		return *x.Available
+	}
+	return false
+}
+
+type UpdateMenuVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       *MenuVariant           `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMenuVariantResponse) Reset() {
+	*x = UpdateMenuVariantResponse{}
+	mi := &file_rpc_menu_variant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuVariantResponse) ProtoMessage() {}
+
+func (x *UpdateMenuVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_variant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMenuVariantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMenuVariantResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_variant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateMenuVariantResponse) GetVariant() *MenuVariant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+type DeleteMenuVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMenuVariantRequest) Reset() {
+	*x = DeleteMenuVariantRequest{}
+	mi := &file_rpc_menu_variant_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuVariantRequest) ProtoMessage() {}
+
+func (x *DeleteMenuVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_variant_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuVariantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMenuVariantRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_variant_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteMenuVariantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteMenuVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMenuVariantResponse) Reset() {
+	*x = DeleteMenuVariantResponse{}
+	mi := &file_rpc_menu_variant_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuVariantResponse) ProtoMessage() {}
+
+func (x *DeleteMenuVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_menu_variant_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuVariantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMenuVariantResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_menu_variant_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteMenuVariantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_rpc_menu_variant_proto protoreflect.FileDescriptor
+
+const file_rpc_menu_variant_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_menu_variant.proto\x12\x02pb\x1a\n" +
+	"menu.proto\"\x8e\x01\n" +
+	"\x18CreateMenuVariantRequest\x12\x17\n" +
+	"\amenu_id\x18\x01 \x01(\x03R\x06menuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\tR\x05price\x12!\n" +
+	"\tavailable\x18\x04 \x01(\bH\x00R\tavailable\x88\x01\x01B\f\n" +
+	"\n" +
+	"_available\"F\n" +
+	"\x19CreateMenuVariantResponse\x12)\n" +
+	"\avariant\x18\x01 \x01(\v2\x0f.pb.MenuVariantR\avariant\"2\n" +
+	"\x17ListMenuVariantsRequest\x12\x17\n" +
+	"\amenu_id\x18\x01 \x01(\x03R\x06menuId\"G\n" +
+	"\x18ListMenuVariantsResponse\x12+\n" +
+	"\bvariants\x18\x01 \x03(\v2\x0f.pb.MenuVariantR\bvariants\"\x85\x01\n" +
+	"\x18UpdateMenuVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\tR\x05price\x12!\n" +
+	"\tavailable\x18\x04 \x01(\bH\x00R\tavailable\x88\x01\x01B\f\n" +
+	"\n" +
+	"_available\"F\n" +
+	"\x19UpdateMenuVariantResponse\x12)\n" +
+	"\avariant\x18\x01 \x01(\v2\x0f.pb.MenuVariantR\avariant\"*\n" +
+	"\x18DeleteMenuVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"5\n" +
+	"\x19DeleteMenuVariantResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessageB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
+
+var (
+	file_rpc_menu_variant_proto_rawDescOnce sync.Once
+	file_rpc_menu_variant_proto_rawDescData []byte
+)
+
+func file_rpc_menu_variant_proto_rawDescGZIP() []byte {
+	file_rpc_menu_variant_proto_rawDescOnce.Do(func() {
+		file_rpc_menu_variant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_menu_variant_proto_rawDesc), len(file_rpc_menu_variant_proto_rawDesc)))
+	})
+	return file_rpc_menu_variant_proto_rawDescData
+}
+
+var file_rpc_menu_variant_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rpc_menu_variant_proto_goTypes = []any{
+	(*CreateMenuVariantRequest)(nil),  // 0: pb.CreateMenuVariantRequest
+	(*CreateMenuVariantResponse)(nil), // 1: pb.CreateMenuVariantResponse
+	(*ListMenuVariantsRequest)(nil),   // 2: pb.ListMenuVariantsRequest
+	(*ListMenuVariantsResponse)(nil),  // 3: pb.ListMenuVariantsResponse
+	(*UpdateMenuVariantRequest)(nil),  // 4: pb.UpdateMenuVariantRequest
+	(*UpdateMenuVariantResponse)(nil), // 5: pb.UpdateMenuVariantResponse
+	(*DeleteMenuVariantRequest)(nil),  // 6: pb.DeleteMenuVariantRequest
+	(*DeleteMenuVariantResponse)(nil), // 7: pb.DeleteMenuVariantResponse
+	(*MenuVariant)(nil),               // 8: pb.MenuVariant
+}
+var file_rpc_menu_variant_proto_depIdxs = []int32{
+	8, // 0: pb.CreateMenuVariantResponse.variant:type_name -> pb.MenuVariant
+	8, // 1: pb.ListMenuVariantsResponse.variants:type_name -> pb.MenuVariant
+	8, // 2: pb.UpdateMenuVariantResponse.variant:type_name -> pb.MenuVariant
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_menu_variant_proto_init() }
+func file_rpc_menu_variant_proto_init() {
+	if File_rpc_menu_variant_proto != nil {
+		return
+	}
+	file_menu_proto_init()
+	file_rpc_menu_variant_proto_msgTypes[0].OneofWrappers = []any{}
+	file_rpc_menu_variant_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_menu_variant_proto_rawDesc), len(file_rpc_menu_variant_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_menu_variant_proto_goTypes,
+		DependencyIndexes: file_rpc_menu_variant_proto_depIdxs,
+		MessageInfos:      file_rpc_menu_variant_proto_msgTypes,
+	}.Build()
+	File_rpc_menu_variant_proto = out.File
+	file_rpc_menu_variant_proto_goTypes = nil
+	file_rpc_menu_variant_proto_depIdxs = nil
+}
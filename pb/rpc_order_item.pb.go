@@ -0,0 +1,839 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: rpc_order_item.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateOrderItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MenuId        int64                  `protobuf:"varint,2,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	NoteItem      string                 `protobuf:"bytes,4,opt,name=note_item,json=noteItem,proto3" json:"note_item,omitempty"`
+	OptionIds     []int64                `protobuf:"varint,5,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	VariantId     int64                  `protobuf:"varint,6,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderItemRequest) Reset() {
+	*x = CreateOrderItemRequest{}
+	mi := &file_rpc_order_item_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderItemRequest) ProtoMessage() {}
+
+func (x *CreateOrderItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderItemRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateOrderItemRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateOrderItemRequest) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *CreateOrderItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateOrderItemRequest) GetNoteItem() string {
+	if x != nil {
+		return x.NoteItem
+	}
+	return ""
+}
+
+func (x *CreateOrderItemRequest) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+func (x *CreateOrderItemRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type CreateOrderItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItem     *OrderItem             `protobuf:"bytes,1,opt,name=order_item,json=orderItem,proto3" json:"order_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderItemResponse) Reset() {
+	*x = CreateOrderItemResponse{}
+	mi := &file_rpc_order_item_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderItemResponse) ProtoMessage() {}
+
+func (x *CreateOrderItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderItemResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateOrderItemResponse) GetOrderItem() *OrderItem {
+	if x != nil {
+		return x.OrderItem
+	}
+	return nil
+}
+
+type GetOrderItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderItemRequest) Reset() {
+	*x = GetOrderItemRequest{}
+	mi := &file_rpc_order_item_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderItemRequest) ProtoMessage() {}
+
+func (x *GetOrderItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderItemRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderItemRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetOrderItemRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetOrderItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItem     *OrderItem             `protobuf:"bytes,1,opt,name=order_item,json=orderItem,proto3" json:"order_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderItemResponse) Reset() {
+	*x = GetOrderItemResponse{}
+	mi := &file_rpc_order_item_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderItemResponse) ProtoMessage() {}
+
+func (x *GetOrderItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderItemResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderItemResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOrderItemResponse) GetOrderItem() *OrderItem {
+	if x != nil {
+		return x.OrderItem
+	}
+	return nil
+}
+
+type ListOrderItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        int32                  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderItemsRequest) Reset() {
+	*x = ListOrderItemsRequest{}
+	mi := &file_rpc_order_item_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderItemsRequest) ProtoMessage() {}
+
+func (x *ListOrderItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderItemsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOrderItemsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListOrderItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListOrderItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItems    []*OrderItem           `protobuf:"bytes,1,rep,name=order_items,json=orderItems,proto3" json:"order_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderItemsResponse) Reset() {
+	*x = ListOrderItemsResponse{}
+	mi := &file_rpc_order_item_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderItemsResponse) ProtoMessage() {}
+
+func (x *ListOrderItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderItemsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOrderItemsResponse) GetOrderItems() []*OrderItem {
+	if x != nil {
+		return x.OrderItems
+	}
+	return nil
+}
+
+type UpdateOrderItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	NoteItem      string                 `protobuf:"bytes,5,opt,name=note_item,json=noteItem,proto3" json:"note_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderItemRequest) Reset() {
+	*x = UpdateOrderItemRequest{}
+	mi := &file_rpc_order_item_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderItemRequest) ProtoMessage() {}
+
+func (x *UpdateOrderItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderItemRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateOrderItemRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateOrderItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateOrderItemRequest) GetNoteItem() string {
+	if x != nil {
+		return x.NoteItem
+	}
+	return ""
+}
+
+type UpdateOrderItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItem     *OrderItem             `protobuf:"bytes,1,opt,name=order_item,json=orderItem,proto3" json:"order_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderItemResponse) Reset() {
+	*x = UpdateOrderItemResponse{}
+	mi := &file_rpc_order_item_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderItemResponse) ProtoMessage() {}
+
+func (x *UpdateOrderItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderItemResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateOrderItemResponse) GetOrderItem() *OrderItem {
+	if x != nil {
+		return x.OrderItem
+	}
+	return nil
+}
+
+type DeleteOrderItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrderItemRequest) Reset() {
+	*x = DeleteOrderItemRequest{}
+	mi := &file_rpc_order_item_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderItemRequest) ProtoMessage() {}
+
+func (x *DeleteOrderItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrderItemRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteOrderItemRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteOrderItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrderItemResponse) Reset() {
+	*x = DeleteOrderItemResponse{}
+	mi := &file_rpc_order_item_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderItemResponse) ProtoMessage() {}
+
+func (x *DeleteOrderItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrderItemResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteOrderItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListKitchenItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKitchenItemsRequest) Reset() {
+	*x = ListKitchenItemsRequest{}
+	mi := &file_rpc_order_item_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKitchenItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKitchenItemsRequest) ProtoMessage() {}
+
+func (x *ListKitchenItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKitchenItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListKitchenItemsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListKitchenItemsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type ListKitchenItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stations      []*KitchenStation      `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKitchenItemsResponse) Reset() {
+	*x = ListKitchenItemsResponse{}
+	mi := &file_rpc_order_item_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKitchenItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKitchenItemsResponse) ProtoMessage() {}
+
+func (x *ListKitchenItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKitchenItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListKitchenItemsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListKitchenItemsResponse) GetStations() []*KitchenStation {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+type UpdateKitchenItemStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKitchenItemStatusRequest) Reset() {
+	*x = UpdateKitchenItemStatusRequest{}
+	mi := &file_rpc_order_item_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKitchenItemStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKitchenItemStatusRequest) ProtoMessage() {}
+
+func (x *UpdateKitchenItemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKitchenItemStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKitchenItemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateKitchenItemStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateKitchenItemStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateKitchenItemStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *OrderItem             `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	OrderStatus   string                 `protobuf:"bytes,2,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKitchenItemStatusResponse) Reset() {
+	*x = UpdateKitchenItemStatusResponse{}
+	mi := &file_rpc_order_item_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKitchenItemStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKitchenItemStatusResponse) ProtoMessage() {}
+
+func (x *UpdateKitchenItemStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_order_item_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKitchenItemStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKitchenItemStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_order_item_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateKitchenItemStatusResponse) GetItem() *OrderItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *UpdateKitchenItemStatusResponse) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+var File_rpc_order_item_proto protoreflect.FileDescriptor
+
+const file_rpc_order_item_proto_rawDesc = "" +
+	"\n" +
+	"\x14rpc_order_item.proto\x12\x02pb\x1a\x10order_item.proto\"\xc3\x01\n" +
+	"\x16CreateOrderItemRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\amenu_id\x18\x02 \x01(\x03R\x06menuId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tnote_item\x18\x04 \x01(\tR\bnoteItem\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x05 \x03(\x03R\toptionIds\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x06 \x01(\x03R\tvariantId\"G\n" +
+	"\x17CreateOrderItemResponse\x12,\n" +
+	"\n" +
+	"order_item\x18\x01 \x01(\v2\r.pb.OrderItemR\torderItem\"%\n" +
+	"\x13GetOrderItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x14GetOrderItemResponse\x12,\n" +
+	"\n" +
+	"order_item\x18\x01 \x01(\v2\r.pb.OrderItemR\torderItem\"M\n" +
+	"\x15ListOrderItemsRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"H\n" +
+	"\x16ListOrderItemsResponse\x12.\n" +
+	"\vorder_items\x18\x01 \x03(\v2\r.pb.OrderItemR\n" +
+	"orderItems\"\x8e\x01\n" +
+	"\x16UpdateOrderItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tnote_item\x18\x05 \x01(\tR\bnoteItemJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x06\x10\aR\border_idR\amenu_idR\x06status\"G\n" +
+	"\x17UpdateOrderItemResponse\x12,\n" +
+	"\n" +
+	"order_item\x18\x01 \x01(\v2\r.pb.OrderItemR\torderItem\"(\n" +
+	"\x16DeleteOrderItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x17DeleteOrderItemResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\":\n" +
+	"\x17ListKitchenItemsRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\"J\n" +
+	"\x18ListKitchenItemsResponse\x12.\n" +
+	"\bstations\x18\x01 \x03(\v2\x12.pb.KitchenStationR\bstations\"H\n" +
+	"\x1eUpdateKitchenItemStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"g\n" +
+	"\x1fUpdateKitchenItemStatusResponse\x12!\n" +
+	"\x04item\x18\x01 \x01(\v2\r.pb.OrderItemR\x04item\x12!\n" +
+	"\forder_status\x18\x02 \x01(\tR\vorderStatusB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
+
+var (
+	file_rpc_order_item_proto_rawDescOnce sync.Once
+	file_rpc_order_item_proto_rawDescData []byte
+)
+
+func file_rpc_order_item_proto_rawDescGZIP() []byte {
+	file_rpc_order_item_proto_rawDescOnce.Do(func() {
+		file_rpc_order_item_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_order_item_proto_rawDesc), len(file_rpc_order_item_proto_rawDesc)))
+	})
+	return file_rpc_order_item_proto_rawDescData
+}
+
+var file_rpc_order_item_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_rpc_order_item_proto_goTypes = []any{
+	(*CreateOrderItemRequest)(nil),          // 0: pb.CreateOrderItemRequest
+	(*CreateOrderItemResponse)(nil),         // 1: pb.CreateOrderItemResponse
+	(*GetOrderItemRequest)(nil),             // 2: pb.GetOrderItemRequest
+	(*GetOrderItemResponse)(nil),            // 3: pb.GetOrderItemResponse
+	(*ListOrderItemsRequest)(nil),           // 4: pb.ListOrderItemsRequest
+	(*ListOrderItemsResponse)(nil),          // 5: pb.ListOrderItemsResponse
+	(*UpdateOrderItemRequest)(nil),          // 6: pb.UpdateOrderItemRequest
+	(*UpdateOrderItemResponse)(nil),         // 7: pb.UpdateOrderItemResponse
+	(*DeleteOrderItemRequest)(nil),          // 8: pb.DeleteOrderItemRequest
+	(*DeleteOrderItemResponse)(nil),         // 9: pb.DeleteOrderItemResponse
+	(*ListKitchenItemsRequest)(nil),         // 10: pb.ListKitchenItemsRequest
+	(*ListKitchenItemsResponse)(nil),        // 11: pb.ListKitchenItemsResponse
+	(*UpdateKitchenItemStatusRequest)(nil),  // 12: pb.UpdateKitchenItemStatusRequest
+	(*UpdateKitchenItemStatusResponse)(nil), // 13: pb.UpdateKitchenItemStatusResponse
+	(*OrderItem)(nil),                       // 14: pb.OrderItem
+	(*KitchenStation)(nil),                  // 15: pb.KitchenStation
+}
+var file_rpc_order_item_proto_depIdxs = []int32{
+	14, // 0: pb.CreateOrderItemResponse.order_item:type_name -> pb.OrderItem
+	14, // 1: pb.GetOrderItemResponse.order_item:type_name -> pb.OrderItem
+	14, // 2: pb.ListOrderItemsResponse.order_items:type_name -> pb.OrderItem
+	14, // 3: pb.UpdateOrderItemResponse.order_item:type_name -> pb.OrderItem
+	15, // 4: pb.ListKitchenItemsResponse.stations:type_name -> pb.KitchenStation
+	14, // 5: pb.UpdateKitchenItemStatusResponse.item:type_name -> pb.OrderItem
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rpc_order_item_proto_init() }
+func file_rpc_order_item_proto_init() {
+	if File_rpc_order_item_proto != nil {
+		return
+	}
+	file_order_item_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_order_item_proto_rawDesc), len(file_rpc_order_item_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_order_item_proto_goTypes,
+		DependencyIndexes: file_rpc_order_item_proto_depIdxs,
+		MessageInfos:      file_rpc_order_item_proto_msgTypes,
+	}.Build()
+	File_rpc_order_item_proto = out.File
+	file_rpc_order_item_proto_goTypes = nil
+	file_rpc_order_item_proto_depIdxs = nil
+}
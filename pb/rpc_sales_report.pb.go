@@ -0,0 +1,360 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: rpc_sales_report.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VariantSales struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VariantId     int64                  `protobuf:"varint,1,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantName   string                 `protobuf:"bytes,2,opt,name=variant_name,json=variantName,proto3" json:"variant_name,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Revenue       string                 `protobuf:"bytes,4,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantSales) Reset() {
+	*x = VariantSales{}
+	mi := &file_rpc_sales_report_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantSales) ProtoMessage() {}
+
+func (x *VariantSales) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sales_report_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantSales.ProtoReflect.Descriptor instead.
+func (*VariantSales) Descriptor() ([]byte, []int) {
+	return file_rpc_sales_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VariantSales) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *VariantSales) GetVariantName() string {
+	if x != nil {
+		return x.VariantName
+	}
+	return ""
+}
+
+func (x *VariantSales) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *VariantSales) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+type MenuSales struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuId        int64                  `protobuf:"varint,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	MenuName      string                 `protobuf:"bytes,2,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Revenue       string                 `protobuf:"bytes,4,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Variants      []*VariantSales        `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuSales) Reset() {
+	*x = MenuSales{}
+	mi := &file_rpc_sales_report_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuSales) ProtoMessage() {}
+
+func (x *MenuSales) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sales_report_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuSales.ProtoReflect.Descriptor instead.
+func (*MenuSales) Descriptor() ([]byte, []int) {
+	return file_rpc_sales_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MenuSales) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *MenuSales) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *MenuSales) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MenuSales) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+func (x *MenuSales) GetVariants() []*VariantSales {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type GetSalesReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesReportRequest) Reset() {
+	*x = GetSalesReportRequest{}
+	mi := &file_rpc_sales_report_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesReportRequest) ProtoMessage() {}
+
+func (x *GetSalesReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sales_report_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesReportRequest.ProtoReflect.Descriptor instead.
+func (*GetSalesReportRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_sales_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSalesReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetSalesReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetSalesReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quantity      int64                  `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Revenue       string                 `protobuf:"bytes,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Menus         []*MenuSales           `protobuf:"bytes,3,rep,name=menus,proto3" json:"menus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesReportResponse) Reset() {
+	*x = GetSalesReportResponse{}
+	mi := &file_rpc_sales_report_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesReportResponse) ProtoMessage() {}
+
+func (x *GetSalesReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_sales_report_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesReportResponse.ProtoReflect.Descriptor instead.
+func (*GetSalesReportResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_sales_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSalesReportResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GetSalesReportResponse) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+func (x *GetSalesReportResponse) GetMenus() []*MenuSales {
+	if x != nil {
+		return x.Menus
+	}
+	return nil
+}
+
+var File_rpc_sales_report_proto protoreflect.FileDescriptor
+
+const file_rpc_sales_report_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_sales_report.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x01\n" +
+	"\fVariantSales\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x01 \x01(\x03R\tvariantId\x12!\n" +
+	"\fvariant_name\x18\x02 \x01(\tR\vvariantName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x18\n" +
+	"\arevenue\x18\x04 \x01(\tR\arevenue\"\xa5\x01\n" +
+	"\tMenuSales\x12\x17\n" +
+	"\amenu_id\x18\x01 \x01(\x03R\x06menuId\x12\x1b\n" +
+	"\tmenu_name\x18\x02 \x01(\tR\bmenuName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x18\n" +
+	"\arevenue\x18\x04 \x01(\tR\arevenue\x12,\n" +
+	"\bvariants\x18\x05 \x03(\v2\x10.pb.VariantSalesR\bvariants\"s\n" +
+	"\x15GetSalesReportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"s\n" +
+	"\x16GetSalesReportResponse\x12\x1a\n" +
+	"\bquantity\x18\x01 \x01(\x03R\bquantity\x12\x18\n" +
+	"\arevenue\x18\x02 \x01(\tR\arevenue\x12#\n" +
+	"\x05menus\x18\x03 \x03(\v2\r.pb.MenuSalesR\x05menusB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
+
+var (
+	file_rpc_sales_report_proto_rawDescOnce sync.Once
+	file_rpc_sales_report_proto_rawDescData []byte
+)
+
+func file_rpc_sales_report_proto_rawDescGZIP() []byte {
+	file_rpc_sales_report_proto_rawDescOnce.Do(func() {
+		file_rpc_sales_report_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_sales_report_proto_rawDesc), len(file_rpc_sales_report_proto_rawDesc)))
+	})
+	return file_rpc_sales_report_proto_rawDescData
+}
+
+var file_rpc_sales_report_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_sales_report_proto_goTypes = []any{
+	(*VariantSales)(nil),           // 0: pb.VariantSales
+	(*MenuSales)(nil),              // 1: pb.MenuSales
+	(*GetSalesReportRequest)(nil),  // 2: pb.GetSalesReportRequest
+	(*GetSalesReportResponse)(nil), // 3: pb.GetSalesReportResponse
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
+}
+var file_rpc_sales_report_proto_depIdxs = []int32{
+	0, // 0: pb.MenuSales.variants:type_name -> pb.VariantSales
+	4, // 1: pb.GetSalesReportRequest.from:type_name -> google.protobuf.Timestamp
+	4, // 2: pb.GetSalesReportRequest.to:type_name -> google.protobuf.Timestamp
+	1, // 3: pb.GetSalesReportResponse.menus:type_name -> pb.MenuSales
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_sales_report_proto_init() }
+func file_rpc_sales_report_proto_init() {
+	if File_rpc_sales_report_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_sales_report_proto_rawDesc), len(file_rpc_sales_report_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_sales_report_proto_goTypes,
+		DependencyIndexes: file_rpc_sales_report_proto_depIdxs,
+		MessageInfos:      file_rpc_sales_report_proto_msgTypes,
+	}.Build()
+	File_rpc_sales_report_proto = out.File
+	file_rpc_sales_report_proto_goTypes = nil
+	file_rpc_sales_report_proto_depIdxs = nil
+}
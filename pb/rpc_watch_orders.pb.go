@@ -0,0 +1,140 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: rpc_watch_orders.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatchOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       int64                  `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	LastEventId   uint64                 `protobuf:"varint,3,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrdersRequest) Reset() {
+	*x = WatchOrdersRequest{}
+	mi := &file_rpc_watch_orders_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrdersRequest) ProtoMessage() {}
+
+func (x *WatchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_watch_orders_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_watch_orders_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchOrdersRequest) GetTableId() int64 {
+	if x != nil {
+		return x.TableId
+	}
+	return 0
+}
+
+func (x *WatchOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WatchOrdersRequest) GetLastEventId() uint64 {
+	if x != nil {
+		return x.LastEventId
+	}
+	return 0
+}
+
+var File_rpc_watch_orders_proto protoreflect.FileDescriptor
+
+const file_rpc_watch_orders_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_watch_orders.proto\x12\x02pb\"k\n" +
+	"\x12WatchOrdersRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\x03R\atableId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\"\n" +
+	"\rlast_event_id\x18\x03 \x01(\x04R\vlastEventIdB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
+
+var (
+	file_rpc_watch_orders_proto_rawDescOnce sync.Once
+	file_rpc_watch_orders_proto_rawDescData []byte
+)
+
+func file_rpc_watch_orders_proto_rawDescGZIP() []byte {
+	file_rpc_watch_orders_proto_rawDescOnce.Do(func() {
+		file_rpc_watch_orders_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_watch_orders_proto_rawDesc), len(file_rpc_watch_orders_proto_rawDesc)))
+	})
+	return file_rpc_watch_orders_proto_rawDescData
+}
+
+var file_rpc_watch_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rpc_watch_orders_proto_goTypes = []any{
+	(*WatchOrdersRequest)(nil), // 0: pb.WatchOrdersRequest
+}
+var file_rpc_watch_orders_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_watch_orders_proto_init() }
+func file_rpc_watch_orders_proto_init() {
+	if File_rpc_watch_orders_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_watch_orders_proto_rawDesc), len(file_rpc_watch_orders_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_watch_orders_proto_goTypes,
+		DependencyIndexes: file_rpc_watch_orders_proto_depIdxs,
+		MessageInfos:      file_rpc_watch_orders_proto_msgTypes,
+	}.Build()
+	File_rpc_watch_orders_proto = out.File
+	file_rpc_watch_orders_proto_goTypes = nil
+	file_rpc_watch_orders_proto_depIdxs = nil
+}
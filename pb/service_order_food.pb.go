@@ -8,6 +8,7 @@ package pb
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -25,39 +26,394 @@ var File_service_order_food_proto protoreflect.FileDescriptor
 
 const file_service_order_food_proto_rawDesc = "" +
 	"\n" +
-	"\x18service_order_food.proto\x12\x02pb\x1a\x15rpc_create_user.proto\x1a\x14rpc_login_user.proto\x1a\x15rpc_update_user.proto\x1a!rpc_updateonlypassword_user.proto\x1a\x1cgoogle/api/annotations.proto2\x93\x03\n" +
+	"\x18service_order_food.proto\x12\x02pb\x1a\x15rpc_create_user.proto\x1a\x14rpc_login_user.proto\x1a\x1crpc_renew_access_token.proto\x1a\x15rpc_update_user.proto\x1a!rpc_updateonlypassword_user.proto\x1a\x16rpc_verify_email.proto\x1a\x19rpc_forgot_password.proto\x1a\x18rpc_reset_password.proto\x1a\x11rpc_session.proto\x1a\x14rpc_token_keys.proto\x1a\x15rpc_unlock_user.proto\x1a\x16rpc_watch_orders.proto\x1a\x11order_event.proto\x1a\x12rpc_customer.proto\x1a\x12rpc_category.proto\x1a\x0erpc_menu.proto\x1a\x15rpc_menu_option.proto\x1a\x16rpc_menu_variant.proto\x1a\x16rpc_availability.proto\x1a\x0frpc_table.proto\x1a\x0frpc_order.proto\x1a\x14rpc_order_item.proto\x1a\x11rpc_payment.proto\x1a\x16rpc_sales_report.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto2\xac:\n" +
 	"\x10OrderFoodService\x12W\n" +
 	"\n" +
 	"CreateUser\x12\x15.pb.CreateUserRequest\x1a\x16.pb.CreateUserResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/create_user\x12W\n" +
 	"\n" +
 	"UpdateUser\x12\x15.pb.UpdateUserRequest\x1a\x16.pb.UpdateUserResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/update_user\x12x\n" +
 	"\x12UpdatePasswordUser\x12\x1d.pb.UpdatePasswordUserRequest\x1a\x1e.pb.UpdatePasswordUserResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*2\x18/v1/update_password_user\x12S\n" +
-	"\tLoginUser\x12\x14.pb.LoginUserRequest\x1a\x15.pb.LoginUserResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/login_userB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
+	"\tLoginUser\x12\x14.pb.LoginUserRequest\x1a\x15.pb.LoginUserResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/login_user\x12q\n" +
+	"\x10RenewAccessToken\x12\x1b.pb.RenewAccessTokenRequest\x1a\x1c.pb.RenewAccessTokenResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/tokens/renew_access\x12Y\n" +
+	"\fGetTokenKeys\x12\x17.pb.GetTokenKeysRequest\x1a\x18.pb.GetTokenKeysResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/token_keys\x12X\n" +
+	"\vVerifyEmail\x12\x16.pb.VerifyEmailRequest\x1a\x17.pb.VerifyEmailResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/verify_email\x12g\n" +
+	"\x0eForgotPassword\x12\x19.pb.ForgotPasswordRequest\x1a\x1a.pb.ForgotPasswordResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/forgot_password\x12c\n" +
+	"\rResetPassword\x12\x18.pb.ResetPasswordRequest\x1a\x19.pb.ResetPasswordResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/reset_password\x12F\n" +
+	"\x06Logout\x12\x11.pb.LogoutRequest\x1a\x12.pb.LogoutResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/logout\x12]\n" +
+	"\x0eListMySessions\x12\x19.pb.ListMySessionsRequest\x1a\x1a.pb.ListMySessionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/sessions\x12_\n" +
+	"\rRevokeSession\x12\x18.pb.RevokeSessionRequest\x1a\x19.pb.RevokeSessionResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/sessions/{id}\x12f\n" +
+	"\x11RevokeAllSessions\x12\x1c.pb.RevokeAllSessionsRequest\x1a\x1d.pb.RevokeAllSessionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/v1/sessions\x12s\n" +
+	"\x10ListUserSessions\x12\x1b.pb.ListUserSessionsRequest\x1a\x1c.pb.ListUserSessionsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/users/{user_id}/sessions\x12{\n" +
+	"\x11RevokeUserSession\x12\x1c.pb.RevokeUserSessionRequest\x1a\x1d.pb.RevokeUserSessionResponse\")\x82\xd3\xe4\x93\x02#*!/v1/users/{user_id}/sessions/{id}\x12y\n" +
+	"\x12RevokeUserSessions\x12\x1d.pb.RevokeUserSessionsRequest\x1a\x1e.pb.RevokeUserSessionsResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/users/{user_id}/sessions\x12b\n" +
+	"\n" +
+	"UnlockUser\x12\x15.pb.UnlockUserRequest\x1a\x16.pb.UnlockUserResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/users/{user_id}/unlock\x129\n" +
+	"\vWatchOrders\x12\x16.pb.WatchOrdersRequest\x1a\x0e.pb.OrderEvent\"\x000\x01\x12a\n" +
+	"\x0eCreateCustomer\x12\x19.pb.CreateCustomerRequest\x1a\x1a.pb.CreateCustomerResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/customers\x12Z\n" +
+	"\vGetCustomer\x12\x16.pb.GetCustomerRequest\x1a\x17.pb.GetCustomerResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/customers/{id}\x12[\n" +
+	"\rListCustomers\x12\x18.pb.ListCustomersRequest\x1a\x19.pb.ListCustomersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/customers\x12c\n" +
+	"\x0eDeleteCustomer\x12\x19.pb.DeleteCustomerRequest\x1a\x1a.pb.DeleteCustomerResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/customers/{id}\x12b\n" +
+	"\x0eCreateCategory\x12\x19.pb.CreateCategoryRequest\x1a\x1a.pb.CreateCategoryResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/categories\x12[\n" +
+	"\vGetCategory\x12\x16.pb.GetCategoryRequest\x1a\x17.pb.GetCategoryResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/categories/{id}\x12_\n" +
+	"\x0eListCategories\x12\x19.pb.ListCategoriesRequest\x1a\x1a.pb.ListCategoriesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/categories\x12g\n" +
+	"\x0eUpdateCategory\x12\x19.pb.UpdateCategoryRequest\x1a\x1a.pb.UpdateCategoryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/categories/{id}\x12d\n" +
+	"\x0eDeleteCategory\x12\x19.pb.DeleteCategoryRequest\x1a\x1a.pb.DeleteCategoryResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/categories/{id}\x12Q\n" +
+	"\n" +
+	"CreateMenu\x12\x15.pb.CreateMenuRequest\x1a\x16.pb.CreateMenuResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/menus\x12J\n" +
+	"\aGetMenu\x12\x12.pb.GetMenuRequest\x1a\x13.pb.GetMenuResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/menus/{id}\x12K\n" +
+	"\tListMenus\x12\x14.pb.ListMenusRequest\x1a\x15.pb.ListMenusResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/menus\x12V\n" +
+	"\n" +
+	"UpdateMenu\x12\x15.pb.UpdateMenuRequest\x1a\x16.pb.UpdateMenuResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/menus/{id}\x12S\n" +
+	"\n" +
+	"DeleteMenu\x12\x15.pb.DeleteMenuRequest\x1a\x16.pb.DeleteMenuResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/menus/{id}\x12~\n" +
+	"\x11CreateOptionGroup\x12\x1c.pb.CreateOptionGroupRequest\x1a\x1d.pb.CreateOptionGroupResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/menus/{menu_id}/option_groups\x12x\n" +
+	"\x10ListOptionGroups\x12\x1b.pb.ListOptionGroupsRequest\x1a\x1c.pb.ListOptionGroupsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/menus/{menu_id}/option_groups\x12p\n" +
+	"\x11DeleteOptionGroup\x12\x1c.pb.DeleteOptionGroupRequest\x1a\x1d.pb.DeleteOptionGroupResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/option_groups/{id}\x12\x85\x01\n" +
+	"\x10CreateMenuOption\x12\x1b.pb.CreateMenuOptionRequest\x1a\x1c.pb.CreateMenuOptionResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/option_groups/{option_group_id}/options\x12l\n" +
+	"\x10DeleteMenuOption\x12\x1b.pb.DeleteMenuOptionRequest\x1a\x1c.pb.DeleteMenuOptionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/menu_options/{id}\x12y\n" +
+	"\x11CreateMenuVariant\x12\x1c.pb.CreateMenuVariantRequest\x1a\x1d.pb.CreateMenuVariantResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/menus/{menu_id}/variants\x12s\n" +
+	"\x10ListMenuVariants\x12\x1b.pb.ListMenuVariantsRequest\x1a\x1c.pb.ListMenuVariantsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/menus/{menu_id}/variants\x12s\n" +
+	"\x11UpdateMenuVariant\x12\x1c.pb.UpdateMenuVariantRequest\x1a\x1d.pb.UpdateMenuVariantResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/v1/menu_variants/{id}\x12p\n" +
+	"\x11DeleteMenuVariant\x12\x1c.pb.DeleteMenuVariantRequest\x1a\x1d.pb.DeleteMenuVariantResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/menu_variants/{id}\x12l\n" +
+	"\x0fGetMenuSchedule\x12\x1a.pb.GetMenuScheduleRequest\x1a\x17.pb.GetScheduleResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/menus/{menu_id}/schedule\x12{\n" +
+	"\x13ReplaceMenuSchedule\x12\x1e.pb.ReplaceMenuScheduleRequest\x1a\x1b.pb.ReplaceScheduleResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/menus/{menu_id}/schedule\x12}\n" +
+	"\x13GetCategorySchedule\x12\x1e.pb.GetCategoryScheduleRequest\x1a\x17.pb.GetScheduleResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/categories/{category_id}/schedule\x12\x8c\x01\n" +
+	"\x17ReplaceCategorySchedule\x12\".pb.ReplaceCategoryScheduleRequest\x1a\x1b.pb.ReplaceScheduleResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/v1/categories/{category_id}/schedule\x12p\n" +
+	"\x12ListAvailableMenus\x12\x1d.pb.ListAvailableMenusRequest\x1a\x1e.pb.ListAvailableMenusResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/menus/available\x12U\n" +
+	"\vCreateTable\x12\x16.pb.CreateTableRequest\x1a\x17.pb.CreateTableResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/tables\x12N\n" +
+	"\bGetTable\x12\x13.pb.GetTableRequest\x1a\x14.pb.GetTableResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/tables/{id}\x12O\n" +
+	"\n" +
+	"ListTables\x12\x15.pb.ListTablesRequest\x1a\x16.pb.ListTablesResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/tables\x12l\n" +
+	"\x11UpdateTableStatus\x12\x1c.pb.UpdateTableStatusRequest\x1a\x1d.pb.UpdateTableStatusResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/tables/{id}\x12W\n" +
+	"\vDeleteTable\x12\x16.pb.DeleteTableRequest\x1a\x17.pb.DeleteTableResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/tables/{id}\x12j\n" +
+	"\rRotateTableQR\x12\x18.pb.RotateTableQRRequest\x1a\x19.pb.RotateTableQRResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/tables/{id}/qr/rotate\x12]\n" +
+	"\rExportTableQR\x12\x18.pb.ExportTableQRRequest\x1a\x14.google.api.HttpBody\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/tables/qr/export\x12U\n" +
+	"\vCreateOrder\x12\x16.pb.CreateOrderRequest\x1a\x17.pb.CreateOrderResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/orders\x12N\n" +
+	"\bGetOrder\x12\x13.pb.GetOrderRequest\x1a\x14.pb.GetOrderResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/orders/{id}\x12O\n" +
+	"\n" +
+	"ListOrders\x12\x15.pb.ListOrdersRequest\x1a\x16.pb.ListOrdersResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/orders\x12Z\n" +
+	"\vUpdateOrder\x12\x16.pb.UpdateOrderRequest\x1a\x17.pb.UpdateOrderResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/v1/orders/{id}\x12s\n" +
+	"\x11UpdateOrderStatus\x12\x1c.pb.UpdateOrderStatusRequest\x1a\x1d.pb.UpdateOrderStatusResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*2\x16/v1/orders/status/{id}\x12W\n" +
+	"\vDeleteOrder\x12\x16.pb.DeleteOrderRequest\x1a\x17.pb.DeleteOrderResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/orders/{id}\x12\x80\x01\n" +
+	"\x16ListOrderStatusHistory\x12!.pb.ListOrderStatusHistoryRequest\x1a\".pb.ListOrderStatusHistoryResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/orders/history/{id}\x12e\n" +
+	"\x0fCreateOrderItem\x12\x1a.pb.CreateOrderItemRequest\x1a\x1b.pb.CreateOrderItemResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/orderitems\x12^\n" +
+	"\fGetOrderItem\x12\x17.pb.GetOrderItemRequest\x1a\x18.pb.GetOrderItemResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/orderitems/{id}\x12_\n" +
+	"\x0eListOrderItems\x12\x19.pb.ListOrderItemsRequest\x1a\x1a.pb.ListOrderItemsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/orderitems\x12k\n" +
+	"\x0fUpdateOrderItem\x12\x1a.pb.UpdateOrderItemRequest\x1a\x1b.pb.UpdateOrderItemResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/order_items/{id}\x12g\n" +
+	"\x0fDeleteOrderItem\x12\x1a.pb.DeleteOrderItemRequest\x1a\x1b.pb.DeleteOrderItemResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/orderitems/{id}\x12h\n" +
+	"\x10ListKitchenItems\x12\x1b.pb.ListKitchenItemsRequest\x1a\x1c.pb.ListKitchenItemsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/kitchen/items\x12\x8c\x01\n" +
+	"\x17UpdateKitchenItemStatus\x12\".pb.UpdateKitchenItemStatusRequest\x1a#.pb.UpdateKitchenItemStatusResponse\"(\x82\xd3\xe4\x93\x02\":\x01*2\x1d/v1/kitchen/items/{id}/status\x12]\n" +
+	"\rCreatePayment\x12\x18.pb.CreatePaymentRequest\x1a\x19.pb.CreatePaymentResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/payments\x12V\n" +
+	"\n" +
+	"GetPayment\x12\x15.pb.GetPaymentRequest\x1a\x16.pb.GetPaymentResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/payments/{id}\x12W\n" +
+	"\fListPayments\x12\x17.pb.ListPaymentsRequest\x1a\x18.pb.ListPaymentsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/payments\x12{\n" +
+	"\x13UpdatePaymentStatus\x12\x1e.pb.UpdatePaymentStatusRequest\x1a\x1f.pb.UpdatePaymentStatusResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*2\x18/v1/payments/status/{id}\x12_\n" +
+	"\rDeletePayment\x12\x18.pb.DeletePaymentRequest\x1a\x19.pb.DeletePaymentResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/payments/{id}\x12b\n" +
+	"\x0eGetSalesReport\x12\x19.pb.GetSalesReportRequest\x1a\x1a.pb.GetSalesReportResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/reports/salesB%Z#github.com/datmaithanh/orderfood/pbb\x06proto3"
 
 var file_service_order_food_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),          // 0: pb.CreateUserRequest
-	(*UpdateUserRequest)(nil),          // 1: pb.UpdateUserRequest
-	(*UpdatePasswordUserRequest)(nil),  // 2: pb.UpdatePasswordUserRequest
-	(*LoginUserRequest)(nil),           // 3: pb.LoginUserRequest
-	(*CreateUserResponse)(nil),         // 4: pb.CreateUserResponse
-	(*UpdateUserResponse)(nil),         // 5: pb.UpdateUserResponse
-	(*UpdatePasswordUserResponse)(nil), // 6: pb.UpdatePasswordUserResponse
-	(*LoginUserResponse)(nil),          // 7: pb.LoginUserResponse
+	(*CreateUserRequest)(nil),               // 0: pb.CreateUserRequest
+	(*UpdateUserRequest)(nil),               // 1: pb.UpdateUserRequest
+	(*UpdatePasswordUserRequest)(nil),       // 2: pb.UpdatePasswordUserRequest
+	(*LoginUserRequest)(nil),                // 3: pb.LoginUserRequest
+	(*RenewAccessTokenRequest)(nil),         // 4: pb.RenewAccessTokenRequest
+	(*GetTokenKeysRequest)(nil),             // 5: pb.GetTokenKeysRequest
+	(*VerifyEmailRequest)(nil),              // 6: pb.VerifyEmailRequest
+	(*ForgotPasswordRequest)(nil),           // 7: pb.ForgotPasswordRequest
+	(*ResetPasswordRequest)(nil),            // 8: pb.ResetPasswordRequest
+	(*LogoutRequest)(nil),                   // 9: pb.LogoutRequest
+	(*ListMySessionsRequest)(nil),           // 10: pb.ListMySessionsRequest
+	(*RevokeSessionRequest)(nil),            // 11: pb.RevokeSessionRequest
+	(*RevokeAllSessionsRequest)(nil),        // 12: pb.RevokeAllSessionsRequest
+	(*ListUserSessionsRequest)(nil),         // 13: pb.ListUserSessionsRequest
+	(*RevokeUserSessionRequest)(nil),        // 14: pb.RevokeUserSessionRequest
+	(*RevokeUserSessionsRequest)(nil),       // 15: pb.RevokeUserSessionsRequest
+	(*UnlockUserRequest)(nil),               // 16: pb.UnlockUserRequest
+	(*WatchOrdersRequest)(nil),              // 17: pb.WatchOrdersRequest
+	(*CreateCustomerRequest)(nil),           // 18: pb.CreateCustomerRequest
+	(*GetCustomerRequest)(nil),              // 19: pb.GetCustomerRequest
+	(*ListCustomersRequest)(nil),            // 20: pb.ListCustomersRequest
+	(*DeleteCustomerRequest)(nil),           // 21: pb.DeleteCustomerRequest
+	(*CreateCategoryRequest)(nil),           // 22: pb.CreateCategoryRequest
+	(*GetCategoryRequest)(nil),              // 23: pb.GetCategoryRequest
+	(*ListCategoriesRequest)(nil),           // 24: pb.ListCategoriesRequest
+	(*UpdateCategoryRequest)(nil),           // 25: pb.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),           // 26: pb.DeleteCategoryRequest
+	(*CreateMenuRequest)(nil),               // 27: pb.CreateMenuRequest
+	(*GetMenuRequest)(nil),                  // 28: pb.GetMenuRequest
+	(*ListMenusRequest)(nil),                // 29: pb.ListMenusRequest
+	(*UpdateMenuRequest)(nil),               // 30: pb.UpdateMenuRequest
+	(*DeleteMenuRequest)(nil),               // 31: pb.DeleteMenuRequest
+	(*CreateOptionGroupRequest)(nil),        // 32: pb.CreateOptionGroupRequest
+	(*ListOptionGroupsRequest)(nil),         // 33: pb.ListOptionGroupsRequest
+	(*DeleteOptionGroupRequest)(nil),        // 34: pb.DeleteOptionGroupRequest
+	(*CreateMenuOptionRequest)(nil),         // 35: pb.CreateMenuOptionRequest
+	(*DeleteMenuOptionRequest)(nil),         // 36: pb.DeleteMenuOptionRequest
+	(*CreateMenuVariantRequest)(nil),        // 37: pb.CreateMenuVariantRequest
+	(*ListMenuVariantsRequest)(nil),         // 38: pb.ListMenuVariantsRequest
+	(*UpdateMenuVariantRequest)(nil),        // 39: pb.UpdateMenuVariantRequest
+	(*DeleteMenuVariantRequest)(nil),        // 40: pb.DeleteMenuVariantRequest
+	(*GetMenuScheduleRequest)(nil),          // 41: pb.GetMenuScheduleRequest
+	(*ReplaceMenuScheduleRequest)(nil),      // 42: pb.ReplaceMenuScheduleRequest
+	(*GetCategoryScheduleRequest)(nil),      // 43: pb.GetCategoryScheduleRequest
+	(*ReplaceCategoryScheduleRequest)(nil),  // 44: pb.ReplaceCategoryScheduleRequest
+	(*ListAvailableMenusRequest)(nil),       // 45: pb.ListAvailableMenusRequest
+	(*CreateTableRequest)(nil),              // 46: pb.CreateTableRequest
+	(*GetTableRequest)(nil),                 // 47: pb.GetTableRequest
+	(*ListTablesRequest)(nil),               // 48: pb.ListTablesRequest
+	(*UpdateTableStatusRequest)(nil),        // 49: pb.UpdateTableStatusRequest
+	(*DeleteTableRequest)(nil),              // 50: pb.DeleteTableRequest
+	(*RotateTableQRRequest)(nil),            // 51: pb.RotateTableQRRequest
+	(*ExportTableQRRequest)(nil),            // 52: pb.ExportTableQRRequest
+	(*CreateOrderRequest)(nil),              // 53: pb.CreateOrderRequest
+	(*GetOrderRequest)(nil),                 // 54: pb.GetOrderRequest
+	(*ListOrdersRequest)(nil),               // 55: pb.ListOrdersRequest
+	(*UpdateOrderRequest)(nil),              // 56: pb.UpdateOrderRequest
+	(*UpdateOrderStatusRequest)(nil),        // 57: pb.UpdateOrderStatusRequest
+	(*DeleteOrderRequest)(nil),              // 58: pb.DeleteOrderRequest
+	(*ListOrderStatusHistoryRequest)(nil),   // 59: pb.ListOrderStatusHistoryRequest
+	(*CreateOrderItemRequest)(nil),          // 60: pb.CreateOrderItemRequest
+	(*GetOrderItemRequest)(nil),             // 61: pb.GetOrderItemRequest
+	(*ListOrderItemsRequest)(nil),           // 62: pb.ListOrderItemsRequest
+	(*UpdateOrderItemRequest)(nil),          // 63: pb.UpdateOrderItemRequest
+	(*DeleteOrderItemRequest)(nil),          // 64: pb.DeleteOrderItemRequest
+	(*ListKitchenItemsRequest)(nil),         // 65: pb.ListKitchenItemsRequest
+	(*UpdateKitchenItemStatusRequest)(nil),  // 66: pb.UpdateKitchenItemStatusRequest
+	(*CreatePaymentRequest)(nil),            // 67: pb.CreatePaymentRequest
+	(*GetPaymentRequest)(nil),               // 68: pb.GetPaymentRequest
+	(*ListPaymentsRequest)(nil),             // 69: pb.ListPaymentsRequest
+	(*UpdatePaymentStatusRequest)(nil),      // 70: pb.UpdatePaymentStatusRequest
+	(*DeletePaymentRequest)(nil),            // 71: pb.DeletePaymentRequest
+	(*GetSalesReportRequest)(nil),           // 72: pb.GetSalesReportRequest
+	(*CreateUserResponse)(nil),              // 73: pb.CreateUserResponse
+	(*UpdateUserResponse)(nil),              // 74: pb.UpdateUserResponse
+	(*UpdatePasswordUserResponse)(nil),      // 75: pb.UpdatePasswordUserResponse
+	(*LoginUserResponse)(nil),               // 76: pb.LoginUserResponse
+	(*RenewAccessTokenResponse)(nil),        // 77: pb.RenewAccessTokenResponse
+	(*GetTokenKeysResponse)(nil),            // 78: pb.GetTokenKeysResponse
+	(*VerifyEmailResponse)(nil),             // 79: pb.VerifyEmailResponse
+	(*ForgotPasswordResponse)(nil),          // 80: pb.ForgotPasswordResponse
+	(*ResetPasswordResponse)(nil),           // 81: pb.ResetPasswordResponse
+	(*LogoutResponse)(nil),                  // 82: pb.LogoutResponse
+	(*ListMySessionsResponse)(nil),          // 83: pb.ListMySessionsResponse
+	(*RevokeSessionResponse)(nil),           // 84: pb.RevokeSessionResponse
+	(*RevokeAllSessionsResponse)(nil),       // 85: pb.RevokeAllSessionsResponse
+	(*ListUserSessionsResponse)(nil),        // 86: pb.ListUserSessionsResponse
+	(*RevokeUserSessionResponse)(nil),       // 87: pb.RevokeUserSessionResponse
+	(*RevokeUserSessionsResponse)(nil),      // 88: pb.RevokeUserSessionsResponse
+	(*UnlockUserResponse)(nil),              // 89: pb.UnlockUserResponse
+	(*OrderEvent)(nil),                      // 90: pb.OrderEvent
+	(*CreateCustomerResponse)(nil),          // 91: pb.CreateCustomerResponse
+	(*GetCustomerResponse)(nil),             // 92: pb.GetCustomerResponse
+	(*ListCustomersResponse)(nil),           // 93: pb.ListCustomersResponse
+	(*DeleteCustomerResponse)(nil),          // 94: pb.DeleteCustomerResponse
+	(*CreateCategoryResponse)(nil),          // 95: pb.CreateCategoryResponse
+	(*GetCategoryResponse)(nil),             // 96: pb.GetCategoryResponse
+	(*ListCategoriesResponse)(nil),          // 97: pb.ListCategoriesResponse
+	(*UpdateCategoryResponse)(nil),          // 98: pb.UpdateCategoryResponse
+	(*DeleteCategoryResponse)(nil),          // 99: pb.DeleteCategoryResponse
+	(*CreateMenuResponse)(nil),              // 100: pb.CreateMenuResponse
+	(*GetMenuResponse)(nil),                 // 101: pb.GetMenuResponse
+	(*ListMenusResponse)(nil),               // 102: pb.ListMenusResponse
+	(*UpdateMenuResponse)(nil),              // 103: pb.UpdateMenuResponse
+	(*DeleteMenuResponse)(nil),              // 104: pb.DeleteMenuResponse
+	(*CreateOptionGroupResponse)(nil),       // 105: pb.CreateOptionGroupResponse
+	(*ListOptionGroupsResponse)(nil),        // 106: pb.ListOptionGroupsResponse
+	(*DeleteOptionGroupResponse)(nil),       // 107: pb.DeleteOptionGroupResponse
+	(*CreateMenuOptionResponse)(nil),        // 108: pb.CreateMenuOptionResponse
+	(*DeleteMenuOptionResponse)(nil),        // 109: pb.DeleteMenuOptionResponse
+	(*CreateMenuVariantResponse)(nil),       // 110: pb.CreateMenuVariantResponse
+	(*ListMenuVariantsResponse)(nil),        // 111: pb.ListMenuVariantsResponse
+	(*UpdateMenuVariantResponse)(nil),       // 112: pb.UpdateMenuVariantResponse
+	(*DeleteMenuVariantResponse)(nil),       // 113: pb.DeleteMenuVariantResponse
+	(*GetScheduleResponse)(nil),             // 114: pb.GetScheduleResponse
+	(*ReplaceScheduleResponse)(nil),         // 115: pb.ReplaceScheduleResponse
+	(*ListAvailableMenusResponse)(nil),      // 116: pb.ListAvailableMenusResponse
+	(*CreateTableResponse)(nil),             // 117: pb.CreateTableResponse
+	(*GetTableResponse)(nil),                // 118: pb.GetTableResponse
+	(*ListTablesResponse)(nil),              // 119: pb.ListTablesResponse
+	(*UpdateTableStatusResponse)(nil),       // 120: pb.UpdateTableStatusResponse
+	(*DeleteTableResponse)(nil),             // 121: pb.DeleteTableResponse
+	(*RotateTableQRResponse)(nil),           // 122: pb.RotateTableQRResponse
+	(*httpbody.HttpBody)(nil),               // 123: google.api.HttpBody
+	(*CreateOrderResponse)(nil),             // 124: pb.CreateOrderResponse
+	(*GetOrderResponse)(nil),                // 125: pb.GetOrderResponse
+	(*ListOrdersResponse)(nil),              // 126: pb.ListOrdersResponse
+	(*UpdateOrderResponse)(nil),             // 127: pb.UpdateOrderResponse
+	(*UpdateOrderStatusResponse)(nil),       // 128: pb.UpdateOrderStatusResponse
+	(*DeleteOrderResponse)(nil),             // 129: pb.DeleteOrderResponse
+	(*ListOrderStatusHistoryResponse)(nil),  // 130: pb.ListOrderStatusHistoryResponse
+	(*CreateOrderItemResponse)(nil),         // 131: pb.CreateOrderItemResponse
+	(*GetOrderItemResponse)(nil),            // 132: pb.GetOrderItemResponse
+	(*ListOrderItemsResponse)(nil),          // 133: pb.ListOrderItemsResponse
+	(*UpdateOrderItemResponse)(nil),         // 134: pb.UpdateOrderItemResponse
+	(*DeleteOrderItemResponse)(nil),         // 135: pb.DeleteOrderItemResponse
+	(*ListKitchenItemsResponse)(nil),        // 136: pb.ListKitchenItemsResponse
+	(*UpdateKitchenItemStatusResponse)(nil), // 137: pb.UpdateKitchenItemStatusResponse
+	(*CreatePaymentResponse)(nil),           // 138: pb.CreatePaymentResponse
+	(*GetPaymentResponse)(nil),              // 139: pb.GetPaymentResponse
+	(*ListPaymentsResponse)(nil),            // 140: pb.ListPaymentsResponse
+	(*UpdatePaymentStatusResponse)(nil),     // 141: pb.UpdatePaymentStatusResponse
+	(*DeletePaymentResponse)(nil),           // 142: pb.DeletePaymentResponse
+	(*GetSalesReportResponse)(nil),          // 143: pb.GetSalesReportResponse
 }
 var file_service_order_food_proto_depIdxs = []int32{
-	0, // 0: pb.OrderFoodService.CreateUser:input_type -> pb.CreateUserRequest
-	1, // 1: pb.OrderFoodService.UpdateUser:input_type -> pb.UpdateUserRequest
-	2, // 2: pb.OrderFoodService.UpdatePasswordUser:input_type -> pb.UpdatePasswordUserRequest
-	3, // 3: pb.OrderFoodService.LoginUser:input_type -> pb.LoginUserRequest
-	4, // 4: pb.OrderFoodService.CreateUser:output_type -> pb.CreateUserResponse
-	5, // 5: pb.OrderFoodService.UpdateUser:output_type -> pb.UpdateUserResponse
-	6, // 6: pb.OrderFoodService.UpdatePasswordUser:output_type -> pb.UpdatePasswordUserResponse
-	7, // 7: pb.OrderFoodService.LoginUser:output_type -> pb.LoginUserResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,   // 0: pb.OrderFoodService.CreateUser:input_type -> pb.CreateUserRequest
+	1,   // 1: pb.OrderFoodService.UpdateUser:input_type -> pb.UpdateUserRequest
+	2,   // 2: pb.OrderFoodService.UpdatePasswordUser:input_type -> pb.UpdatePasswordUserRequest
+	3,   // 3: pb.OrderFoodService.LoginUser:input_type -> pb.LoginUserRequest
+	4,   // 4: pb.OrderFoodService.RenewAccessToken:input_type -> pb.RenewAccessTokenRequest
+	5,   // 5: pb.OrderFoodService.GetTokenKeys:input_type -> pb.GetTokenKeysRequest
+	6,   // 6: pb.OrderFoodService.VerifyEmail:input_type -> pb.VerifyEmailRequest
+	7,   // 7: pb.OrderFoodService.ForgotPassword:input_type -> pb.ForgotPasswordRequest
+	8,   // 8: pb.OrderFoodService.ResetPassword:input_type -> pb.ResetPasswordRequest
+	9,   // 9: pb.OrderFoodService.Logout:input_type -> pb.LogoutRequest
+	10,  // 10: pb.OrderFoodService.ListMySessions:input_type -> pb.ListMySessionsRequest
+	11,  // 11: pb.OrderFoodService.RevokeSession:input_type -> pb.RevokeSessionRequest
+	12,  // 12: pb.OrderFoodService.RevokeAllSessions:input_type -> pb.RevokeAllSessionsRequest
+	13,  // 13: pb.OrderFoodService.ListUserSessions:input_type -> pb.ListUserSessionsRequest
+	14,  // 14: pb.OrderFoodService.RevokeUserSession:input_type -> pb.RevokeUserSessionRequest
+	15,  // 15: pb.OrderFoodService.RevokeUserSessions:input_type -> pb.RevokeUserSessionsRequest
+	16,  // 16: pb.OrderFoodService.UnlockUser:input_type -> pb.UnlockUserRequest
+	17,  // 17: pb.OrderFoodService.WatchOrders:input_type -> pb.WatchOrdersRequest
+	18,  // 18: pb.OrderFoodService.CreateCustomer:input_type -> pb.CreateCustomerRequest
+	19,  // 19: pb.OrderFoodService.GetCustomer:input_type -> pb.GetCustomerRequest
+	20,  // 20: pb.OrderFoodService.ListCustomers:input_type -> pb.ListCustomersRequest
+	21,  // 21: pb.OrderFoodService.DeleteCustomer:input_type -> pb.DeleteCustomerRequest
+	22,  // 22: pb.OrderFoodService.CreateCategory:input_type -> pb.CreateCategoryRequest
+	23,  // 23: pb.OrderFoodService.GetCategory:input_type -> pb.GetCategoryRequest
+	24,  // 24: pb.OrderFoodService.ListCategories:input_type -> pb.ListCategoriesRequest
+	25,  // 25: pb.OrderFoodService.UpdateCategory:input_type -> pb.UpdateCategoryRequest
+	26,  // 26: pb.OrderFoodService.DeleteCategory:input_type -> pb.DeleteCategoryRequest
+	27,  // 27: pb.OrderFoodService.CreateMenu:input_type -> pb.CreateMenuRequest
+	28,  // 28: pb.OrderFoodService.GetMenu:input_type -> pb.GetMenuRequest
+	29,  // 29: pb.OrderFoodService.ListMenus:input_type -> pb.ListMenusRequest
+	30,  // 30: pb.OrderFoodService.UpdateMenu:input_type -> pb.UpdateMenuRequest
+	31,  // 31: pb.OrderFoodService.DeleteMenu:input_type -> pb.DeleteMenuRequest
+	32,  // 32: pb.OrderFoodService.CreateOptionGroup:input_type -> pb.CreateOptionGroupRequest
+	33,  // 33: pb.OrderFoodService.ListOptionGroups:input_type -> pb.ListOptionGroupsRequest
+	34,  // 34: pb.OrderFoodService.DeleteOptionGroup:input_type -> pb.DeleteOptionGroupRequest
+	35,  // 35: pb.OrderFoodService.CreateMenuOption:input_type -> pb.CreateMenuOptionRequest
+	36,  // 36: pb.OrderFoodService.DeleteMenuOption:input_type -> pb.DeleteMenuOptionRequest
+	37,  // 37: pb.OrderFoodService.CreateMenuVariant:input_type -> pb.CreateMenuVariantRequest
+	38,  // 38: pb.OrderFoodService.ListMenuVariants:input_type -> pb.ListMenuVariantsRequest
+	39,  // 39: pb.OrderFoodService.UpdateMenuVariant:input_type -> pb.UpdateMenuVariantRequest
+	40,  // 40: pb.OrderFoodService.DeleteMenuVariant:input_type -> pb.DeleteMenuVariantRequest
+	41,  // 41: pb.OrderFoodService.GetMenuSchedule:input_type -> pb.GetMenuScheduleRequest
+	42,  // 42: pb.OrderFoodService.ReplaceMenuSchedule:input_type -> pb.ReplaceMenuScheduleRequest
+	43,  // 43: pb.OrderFoodService.GetCategorySchedule:input_type -> pb.GetCategoryScheduleRequest
+	44,  // 44: pb.OrderFoodService.ReplaceCategorySchedule:input_type -> pb.ReplaceCategoryScheduleRequest
+	45,  // 45: pb.OrderFoodService.ListAvailableMenus:input_type -> pb.ListAvailableMenusRequest
+	46,  // 46: pb.OrderFoodService.CreateTable:input_type -> pb.CreateTableRequest
+	47,  // 47: pb.OrderFoodService.GetTable:input_type -> pb.GetTableRequest
+	48,  // 48: pb.OrderFoodService.ListTables:input_type -> pb.ListTablesRequest
+	49,  // 49: pb.OrderFoodService.UpdateTableStatus:input_type -> pb.UpdateTableStatusRequest
+	50,  // 50: pb.OrderFoodService.DeleteTable:input_type -> pb.DeleteTableRequest
+	51,  // 51: pb.OrderFoodService.RotateTableQR:input_type -> pb.RotateTableQRRequest
+	52,  // 52: pb.OrderFoodService.ExportTableQR:input_type -> pb.ExportTableQRRequest
+	53,  // 53: pb.OrderFoodService.CreateOrder:input_type -> pb.CreateOrderRequest
+	54,  // 54: pb.OrderFoodService.GetOrder:input_type -> pb.GetOrderRequest
+	55,  // 55: pb.OrderFoodService.ListOrders:input_type -> pb.ListOrdersRequest
+	56,  // 56: pb.OrderFoodService.UpdateOrder:input_type -> pb.UpdateOrderRequest
+	57,  // 57: pb.OrderFoodService.UpdateOrderStatus:input_type -> pb.UpdateOrderStatusRequest
+	58,  // 58: pb.OrderFoodService.DeleteOrder:input_type -> pb.DeleteOrderRequest
+	59,  // 59: pb.OrderFoodService.ListOrderStatusHistory:input_type -> pb.ListOrderStatusHistoryRequest
+	60,  // 60: pb.OrderFoodService.CreateOrderItem:input_type -> pb.CreateOrderItemRequest
+	61,  // 61: pb.OrderFoodService.GetOrderItem:input_type -> pb.GetOrderItemRequest
+	62,  // 62: pb.OrderFoodService.ListOrderItems:input_type -> pb.ListOrderItemsRequest
+	63,  // 63: pb.OrderFoodService.UpdateOrderItem:input_type -> pb.UpdateOrderItemRequest
+	64,  // 64: pb.OrderFoodService.DeleteOrderItem:input_type -> pb.DeleteOrderItemRequest
+	65,  // 65: pb.OrderFoodService.ListKitchenItems:input_type -> pb.ListKitchenItemsRequest
+	66,  // 66: pb.OrderFoodService.UpdateKitchenItemStatus:input_type -> pb.UpdateKitchenItemStatusRequest
+	67,  // 67: pb.OrderFoodService.CreatePayment:input_type -> pb.CreatePaymentRequest
+	68,  // 68: pb.OrderFoodService.GetPayment:input_type -> pb.GetPaymentRequest
+	69,  // 69: pb.OrderFoodService.ListPayments:input_type -> pb.ListPaymentsRequest
+	70,  // 70: pb.OrderFoodService.UpdatePaymentStatus:input_type -> pb.UpdatePaymentStatusRequest
+	71,  // 71: pb.OrderFoodService.DeletePayment:input_type -> pb.DeletePaymentRequest
+	72,  // 72: pb.OrderFoodService.GetSalesReport:input_type -> pb.GetSalesReportRequest
+	73,  // 73: pb.OrderFoodService.CreateUser:output_type -> pb.CreateUserResponse
+	74,  // 74: pb.OrderFoodService.UpdateUser:output_type -> pb.UpdateUserResponse
+	75,  // 75: pb.OrderFoodService.UpdatePasswordUser:output_type -> pb.UpdatePasswordUserResponse
+	76,  // 76: pb.OrderFoodService.LoginUser:output_type -> pb.LoginUserResponse
+	77,  // 77: pb.OrderFoodService.RenewAccessToken:output_type -> pb.RenewAccessTokenResponse
+	78,  // 78: pb.OrderFoodService.GetTokenKeys:output_type -> pb.GetTokenKeysResponse
+	79,  // 79: pb.OrderFoodService.VerifyEmail:output_type -> pb.VerifyEmailResponse
+	80,  // 80: pb.OrderFoodService.ForgotPassword:output_type -> pb.ForgotPasswordResponse
+	81,  // 81: pb.OrderFoodService.ResetPassword:output_type -> pb.ResetPasswordResponse
+	82,  // 82: pb.OrderFoodService.Logout:output_type -> pb.LogoutResponse
+	83,  // 83: pb.OrderFoodService.ListMySessions:output_type -> pb.ListMySessionsResponse
+	84,  // 84: pb.OrderFoodService.RevokeSession:output_type -> pb.RevokeSessionResponse
+	85,  // 85: pb.OrderFoodService.RevokeAllSessions:output_type -> pb.RevokeAllSessionsResponse
+	86,  // 86: pb.OrderFoodService.ListUserSessions:output_type -> pb.ListUserSessionsResponse
+	87,  // 87: pb.OrderFoodService.RevokeUserSession:output_type -> pb.RevokeUserSessionResponse
+	88,  // 88: pb.OrderFoodService.RevokeUserSessions:output_type -> pb.RevokeUserSessionsResponse
+	89,  // 89: pb.OrderFoodService.UnlockUser:output_type -> pb.UnlockUserResponse
+	90,  // 90: pb.OrderFoodService.WatchOrders:output_type -> pb.OrderEvent
+	91,  // 91: pb.OrderFoodService.CreateCustomer:output_type -> pb.CreateCustomerResponse
+	92,  // 92: pb.OrderFoodService.GetCustomer:output_type -> pb.GetCustomerResponse
+	93,  // 93: pb.OrderFoodService.ListCustomers:output_type -> pb.ListCustomersResponse
+	94,  // 94: pb.OrderFoodService.DeleteCustomer:output_type -> pb.DeleteCustomerResponse
+	95,  // 95: pb.OrderFoodService.CreateCategory:output_type -> pb.CreateCategoryResponse
+	96,  // 96: pb.OrderFoodService.GetCategory:output_type -> pb.GetCategoryResponse
+	97,  // 97: pb.OrderFoodService.ListCategories:output_type -> pb.ListCategoriesResponse
+	98,  // 98: pb.OrderFoodService.UpdateCategory:output_type -> pb.UpdateCategoryResponse
+	99,  // 99: pb.OrderFoodService.DeleteCategory:output_type -> pb.DeleteCategoryResponse
+	100, // 100: pb.OrderFoodService.CreateMenu:output_type -> pb.CreateMenuResponse
+	101, // 101: pb.OrderFoodService.GetMenu:output_type -> pb.GetMenuResponse
+	102, // 102: pb.OrderFoodService.ListMenus:output_type -> pb.ListMenusResponse
+	103, // 103: pb.OrderFoodService.UpdateMenu:output_type -> pb.UpdateMenuResponse
+	104, // 104: pb.OrderFoodService.DeleteMenu:output_type -> pb.DeleteMenuResponse
+	105, // 105: pb.OrderFoodService.CreateOptionGroup:output_type -> pb.CreateOptionGroupResponse
+	106, // 106: pb.OrderFoodService.ListOptionGroups:output_type -> pb.ListOptionGroupsResponse
+	107, // 107: pb.OrderFoodService.DeleteOptionGroup:output_type -> pb.DeleteOptionGroupResponse
+	108, // 108: pb.OrderFoodService.CreateMenuOption:output_type -> pb.CreateMenuOptionResponse
+	109, // 109: pb.OrderFoodService.DeleteMenuOption:output_type -> pb.DeleteMenuOptionResponse
+	110, // 110: pb.OrderFoodService.CreateMenuVariant:output_type -> pb.CreateMenuVariantResponse
+	111, // 111: pb.OrderFoodService.ListMenuVariants:output_type -> pb.ListMenuVariantsResponse
+	112, // 112: pb.OrderFoodService.UpdateMenuVariant:output_type -> pb.UpdateMenuVariantResponse
+	113, // 113: pb.OrderFoodService.DeleteMenuVariant:output_type -> pb.DeleteMenuVariantResponse
+	114, // 114: pb.OrderFoodService.GetMenuSchedule:output_type -> pb.GetScheduleResponse
+	115, // 115: pb.OrderFoodService.ReplaceMenuSchedule:output_type -> pb.ReplaceScheduleResponse
+	114, // 116: pb.OrderFoodService.GetCategorySchedule:output_type -> pb.GetScheduleResponse
+	115, // 117: pb.OrderFoodService.ReplaceCategorySchedule:output_type -> pb.ReplaceScheduleResponse
+	116, // 118: pb.OrderFoodService.ListAvailableMenus:output_type -> pb.ListAvailableMenusResponse
+	117, // 119: pb.OrderFoodService.CreateTable:output_type -> pb.CreateTableResponse
+	118, // 120: pb.OrderFoodService.GetTable:output_type -> pb.GetTableResponse
+	119, // 121: pb.OrderFoodService.ListTables:output_type -> pb.ListTablesResponse
+	120, // 122: pb.OrderFoodService.UpdateTableStatus:output_type -> pb.UpdateTableStatusResponse
+	121, // 123: pb.OrderFoodService.DeleteTable:output_type -> pb.DeleteTableResponse
+	122, // 124: pb.OrderFoodService.RotateTableQR:output_type -> pb.RotateTableQRResponse
+	123, // 125: pb.OrderFoodService.ExportTableQR:output_type -> google.api.HttpBody
+	124, // 126: pb.OrderFoodService.CreateOrder:output_type -> pb.CreateOrderResponse
+	125, // 127: pb.OrderFoodService.GetOrder:output_type -> pb.GetOrderResponse
+	126, // 128: pb.OrderFoodService.ListOrders:output_type -> pb.ListOrdersResponse
+	127, // 129: pb.OrderFoodService.UpdateOrder:output_type -> pb.UpdateOrderResponse
+	128, // 130: pb.OrderFoodService.UpdateOrderStatus:output_type -> pb.UpdateOrderStatusResponse
+	129, // 131: pb.OrderFoodService.DeleteOrder:output_type -> pb.DeleteOrderResponse
+	130, // 132: pb.OrderFoodService.ListOrderStatusHistory:output_type -> pb.ListOrderStatusHistoryResponse
+	131, // 133: pb.OrderFoodService.CreateOrderItem:output_type -> pb.CreateOrderItemResponse
+	132, // 134: pb.OrderFoodService.GetOrderItem:output_type -> pb.GetOrderItemResponse
+	133, // 135: pb.OrderFoodService.ListOrderItems:output_type -> pb.ListOrderItemsResponse
+	134, // 136: pb.OrderFoodService.UpdateOrderItem:output_type -> pb.UpdateOrderItemResponse
+	135, // 137: pb.OrderFoodService.DeleteOrderItem:output_type -> pb.DeleteOrderItemResponse
+	136, // 138: pb.OrderFoodService.ListKitchenItems:output_type -> pb.ListKitchenItemsResponse
+	137, // 139: pb.OrderFoodService.UpdateKitchenItemStatus:output_type -> pb.UpdateKitchenItemStatusResponse
+	138, // 140: pb.OrderFoodService.CreatePayment:output_type -> pb.CreatePaymentResponse
+	139, // 141: pb.OrderFoodService.GetPayment:output_type -> pb.GetPaymentResponse
+	140, // 142: pb.OrderFoodService.ListPayments:output_type -> pb.ListPaymentsResponse
+	141, // 143: pb.OrderFoodService.UpdatePaymentStatus:output_type -> pb.UpdatePaymentStatusResponse
+	142, // 144: pb.OrderFoodService.DeletePayment:output_type -> pb.DeletePaymentResponse
+	143, // 145: pb.OrderFoodService.GetSalesReport:output_type -> pb.GetSalesReportResponse
+	73,  // [73:146] is the sub-list for method output_type
+	0,   // [0:73] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_service_order_food_proto_init() }
@@ -67,8 +423,28 @@ func file_service_order_food_proto_init() {
 	}
 	file_rpc_create_user_proto_init()
 	file_rpc_login_user_proto_init()
+	file_rpc_renew_access_token_proto_init()
 	file_rpc_update_user_proto_init()
 	file_rpc_updateonlypassword_user_proto_init()
+	file_rpc_verify_email_proto_init()
+	file_rpc_forgot_password_proto_init()
+	file_rpc_reset_password_proto_init()
+	file_rpc_session_proto_init()
+	file_rpc_token_keys_proto_init()
+	file_rpc_unlock_user_proto_init()
+	file_rpc_watch_orders_proto_init()
+	file_order_event_proto_init()
+	file_rpc_customer_proto_init()
+	file_rpc_category_proto_init()
+	file_rpc_menu_proto_init()
+	file_rpc_menu_option_proto_init()
+	file_rpc_menu_variant_proto_init()
+	file_rpc_availability_proto_init()
+	file_rpc_table_proto_init()
+	file_rpc_order_proto_init()
+	file_rpc_order_item_proto_init()
+	file_rpc_payment_proto_init()
+	file_rpc_sales_report_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
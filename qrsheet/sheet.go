@@ -0,0 +1,130 @@
+package qrsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const (
+	SizeSmall  = "small"
+	SizeMedium = "medium"
+	SizeLarge  = "large"
+)
+
+const (
+	pageMargin   = 10.0
+	headerHeight = 14.0
+	labelHeight  = 8.0
+	cellPadding  = 4.0
+)
+
+// Size controls the PNG resolution of each code and how many codes fit on
+// one row of an A4 page.
+type Size struct {
+	Pixels  int
+	Columns int
+}
+
+var sizes = map[string]Size{
+	SizeSmall:  {Pixels: 256, Columns: 4},
+	SizeMedium: {Pixels: 512, Columns: 3},
+	SizeLarge:  {Pixels: 1024, Columns: 2},
+}
+
+func SizeByName(name string) (Size, bool) {
+	size, ok := sizes[name]
+	return size, ok
+}
+
+type Entry struct {
+	Name   string
+	QRText string
+}
+
+func WritePDF(w io.Writer, entries []Entry, size Size, branding string) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(false, pageMargin)
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	cellWidth := (pageWidth - 2*pageMargin) / float64(size.Columns)
+	qrWidth := cellWidth - 2*cellPadding
+	cellHeight := cellWidth + labelHeight
+	rows := int((pageHeight - 2*pageMargin - headerHeight) / cellHeight)
+	if rows < 1 {
+		rows = 1
+	}
+	perPage := rows * size.Columns
+
+	for i, entry := range entries {
+		if i%perPage == 0 {
+			pdf.AddPage()
+			pdf.SetFont("Helvetica", "B", 16)
+			pdf.CellFormat(0, headerHeight, translate(branding), "", 1, "C", false, 0, "")
+		}
+
+		png, err := qrcode.Encode(entry.QRText, qrcode.Medium, size.Pixels)
+		if err != nil {
+			return fmt.Errorf("cannot encode QR for %s: %w", entry.Name, err)
+		}
+
+		imageName := fmt.Sprintf("qr-%d", i)
+		options := gofpdf.ImageOptions{ImageType: "PNG"}
+		pdf.RegisterImageOptionsReader(imageName, options, bytes.NewReader(png))
+
+		slot := i % perPage
+		x := pageMargin + float64(slot%size.Columns)*cellWidth
+		y := pageMargin + headerHeight + float64(slot/size.Columns)*cellHeight
+		pdf.ImageOptions(imageName, x+cellPadding, y+cellPadding, qrWidth, qrWidth, false, options, 0, "")
+
+		pdf.SetFont("Helvetica", "", 12)
+		pdf.SetXY(x, y+cellWidth)
+		pdf.CellFormat(cellWidth, labelHeight, translate(entry.Name), "", 0, "C", false, 0, "")
+	}
+
+	if len(entries) == 0 {
+		pdf.AddPage()
+		pdf.SetFont("Helvetica", "B", 16)
+		pdf.CellFormat(0, headerHeight, translate(branding), "", 1, "C", false, 0, "")
+	}
+
+	return pdf.Output(w)
+}
+
+func WriteZIP(w io.Writer, entries []Entry, size Size) error {
+	archive := zip.NewWriter(w)
+
+	for _, entry := range entries {
+		png, err := qrcode.Encode(entry.QRText, qrcode.Medium, size.Pixels)
+		if err != nil {
+			return fmt.Errorf("cannot encode QR for %s: %w", entry.Name, err)
+		}
+
+		file, err := archive.Create(fileName(entry.Name) + ".png")
+		if err != nil {
+			return err
+		}
+		_, err = file.Write(png)
+		if err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+func fileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r < ' ' {
+			return '_'
+		}
+		return r
+	}, name)
+}
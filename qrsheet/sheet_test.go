@@ -0,0 +1,63 @@
+package qrsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomEntries(n int) []Entry {
+	entries := make([]Entry, n)
+	for i := range entries {
+		entries[i] = Entry{
+			Name:   fmt.Sprintf("Table-%d", i+1),
+			QRText: fmt.Sprintf("http://localhost:3000/table/token-%d", i+1),
+		}
+	}
+	return entries
+}
+
+func TestWritePDF(t *testing.T) {
+	for name := range sizes {
+		t.Run(name, func(t *testing.T) {
+			size, ok := SizeByName(name)
+			require.True(t, ok)
+
+			var buf bytes.Buffer
+			err := WritePDF(&buf, randomEntries(25), size, "OrderFood Café")
+			require.NoError(t, err)
+			require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+		})
+	}
+}
+
+func TestWriteZIP(t *testing.T) {
+	size, ok := SizeByName(SizeSmall)
+	require.True(t, ok)
+
+	var buf bytes.Buffer
+	err := WriteZIP(&buf, append(randomEntries(2), Entry{Name: "Patio/1", QRText: "x"}), size)
+	require.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, reader.File, 3)
+	require.Equal(t, "Table-1.png", reader.File[0].Name)
+	require.Equal(t, "Patio_1.png", reader.File[2].Name)
+
+	file, err := reader.File[0].Open()
+	require.NoError(t, err)
+	defer file.Close()
+	img, err := png.Decode(file)
+	require.NoError(t, err)
+	require.Equal(t, size.Pixels, img.Bounds().Dx())
+}
+
+func TestSizeByNameUnknown(t *testing.T) {
+	_, ok := SizeByName("huge")
+	require.False(t, ok)
+}
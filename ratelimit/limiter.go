@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter allows at most a fixed number of events per key within a window.
+type Limiter interface {
+	Allow(ctx context.Context, key string) (bool, error)
+}
+
+// PasswordResetLimiters limits password reset requests per email address and
+// per client IP. One pair is shared by every server of a process.
+type PasswordResetLimiters struct {
+	Email Limiter
+	IP    Limiter
+}
+
+type window struct {
+	count   int
+	resetAt time.Time
+}
+
+// MemoryLimiter is a fixed-window limiter kept in process memory. Limits are
+// per instance, so a deployment with several replicas allows proportionally more.
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	period  time.Duration
+	windows map[string]*window
+	now     func() time.Time
+}
+
+func NewMemoryLimiter(limit int, period time.Duration) *MemoryLimiter {
+	return &MemoryLimiter{
+		limit:   limit,
+		period:  period,
+		windows: make(map[string]*window),
+		now:     time.Now,
+	}
+}
+
+func (limiter *MemoryLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := limiter.now()
+	w, ok := limiter.windows[key]
+	if !ok || !now.Before(w.resetAt) {
+		limiter.sweep(now)
+		w = &window{resetAt: now.Add(limiter.period)}
+		limiter.windows[key] = w
+	}
+
+	if w.count >= limiter.limit {
+		return false, nil
+	}
+	w.count++
+	return true, nil
+}
+
+// sweep drops expired windows so that keys such as client IPs do not
+// accumulate forever.
+func (limiter *MemoryLimiter) sweep(now time.Time) {
+	for key, w := range limiter.windows {
+		if !now.Before(w.resetAt) {
+			delete(limiter.windows, key)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := NewMemoryLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		allowed, err := limiter.Allow(context.Background(), "a")
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	allowed, err := limiter.Allow(context.Background(), "a")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = limiter.Allow(context.Background(), "b")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	now = now.Add(time.Minute)
+	allowed, err = limiter.Allow(context.Background(), "a")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	require.Len(t, limiter.windows, 1)
+}
+
+func TestRedisLimiter(t *testing.T) {
+	address := os.Getenv("REDIS_ADDR")
+	if address == "" {
+		t.Skip("REDIS_ADDR is not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: address})
+	defer client.Close()
+	limiter := NewRedisLimiter(client, "test_"+utils.RandomString(8), 2, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		allowed, err := limiter.Allow(context.Background(), "a")
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	allowed, err := limiter.Allow(context.Background(), "a")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = limiter.Allow(context.Background(), "b")
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "rate_limit:"
+
+// incrementScript counts an event and starts the window on the first one, so
+// that later events do not extend it.
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// RedisLimiter is a fixed-window limiter kept in Redis, so every replica
+// counts against the same limit. Keys are namespaced by name so that several
+// limiters can share one Redis.
+type RedisLimiter struct {
+	client redis.UniversalClient
+	name   string
+	limit  int
+	period time.Duration
+}
+
+func NewRedisLimiter(client redis.UniversalClient, name string, limit int, period time.Duration) *RedisLimiter {
+	return &RedisLimiter{
+		client: client,
+		name:   name,
+		limit:  limit,
+		period: period,
+	}
+}
+
+func (limiter *RedisLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	count, err := incrementScript.Run(ctx, limiter.client, []string{redisKeyPrefix + limiter.name + ":" + key}, limiter.period.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	return count <= limiter.limit, nil
+}
@@ -0,0 +1,107 @@
+package rbac
+
+const (
+	RoleAdmin   = "admin"
+	RoleManager = "manager"
+	RoleCashier = "cashier"
+	RoleWaiter  = "waiter"
+	RoleKitchen = "kitchen"
+	// RolePending is given to self-registered users. It grants nothing until
+	// an admin assigns one of Roles.
+	RolePending = "pending"
+)
+
+type Permission string
+
+const (
+	PermProfileUpdate  Permission = "profile:update"
+	PermUserRead       Permission = "users:read"
+	PermUserManage     Permission = "users:manage"
+	PermCustomerRead   Permission = "customers:read"
+	PermCustomerManage Permission = "customers:manage"
+	PermMenuRead       Permission = "menus:read"
+	PermMenuManage     Permission = "menus:manage"
+	PermTableRead      Permission = "tables:read"
+	PermTableStatus    Permission = "tables:status"
+	PermTableManage    Permission = "tables:manage"
+	PermOrderRead      Permission = "orders:read"
+	PermOrderWrite     Permission = "orders:write"
+	PermOrderStatus    Permission = "orders:status"
+	PermOrderDelete    Permission = "orders:delete"
+	PermKitchen        Permission = "kitchen:update"
+	PermEventsRead     Permission = "events:read"
+	PermPaymentRead    Permission = "payments:read"
+	PermPaymentWrite   Permission = "payments:write"
+	PermPaymentDelete  Permission = "payments:delete"
+	PermReportRead     Permission = "reports:read"
+)
+
+var Roles = []string{RoleAdmin, RoleManager, RoleCashier, RoleWaiter, RoleKitchen}
+
+var frontOfHouse = []Permission{
+	PermProfileUpdate,
+	PermCustomerRead,
+	PermCustomerManage,
+	PermMenuRead,
+	PermTableRead,
+	PermTableStatus,
+	PermOrderRead,
+	PermOrderWrite,
+	PermOrderStatus,
+	PermEventsRead,
+}
+
+var rolePermissions = map[string][]Permission{
+	RoleManager: {
+		PermProfileUpdate,
+		PermUserRead,
+		PermCustomerRead,
+		PermCustomerManage,
+		PermMenuRead,
+		PermMenuManage,
+		PermTableRead,
+		PermTableStatus,
+		PermTableManage,
+		PermOrderRead,
+		PermOrderWrite,
+		PermOrderStatus,
+		PermOrderDelete,
+		PermKitchen,
+		PermEventsRead,
+		PermPaymentRead,
+		PermPaymentWrite,
+		PermPaymentDelete,
+		PermReportRead,
+	},
+	RoleCashier: append([]Permission{PermPaymentRead, PermPaymentWrite}, frontOfHouse...),
+	RoleWaiter:  append([]Permission{PermKitchen}, frontOfHouse...),
+	RoleKitchen: {
+		PermProfileUpdate,
+		PermMenuRead,
+		PermOrderRead,
+		PermKitchen,
+		PermEventsRead,
+	},
+}
+
+func IsValidRole(role string) bool {
+	if role == RoleAdmin || role == RolePending {
+		return true
+	}
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Can reports whether the role is granted the permission. Admins are granted
+// every permission; unknown roles are granted none.
+func Can(role string, permission Permission) bool {
+	if role == RoleAdmin {
+		return true
+	}
+	for _, granted := range rolePermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
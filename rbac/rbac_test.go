@@ -0,0 +1,45 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCan(t *testing.T) {
+	testCases := []struct {
+		role       string
+		permission Permission
+		allowed    bool
+	}{
+		{RoleAdmin, PermUserManage, true},
+		{RoleManager, PermUserManage, false},
+		{RoleManager, PermMenuManage, true},
+		{RoleCashier, PermPaymentWrite, true},
+		{RoleCashier, PermKitchen, false},
+		{RoleManager, PermReportRead, true},
+		{RoleCashier, PermReportRead, false},
+		{RoleWaiter, PermOrderWrite, true},
+		{RoleWaiter, PermPaymentRead, false},
+		{RoleKitchen, PermKitchen, true},
+		{RoleKitchen, PermOrderWrite, false},
+		{RolePending, PermProfileUpdate, false},
+		{RolePending, PermOrderWrite, false},
+		{"staff", PermMenuRead, false},
+		{"guest", PermMenuRead, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.allowed, Can(tc.role, tc.permission), "%s %s", tc.role, tc.permission)
+	}
+}
+
+func TestIsValidRole(t *testing.T) {
+	for _, role := range Roles {
+		require.True(t, IsValidRole(role))
+		require.True(t, Can(role, PermProfileUpdate))
+	}
+	require.True(t, IsValidRole(RolePending))
+	require.False(t, IsValidRole("staff"))
+	require.False(t, IsValidRole(""))
+}
@@ -0,0 +1,135 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+const Mask = "[REDACTED]"
+
+// minSecretLength keeps very short values from masking unrelated text.
+const minSecretLength = 4
+
+// sensitiveKeys are matched against the end of lower-cased field names, so
+// that "hash_password" and "access_token" are masked but "access_token_expires_at" is not.
+var sensitiveKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"authorization",
+	"cookie",
+	"api_key",
+	"private_key",
+}
+
+// Writer masks sensitive fields and known secret values in zerolog JSON
+// events before passing them to the wrapped writer. String fields holding
+// JSON, such as task payloads or response bodies, are masked recursively.
+type Writer struct {
+	out     io.Writer
+	secrets []string
+}
+
+func NewWriter(out io.Writer, secrets ...string) *Writer {
+	writer := &Writer{out: out}
+	for _, secret := range secrets {
+		if len(secret) >= minSecretLength {
+			writer.secrets = append(writer.secrets, secret)
+		}
+	}
+	return writer
+}
+
+func (writer *Writer) Write(p []byte) (int, error) {
+	if !writer.mayContainSecrets(p) {
+		return writer.out.Write(p)
+	}
+
+	var event map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(p))
+	decoder.UseNumber()
+	if err := decoder.Decode(&event); err != nil {
+		_, err := writer.out.Write([]byte(writer.maskSecrets(string(p))))
+		return len(p), err
+	}
+
+	data, err := json.Marshal(writer.redactValue(event))
+	if err != nil {
+		return 0, err
+	}
+	_, err = writer.out.Write(append(data, '\n'))
+	return len(p), err
+}
+
+func (writer *Writer) mayContainSecrets(p []byte) bool {
+	lower := bytes.ToLower(p)
+	for _, key := range sensitiveKeys {
+		if bytes.Contains(lower, []byte(key)) {
+			return true
+		}
+	}
+	for _, secret := range writer.secrets {
+		if bytes.Contains(p, []byte(secret)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (writer *Writer) redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if IsSensitiveKey(key) {
+				v[key] = Mask
+				continue
+			}
+			v[key] = writer.redactValue(field)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = writer.redactValue(item)
+		}
+		return v
+	case string:
+		return writer.redactString(v)
+	default:
+		return v
+	}
+}
+
+func (writer *Writer) redactString(value string) string {
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var nested any
+		decoder := json.NewDecoder(strings.NewReader(trimmed))
+		decoder.UseNumber()
+		if err := decoder.Decode(&nested); err == nil {
+			if data, err := json.Marshal(writer.redactValue(nested)); err == nil {
+				return string(data)
+			}
+		}
+	}
+	return writer.maskSecrets(value)
+}
+
+func (writer *Writer) maskSecrets(value string) string {
+	for _, secret := range writer.secrets {
+		value = strings.ReplaceAll(value, secret, Mask)
+	}
+	return value
+}
+
+// IsSensitiveKey reports whether a field with this name must never be logged.
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.HasSuffix(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
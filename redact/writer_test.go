@@ -0,0 +1,71 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func logLine(t *testing.T, secrets []string, write func(logger zerolog.Logger)) map[string]any {
+	var buf bytes.Buffer
+	write(zerolog.New(NewWriter(&buf, secrets...)))
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &event))
+	return event
+}
+
+func TestWriterMasksSensitiveFields(t *testing.T) {
+	event := logLine(t, nil, func(logger zerolog.Logger) {
+		logger.Info().
+			Str("password", "hunter22").
+			Str("access_token", "v2.local.abc").
+			Str("access_token_expires_at", "2026-01-01").
+			Str("username", "alice").
+			Msg("login")
+	})
+
+	require.Equal(t, Mask, event["password"])
+	require.Equal(t, Mask, event["access_token"])
+	require.Equal(t, "2026-01-01", event["access_token_expires_at"])
+	require.Equal(t, "alice", event["username"])
+	require.Equal(t, "login", event["message"])
+}
+
+func TestWriterMasksNestedPayload(t *testing.T) {
+	payload := []byte(`{"username":"alice","reset":{"token":"abc123","new_password":"hunter22"}}`)
+	event := logLine(t, nil, func(logger zerolog.Logger) {
+		logger.Error().Bytes("Payload", payload).Msg("process task failed")
+	})
+
+	var nested map[string]any
+	require.NoError(t, json.Unmarshal([]byte(event["Payload"].(string)), &nested))
+	require.Equal(t, "alice", nested["username"])
+	reset := nested["reset"].(map[string]any)
+	require.Equal(t, Mask, reset["token"])
+	require.Equal(t, Mask, reset["new_password"])
+}
+
+func TestWriterMasksKnownSecrets(t *testing.T) {
+	secret := "s3cr3t-value"
+	event := logLine(t, []string{secret, "ab"}, func(logger zerolog.Logger) {
+		logger.Error().Str("error", "dial postgresql://root:"+secret+"@db failed").Str("note", "ab").Msg("db")
+	})
+
+	require.Equal(t, "dial postgresql://root:"+Mask+"@db failed", event["error"])
+	require.Equal(t, "ab", event["note"])
+}
+
+func TestWriterPassesThroughCleanEvents(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, "s3cr3t-value")
+	line := []byte(`{"level":"info","message":"ok"}` + "\n")
+
+	n, err := writer.Write(line)
+	require.NoError(t, err)
+	require.Equal(t, len(line), n)
+	require.Equal(t, line, buf.Bytes())
+}
@@ -0,0 +1,70 @@
+package revocation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/datmaithanh/orderfood/token"
+)
+
+var (
+	ErrTokenRevoked  = errors.New("token has been revoked")
+	ErrTokenOutdated = errors.New("token was issued before the account changed")
+)
+
+// VersionStore returns the current token version of a user. db.Store
+// satisfies it.
+type VersionStore interface {
+	GetUserTokenVersion(ctx context.Context, username string) (int64, error)
+}
+
+// Checker decides whether a verified token is still acceptable. Both checks
+// are optional: a nil list skips the revocation lookup and a nil store skips
+// the token version comparison.
+type Checker struct {
+	list     List
+	versions VersionStore
+}
+
+func NewChecker(list List, versions VersionStore) *Checker {
+	return &Checker{list: list, versions: versions}
+}
+
+func (checker *Checker) Check(ctx context.Context, payload *token.Payload) error {
+	if checker.list != nil {
+		revoked, err := checker.list.IsRevoked(ctx, payload.ID)
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return ErrTokenRevoked
+		}
+	}
+
+	// Guest tokens are bound to a table rather than a user account.
+	if checker.versions == nil || payload.Role == token.GuestRole {
+		return nil
+	}
+
+	version, err := checker.versions.GetUserTokenVersion(ctx, payload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%w: user no longer exists", ErrTokenOutdated)
+		}
+		return err
+	}
+	if payload.Version != version {
+		return ErrTokenOutdated
+	}
+	return nil
+}
+
+// Revoke adds the token to the revocation list, if one is configured.
+func (checker *Checker) Revoke(ctx context.Context, payload *token.Payload) error {
+	if checker.list == nil {
+		return nil
+	}
+	return checker.list.Revoke(ctx, payload.ID, payload.ExpiredAt)
+}
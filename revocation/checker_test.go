@@ -0,0 +1,49 @@
+package revocation
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/token"
+	"github.com/stretchr/testify/require"
+)
+
+type versionStore map[string]int64
+
+func (store versionStore) GetUserTokenVersion(ctx context.Context, username string) (int64, error) {
+	version, ok := store[username]
+	if !ok {
+		return 0, sql.ErrNoRows
+	}
+	return version, nil
+}
+
+func TestChecker(t *testing.T) {
+	versions := versionStore{"alice": 2}
+	checker := NewChecker(NewMemoryList(), versions)
+
+	payload := token.NewPayload("alice", "waiter", 2, time.Minute)
+	require.NoError(t, checker.Check(context.Background(), payload))
+
+	require.NoError(t, checker.Revoke(context.Background(), payload))
+	require.ErrorIs(t, checker.Check(context.Background(), payload), ErrTokenRevoked)
+
+	outdated := token.NewPayload("alice", "manager", 1, time.Minute)
+	require.ErrorIs(t, checker.Check(context.Background(), outdated), ErrTokenOutdated)
+
+	deleted := token.NewPayload("bob", "waiter", 1, time.Minute)
+	require.ErrorIs(t, checker.Check(context.Background(), deleted), ErrTokenOutdated)
+
+	guest := token.NewTablePayload(7, time.Minute)
+	require.NoError(t, checker.Check(context.Background(), guest))
+}
+
+func TestCheckerDisabled(t *testing.T) {
+	checker := NewChecker(nil, nil)
+
+	payload := token.NewPayload("alice", "waiter", 1, time.Minute)
+	require.NoError(t, checker.Revoke(context.Background(), payload))
+	require.NoError(t, checker.Check(context.Background(), payload))
+}
@@ -0,0 +1,14 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// List remembers revoked token IDs until the tokens would have expired anyway.
+type List interface {
+	Revoke(ctx context.Context, tokenID uuid.UUID, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,53 @@
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemoryList keeps revoked token IDs in process memory. Revocations are not
+// shared between replicas and are lost on restart.
+type MemoryList struct {
+	mu      sync.Mutex
+	revoked map[uuid.UUID]time.Time
+	now     func() time.Time
+}
+
+func NewMemoryList() *MemoryList {
+	return &MemoryList{
+		revoked: make(map[uuid.UUID]time.Time),
+		now:     time.Now,
+	}
+}
+
+func (list *MemoryList) Revoke(ctx context.Context, tokenID uuid.UUID, expiresAt time.Time) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	now := list.now()
+	list.sweep(now)
+	if expiresAt.After(now) {
+		list.revoked[tokenID] = expiresAt
+	}
+	return nil
+}
+
+func (list *MemoryList) IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	expiresAt, ok := list.revoked[tokenID]
+	return ok && list.now().Before(expiresAt), nil
+}
+
+// sweep drops entries whose tokens have expired on their own.
+func (list *MemoryList) sweep(now time.Time) {
+	for tokenID, expiresAt := range list.revoked {
+		if !now.Before(expiresAt) {
+			delete(list.revoked, tokenID)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryList(t *testing.T) {
+	now := time.Now()
+	list := NewMemoryList()
+	list.now = func() time.Time { return now }
+
+	tokenID := uuid.New()
+	revoked, err := list.IsRevoked(context.Background(), tokenID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, list.Revoke(context.Background(), tokenID, now.Add(time.Minute)))
+	revoked, err = list.IsRevoked(context.Background(), tokenID)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	now = now.Add(2 * time.Minute)
+	revoked, err = list.IsRevoked(context.Background(), tokenID)
+	require.NoError(t, err)
+	require.False(t, revoked, "entries expire with the token")
+
+	require.NoError(t, list.Revoke(context.Background(), uuid.New(), now.Add(time.Minute)))
+	require.Len(t, list.revoked, 1, "expired entries are swept")
+}
@@ -0,0 +1,43 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "revoked_token:"
+
+// RedisList stores revoked token IDs in Redis with a TTL matching the token
+// expiry, so every replica sees the same revocations.
+type RedisList struct {
+	client redis.UniversalClient
+}
+
+func NewRedisList(client redis.UniversalClient) *RedisList {
+	return &RedisList{client: client}
+}
+
+func (list *RedisList) Revoke(ctx context.Context, tokenID uuid.UUID, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	err := list.client.Set(ctx, redisKeyPrefix+tokenID.String(), 1, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+func (list *RedisList) IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	count, err := list.client.Exists(ctx, redisKeyPrefix+tokenID.String()).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	return count > 0, nil
+}
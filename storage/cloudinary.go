@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/cloudinary/cloudinary-go"
+	"github.com/cloudinary/cloudinary-go/api/uploader"
+)
+
+type CloudinaryStore struct {
+	cld *cloudinary.Cloudinary
+}
+
+func NewCloudinaryStore(url string) (*CloudinaryStore, error) {
+	cld, err := cloudinary.NewFromURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to Cloudinary: %w", err)
+	}
+
+	return &CloudinaryStore{cld: cld}, nil
+}
+
+func (store *CloudinaryStore) Upload(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	folder, publicID := cloudinaryPublicID(key)
+	uploadResult, err := store.cld.Upload.Upload(ctx, bytes.NewReader(data), uploader.UploadParams{
+		Folder:     folder,
+		PublicID:   publicID,
+		Overwrite:  true,
+		Invalidate: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot upload image to Cloudinary: %w", err)
+	}
+	if uploadResult.Error.Message != "" {
+		return "", fmt.Errorf("cannot upload image to Cloudinary: %s", uploadResult.Error.Message)
+	}
+
+	return uploadResult.SecureURL, nil
+}
+
+func (store *CloudinaryStore) Delete(ctx context.Context, key string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	folder, publicID := cloudinaryPublicID(key)
+	if folder != "" {
+		publicID = folder + "/" + publicID
+	}
+
+	_, err = store.cld.Upload.Destroy(ctx, uploader.DestroyParams{
+		PublicID:   publicID,
+		Invalidate: true,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot delete image from Cloudinary: %w", err)
+	}
+	return nil
+}
+
+// cloudinaryPublicID splits a key into the Cloudinary folder and the public ID,
+// which Cloudinary expects without the file extension.
+func cloudinaryPublicID(key string) (string, string) {
+	folder, file := path.Split(key)
+	return strings.TrimSuffix(folder, "/"), strings.TrimSuffix(file, path.Ext(file))
+}
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"sync"
+)
+
+// FakeStore keeps images in memory. It is meant for tests.
+type FakeStore struct {
+	mu      sync.Mutex
+	baseURL string
+	images  map[string][]byte
+}
+
+func NewFakeStore() *FakeStore {
+	return &FakeStore{
+		baseURL: "https://images.example.com",
+		images:  make(map[string][]byte),
+	}
+}
+
+func (store *FakeStore) Upload(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.images[key] = append([]byte(nil), data...)
+
+	return store.baseURL + "/" + key, nil
+}
+
+func (store *FakeStore) Delete(ctx context.Context, key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	delete(store.images, key)
+	return nil
+}
+
+func (store *FakeStore) Get(key string) ([]byte, bool) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	data, ok := store.images[key]
+	return data, ok
+}
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	TypeCloudinary = "cloudinary"
+	TypeLocal      = "local"
+	TypeS3         = "s3"
+)
+
+var ErrInvalidKey = errors.New("invalid image key")
+
+// ImageStore persists images under a slash separated key such as
+// "orderfood_qrcode/Table-1.png" and returns the public URL of the stored image.
+type ImageStore interface {
+	Upload(ctx context.Context, key string, data []byte, contentType string) (string, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Type          string
+	CloudinaryURL string
+	LocalDir      string
+	LocalBaseURL  string
+	S3            S3Config
+}
+
+func NewImageStore(config Config) (ImageStore, error) {
+	switch config.Type {
+	case TypeCloudinary:
+		return NewCloudinaryStore(config.CloudinaryURL)
+	case TypeLocal:
+		return NewLocalStore(config.LocalDir, config.LocalBaseURL)
+	case TypeS3:
+		return NewS3Store(config.S3)
+	default:
+		return nil, fmt.Errorf("unknown image store type %q", config.Type)
+	}
+}
+
+func cleanKey(key string) (string, error) {
+	cleaned := path.Clean("/" + key)[1:]
+	if cleaned == "" || cleaned != key || strings.HasPrefix(cleaned, "..") {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(dir, "http://localhost:8888/")
+	require.NoError(t, err)
+
+	url, err := store.Upload(context.Background(), "orderfood_qrcode/Table-1.png", []byte("png"), "image/png")
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8888/images/orderfood_qrcode/Table-1.png", url)
+
+	data, err := os.ReadFile(filepath.Join(dir, "orderfood_qrcode", "Table-1.png"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("png"), data)
+
+	require.NoError(t, store.Delete(context.Background(), "orderfood_qrcode/Table-1.png"))
+	require.NoError(t, store.Delete(context.Background(), "orderfood_qrcode/Table-1.png"))
+	_, err = os.Stat(filepath.Join(dir, "orderfood_qrcode", "Table-1.png"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLocalStoreRejectsTraversal(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), "")
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../secret.png", "a/../../b.png", "/abs.png", "a//b.png"} {
+		_, err := store.Upload(context.Background(), key, []byte("x"), "image/png")
+		require.ErrorIs(t, err, ErrInvalidKey, key)
+	}
+}
+
+func TestNewImageStore(t *testing.T) {
+	store, err := NewImageStore(Config{Type: TypeLocal, LocalDir: t.TempDir()})
+	require.NoError(t, err)
+	require.IsType(t, &LocalStore{}, store)
+
+	store, err = NewImageStore(Config{Type: TypeS3, S3: S3Config{
+		Endpoint:        "http://localhost:9000",
+		Bucket:          "orderfood",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	}})
+	require.NoError(t, err)
+	require.IsType(t, &S3Store{}, store)
+
+	_, err = NewImageStore(Config{Type: "ftp"})
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveSigningKey(t *testing.T) {
+	// Example from the AWS Signature Version 4 documentation.
+	key := deriveSigningKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20120215", "us-east-1", "iam")
+	require.Equal(t, "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d", hex.EncodeToString(key))
+}
+
+func TestS3Store(t *testing.T) {
+	var gotMethod, gotPath, gotAuth, gotType string
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.EscapedPath()
+		gotAuth = r.Header.Get("Authorization")
+		gotType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store, err := NewS3Store(S3Config{
+		Endpoint:        server.URL,
+		Region:          "auto",
+		Bucket:          "orderfood",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+		PublicURL:       "https://cdn.example.com/",
+	})
+	require.NoError(t, err)
+	store.now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	url, err := store.Upload(context.Background(), "orderfood_qrcode/Table 1.png", []byte("png"), "image/png")
+	require.NoError(t, err)
+	require.Equal(t, "https://cdn.example.com/orderfood_qrcode/Table%201.png", url)
+	require.Equal(t, http.MethodPut, gotMethod)
+	require.Equal(t, "/orderfood/orderfood_qrcode/Table%201.png", gotPath)
+	require.Equal(t, "image/png", gotType)
+	require.Equal(t, []byte("png"), gotBody)
+	require.True(t, strings.HasPrefix(gotAuth, "AWS4-HMAC-SHA256 Credential=AKID/20250102/auto/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature="))
+
+	err = store.Delete(context.Background(), "orderfood_qrcode/Table 1.png")
+	require.NoError(t, err)
+	require.Equal(t, http.MethodDelete, gotMethod)
+}
+
+func TestS3StoreErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	store, err := NewS3Store(S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "orderfood",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+
+	_, err = store.Upload(context.Background(), "a.png", []byte("png"), "image/png")
+	require.ErrorContains(t, err, "AccessDenied")
+}
@@ -0,0 +1,41 @@
+package tableqr
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/storage"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// Generate issues a fresh QR token for the table, renders and uploads its QR
+// image and stores both, replacing any previously printed code.
+func Generate(ctx context.Context, store db.Store, imageStore storage.ImageStore, key string, websiteURL string, table db.Table) (db.Table, error) {
+	qrToken, err := NewToken(key, table.ID)
+	if err != nil {
+		return table, err
+	}
+	qrText := fmt.Sprintf("%s/table/%s", websiteURL, qrToken)
+
+	png, err := qrcode.Encode(qrText, qrcode.Medium, 256)
+	if err != nil {
+		return table, err
+	}
+
+	qrImageURL, err := imageStore.Upload(ctx, ImageKey(table), png, "image/png")
+	if err != nil {
+		return table, fmt.Errorf("cannot upload QR image: %w", err)
+	}
+
+	return store.UpdateTableQR(ctx, db.UpdateTableQRParams{
+		ID:         table.ID,
+		QrText:     qrText,
+		QrImageUrl: qrImageURL,
+		QrToken:    qrToken,
+	})
+}
+
+func ImageKey(table db.Table) string {
+	return fmt.Sprintf("orderfood_qrcode/%s.png", table.Name)
+}
@@ -0,0 +1,76 @@
+package tableqr
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+)
+
+const tokenNonceSize = 24
+
+var ErrInvalidToken = errors.New("invalid or revoked table QR code")
+
+// NewToken builds the token printed in a table QR code. It carries the
+// table ID and a random nonce, signed with the server key so forged codes are
+// rejected before touching the database.
+func NewToken(key string, tableID int64) (string, error) {
+	nonce := make([]byte, tokenNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("cannot generate QR token: %w", err)
+	}
+
+	payload := fmt.Sprintf("%d.%s", tableID, base64.RawURLEncoding.EncodeToString(nonce))
+	return payload + "." + signPayload(key, payload), nil
+}
+
+func signPayload(key string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func ParseToken(key string, qrToken string) (int64, error) {
+	parts := strings.Split(qrToken, ".")
+	if len(parts) != 3 {
+		return 0, ErrInvalidToken
+	}
+
+	signature := signPayload(key, parts[0]+"."+parts[1])
+	if !hmac.Equal([]byte(parts[2]), []byte(signature)) {
+		return 0, ErrInvalidToken
+	}
+
+	tableID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || tableID <= 0 {
+		return 0, ErrInvalidToken
+	}
+	return tableID, nil
+}
+
+// VerifyToken checks the signature and that the token is still the one
+// stored for its table, so rotating a table's QR revokes all older codes.
+func VerifyToken(ctx context.Context, store db.Store, key string, qrToken string) (db.Table, error) {
+	tableID, err := ParseToken(key, qrToken)
+	if err != nil {
+		return db.Table{}, err
+	}
+
+	table, err := store.GetTable(ctx, tableID)
+	if err != nil {
+		return db.Table{}, err
+	}
+
+	if table.QrToken == "" || subtle.ConstantTimeCompare([]byte(table.QrToken), []byte(qrToken)) != 1 {
+		return db.Table{}, ErrInvalidToken
+	}
+	return table, nil
+}
@@ -0,0 +1,32 @@
+package tableqr
+
+import (
+	"testing"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken(t *testing.T) {
+	key := utils.RandomString(32)
+
+	qrToken, err := NewToken(key, 12)
+	require.NoError(t, err)
+
+	tableID, err := ParseToken(key, qrToken)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), tableID)
+
+	other, err := NewToken(key, 12)
+	require.NoError(t, err)
+	require.NotEqual(t, qrToken, other)
+
+	_, err = ParseToken(utils.RandomString(32), qrToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = ParseToken(key, "13"+qrToken[2:])
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = ParseToken(key, "12")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
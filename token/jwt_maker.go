@@ -0,0 +1,193 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const minSecretKeySize = 32
+
+// jwtClaims carries the Payload fields, using the registered claims where one
+// exists so that gateways can check expiry without knowing our schema.
+type jwtClaims struct {
+	Role    string `json:"role"`
+	Version int64  `json:"token_version,omitempty"`
+	TableID int64  `json:"table_id,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// JWTMaker signs JSON Web Tokens with HS256, or with RS256 or EdDSA when it is
+// created from an asymmetric key. Asymmetric tokens carry the key ID in the
+// kid header so that keys can be rotated like with PublicPasetoMaker.
+type JWTMaker struct {
+	method           jwt.SigningMethod
+	signingKey       any
+	keyID            string
+	verificationKeys map[string]crypto.PublicKey
+	secretKey        []byte
+}
+
+// NewJWTMaker creates an HS256 maker.
+func NewJWTMaker(secretKey string) (Maker, error) {
+	if len(secretKey) < minSecretKeySize {
+		return nil, fmt.Errorf("invalid key size: must be at least %d characters", minSecretKeySize)
+	}
+	return &JWTMaker{method: jwt.SigningMethodHS256, secretKey: []byte(secretKey)}, nil
+}
+
+// NewAsymmetricJWTMaker creates a maker that signs with privateKey, an
+// *rsa.PrivateKey (RS256) or ed25519.PrivateKey (EdDSA), and accepts tokens
+// signed by privateKey or any of verificationKeys. A nil privateKey gives a
+// verify-only maker.
+func NewAsymmetricJWTMaker(privateKey crypto.Signer, verificationKeys ...crypto.PublicKey) (Maker, error) {
+	maker := &JWTMaker{verificationKeys: make(map[string]crypto.PublicKey)}
+
+	if privateKey != nil {
+		method, err := jwtSigningMethod(privateKey.Public())
+		if err != nil {
+			return nil, err
+		}
+		maker.method = method
+		maker.signingKey = privateKey
+		maker.keyID = jwkThumbprint(privateKey.Public())
+		maker.verificationKeys[maker.keyID] = privateKey.Public()
+	}
+
+	for _, key := range verificationKeys {
+		if _, err := jwtSigningMethod(key); err != nil {
+			return nil, err
+		}
+		maker.verificationKeys[jwkThumbprint(key)] = key
+	}
+
+	if len(maker.verificationKeys) == 0 {
+		return nil, errors.New("at least one signing or verification key is required")
+	}
+	return maker, nil
+}
+
+func jwtSigningMethod(key crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if k.N.BitLen() < 2048 {
+			return nil, errors.New("rsa keys must be at least 2048 bits")
+		}
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+func (maker *JWTMaker) CreateToken(username string, role string, version int64, duration time.Duration) (string, *Payload, error) {
+	payload := NewPayload(username, role, version, duration)
+	token, err := maker.sign(payload)
+	return token, payload, err
+}
+
+func (maker *JWTMaker) CreateTableToken(tableID int64, duration time.Duration) (string, *Payload, error) {
+	payload := NewTablePayload(tableID, duration)
+	token, err := maker.sign(payload)
+	return token, payload, err
+}
+
+func (maker *JWTMaker) VerifyToken(token string) (*Payload, error) {
+	claims := &jwtClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, maker.verificationKey,
+		jwt.WithValidMethods(maker.validMethods()),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	id, err := uuid.Parse(claims.ID)
+	if err != nil || claims.IssuedAt == nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{
+		ID:        id,
+		Username:  claims.Subject,
+		Role:      claims.Role,
+		Version:   claims.Version,
+		TableID:   claims.TableID,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiredAt: claims.ExpiresAt.Time,
+	}
+	if err := payload.Valid(); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// PublicKeys lists the verification keys of an asymmetric maker. HS256 makers
+// have none, since their key must stay secret.
+func (maker *JWTMaker) PublicKeys() []PublicKey {
+	keys := make([]PublicKey, 0, len(maker.verificationKeys))
+	for keyID, key := range maker.verificationKeys {
+		method, _ := jwtSigningMethod(key)
+		keys = append(keys, PublicKey{KeyID: keyID, Algorithm: method.Alg(), Key: key})
+	}
+	sortPublicKeys(keys)
+	return keys
+}
+
+func (maker *JWTMaker) sign(payload *Payload) (string, error) {
+	claims := jwtClaims{
+		Role:    payload.Role,
+		Version: payload.Version,
+		TableID: payload.TableID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        payload.ID.String(),
+			Subject:   payload.Username,
+			IssuedAt:  jwt.NewNumericDate(payload.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(payload.ExpiredAt),
+		},
+	}
+
+	if maker.secretKey != nil {
+		return jwt.NewWithClaims(maker.method, claims).SignedString(maker.secretKey)
+	}
+	if maker.signingKey == nil {
+		return "", ErrSigningKeyMissing
+	}
+	token := jwt.NewWithClaims(maker.method, claims)
+	token.Header["kid"] = maker.keyID
+	return token.SignedString(maker.signingKey)
+}
+
+func (maker *JWTMaker) verificationKey(token *jwt.Token) (any, error) {
+	if maker.secretKey != nil {
+		return maker.secretKey, nil
+	}
+
+	keyID, _ := token.Header["kid"].(string)
+	key, ok := maker.verificationKeys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	method, _ := jwtSigningMethod(key)
+	if method.Alg() != token.Method.Alg() {
+		return nil, fmt.Errorf("key %q does not sign %s tokens", keyID, token.Method.Alg())
+	}
+	return key, nil
+}
+
+func (maker *JWTMaker) validMethods() []string {
+	if maker.secretKey != nil {
+		return []string{jwt.SigningMethodHS256.Alg()}
+	}
+	return []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+}
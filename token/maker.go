@@ -1,9 +1,87 @@
 package token
 
-import "time"
+import (
+	"crypto"
+	"crypto/ed25519"
+	"fmt"
+	"strings"
+	"time"
+)
 
 type Maker interface {
-	CreateToken(username string, role string, duration time.Duration) (string, *Payload ,error)
+	CreateToken(username string, role string, version int64, duration time.Duration) (string, *Payload, error)
+
+	CreateTableToken(tableID int64, duration time.Duration) (string, *Payload, error)
 
 	VerifyToken(token string) (*Payload, error)
 }
+
+const (
+	MakerPasetoLocal  = "paseto"
+	MakerPasetoPublic = "paseto-public"
+	MakerJWT          = "jwt"
+	MakerJWTPublic    = "jwt-public"
+)
+
+type Config struct {
+	Type         string
+	SymmetricKey string
+	// PrivateKey and VerificationKeys are PASERK encoded (k4.secret and
+	// k4.public). JWT makers also accept PEM encoded RSA and Ed25519 keys.
+	// VerificationKeys holds retired keys that still verify.
+	PrivateKey       string
+	VerificationKeys []string
+}
+
+func NewMaker(config Config) (Maker, error) {
+	switch config.Type {
+	case "", MakerPasetoLocal:
+		return NewPasetoMaker(config.SymmetricKey)
+	case MakerPasetoPublic:
+		var privateKey ed25519.PrivateKey
+		if config.PrivateKey != "" {
+			key, err := ParsePaserkSecret(config.PrivateKey)
+			if err != nil {
+				return nil, err
+			}
+			privateKey = key
+		}
+		var verificationKeys []ed25519.PublicKey
+		for _, value := range config.VerificationKeys {
+			if value = strings.TrimSpace(value); value == "" {
+				continue
+			}
+			key, err := ParsePaserkPublic(value)
+			if err != nil {
+				return nil, err
+			}
+			verificationKeys = append(verificationKeys, key)
+		}
+		return NewPublicPasetoMaker(privateKey, verificationKeys...)
+	case MakerJWT:
+		return NewJWTMaker(config.SymmetricKey)
+	case MakerJWTPublic:
+		var privateKey crypto.Signer
+		if config.PrivateKey != "" {
+			key, err := parsePrivateKey(config.PrivateKey)
+			if err != nil {
+				return nil, err
+			}
+			privateKey = key
+		}
+		var verificationKeys []crypto.PublicKey
+		for _, value := range config.VerificationKeys {
+			if value = strings.TrimSpace(value); value == "" {
+				continue
+			}
+			key, err := parsePublicKey(value)
+			if err != nil {
+				return nil, err
+			}
+			verificationKeys = append(verificationKeys, key)
+		}
+		return NewAsymmetricJWTMaker(privateKey, verificationKeys...)
+	default:
+		return nil, fmt.Errorf("unsupported token type %q", config.Type)
+	}
+}
@@ -0,0 +1,141 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMakers returns one maker of every kind so that the tests below run
+// identically against each of them.
+func newTestMakers(t *testing.T) map[string]Maker {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	makers := make(map[string]Maker)
+	add := func(name string, maker Maker, err error) {
+		require.NoError(t, err)
+		makers[name] = maker
+	}
+
+	maker, err := NewPasetoMaker(utils.RandomString(32))
+	add("paseto", maker, err)
+	maker, err = NewPublicPasetoMaker(ed25519Key)
+	add("paseto-public", maker, err)
+	maker, err = NewJWTMaker(utils.RandomString(32))
+	add("jwt-hs256", maker, err)
+	maker, err = NewAsymmetricJWTMaker(rsaKey)
+	add("jwt-rs256", maker, err)
+	maker, err = NewAsymmetricJWTMaker(ed25519Key)
+	add("jwt-eddsa", maker, err)
+	return makers
+}
+
+func TestMakerCreateToken(t *testing.T) {
+	for name, maker := range newTestMakers(t) {
+		t.Run(name, func(t *testing.T) {
+			username := utils.RandomString(6)
+			role := utils.RandomRole()
+
+			token, payload, err := maker.CreateToken(username, role, 4, time.Minute)
+			require.NoError(t, err)
+			require.NotEmpty(t, token)
+
+			verified, err := maker.VerifyToken(token)
+			require.NoError(t, err)
+			require.Equal(t, payload.ID, verified.ID)
+			require.Equal(t, username, verified.Username)
+			require.Equal(t, role, verified.Role)
+			require.Equal(t, int64(4), verified.Version)
+			require.Zero(t, verified.TableID)
+			require.WithinDuration(t, payload.IssuedAt, verified.IssuedAt, time.Second)
+			require.WithinDuration(t, payload.ExpiredAt, verified.ExpiredAt, time.Second)
+		})
+	}
+}
+
+func TestMakerTableToken(t *testing.T) {
+	for name, maker := range newTestMakers(t) {
+		t.Run(name, func(t *testing.T) {
+			token, payload, err := maker.CreateTableToken(7, time.Minute)
+			require.NoError(t, err)
+
+			verified, err := maker.VerifyToken(token)
+			require.NoError(t, err)
+			require.Equal(t, payload.ID, verified.ID)
+			require.Equal(t, int64(7), verified.TableID)
+			require.Equal(t, GuestRole, verified.Role)
+		})
+	}
+}
+
+func TestMakerExpiredToken(t *testing.T) {
+	for name, maker := range newTestMakers(t) {
+		t.Run(name, func(t *testing.T) {
+			token, _, err := maker.CreateToken(utils.RandomString(6), utils.RandomRole(), 1, -time.Minute)
+			require.NoError(t, err)
+
+			payload, err := maker.VerifyToken(token)
+			require.ErrorIs(t, err, ErrExpiredToken)
+			require.Nil(t, payload)
+		})
+	}
+}
+
+func TestMakerRejectsForeignTokens(t *testing.T) {
+	makers := newTestMakers(t)
+	others := newTestMakers(t)
+
+	for name, maker := range makers {
+		t.Run(name, func(t *testing.T) {
+			_, err := maker.VerifyToken(utils.RandomString(64))
+			require.ErrorIs(t, err, ErrInvalidToken)
+
+			for otherName, other := range others {
+				token, _, err := other.CreateToken(utils.RandomString(6), utils.RandomRole(), 1, time.Minute)
+				require.NoError(t, err)
+
+				payload, err := maker.VerifyToken(token)
+				require.ErrorIs(t, err, ErrInvalidToken, fmt.Sprintf("token from another %s maker", otherName))
+				require.Nil(t, payload)
+			}
+		})
+	}
+}
+
+func TestNewMaker(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	privateKey := PaserkSecret(ed25519Key)
+	publicKey := PaserkPublic(ed25519Key.Public().(ed25519.PublicKey))
+
+	testCases := []struct {
+		config Config
+		maker  Maker
+	}{
+		{Config{SymmetricKey: utils.RandomString(32)}, &PasetoMaker{}},
+		{Config{Type: MakerPasetoLocal, SymmetricKey: utils.RandomString(32)}, &PasetoMaker{}},
+		{Config{Type: MakerPasetoPublic, PrivateKey: privateKey}, &PublicPasetoMaker{}},
+		{Config{Type: MakerPasetoPublic, VerificationKeys: []string{publicKey, ""}}, &PublicPasetoMaker{}},
+		{Config{Type: MakerJWT, SymmetricKey: utils.RandomString(32)}, &JWTMaker{}},
+		{Config{Type: MakerJWTPublic, PrivateKey: privateKey}, &JWTMaker{}},
+	}
+	for _, tc := range testCases {
+		maker, err := NewMaker(tc.config)
+		require.NoError(t, err, tc.config.Type)
+		require.IsType(t, tc.maker, maker)
+	}
+
+	_, err = NewMaker(Config{Type: "jws"})
+	require.Error(t, err)
+	_, err = NewMaker(Config{Type: MakerPasetoPublic, PrivateKey: publicKey})
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// PASERK (https://github.com/paseto-standard/paserk) serializations of the v4
+// Ed25519 keys, used in configuration and as key IDs in token footers.
+const (
+	paserkSecretPrefix = "k4.secret."
+	paserkPublicPrefix = "k4.public."
+	paserkIDPrefix     = "k4.pid."
+	paserkIDSize       = 33
+)
+
+func PaserkSecret(key ed25519.PrivateKey) string {
+	return paserkSecretPrefix + base64.RawURLEncoding.EncodeToString(key)
+}
+
+func PaserkPublic(key ed25519.PublicKey) string {
+	return paserkPublicPrefix + base64.RawURLEncoding.EncodeToString(key)
+}
+
+// PaserkID returns the k4.pid identifier of a public key.
+func PaserkID(key ed25519.PublicKey) string {
+	hash, _ := blake2b.New(paserkIDSize, nil)
+	hash.Write([]byte(paserkIDPrefix))
+	hash.Write([]byte(PaserkPublic(key)))
+	return paserkIDPrefix + base64.RawURLEncoding.EncodeToString(hash.Sum(nil))
+}
+
+func ParsePaserkSecret(value string) (ed25519.PrivateKey, error) {
+	data, err := decodePaserk(value, paserkSecretPrefix, ed25519.PrivateKeySize)
+	if err != nil {
+		return nil, err
+	}
+	key := ed25519.PrivateKey(data)
+	if !key.Public().(ed25519.PublicKey).Equal(ed25519.NewKeyFromSeed(key.Seed()).Public()) {
+		return nil, fmt.Errorf("invalid %s key: public half does not match the seed", paserkSecretPrefix)
+	}
+	return key, nil
+}
+
+func ParsePaserkPublic(value string) (ed25519.PublicKey, error) {
+	data, err := decodePaserk(value, paserkPublicPrefix, ed25519.PublicKeySize)
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.PublicKey(data), nil
+}
+
+func decodePaserk(value string, prefix string, size int) ([]byte, error) {
+	if !strings.HasPrefix(value, prefix) {
+		return nil, fmt.Errorf("key must start with %q", prefix)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s key: %w", prefix, err)
+	}
+	if len(data) != size {
+		return nil, fmt.Errorf("invalid %s key: must be %d bytes", prefix, size)
+	}
+	return data, nil
+}
@@ -1,9 +1,11 @@
 package token
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/o1egl/paseto"
+	"golang.org/x/crypto/chacha20poly1305"
 )
 
 type PasetoMaker struct {
@@ -12,6 +14,10 @@ type PasetoMaker struct {
 }
 
 func NewPasetoMaker(symmetricKey string) (Maker, error) {
+	if len(symmetricKey) != chacha20poly1305.KeySize {
+		return nil, fmt.Errorf("invalid key size: must be exactly %d characters", chacha20poly1305.KeySize)
+	}
+
 	maker := &PasetoMaker{
 		paseto:       paseto.NewV2(),
 		symmetricKey: []byte(symmetricKey),
@@ -19,8 +25,14 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	return maker, nil
 }
 
-func (maker *PasetoMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
-	payload := NewPayload(username, role, duration)
+func (maker *PasetoMaker) CreateToken(username string, role string, version int64, duration time.Duration) (string, *Payload, error) {
+	payload := NewPayload(username, role, version, duration)
+	token, err := maker.paseto.Encrypt(maker.symmetricKey, payload, nil)
+	return token, payload, err
+}
+
+func (maker *PasetoMaker) CreateTableToken(tableID int64, duration time.Duration) (string, *Payload, error) {
+	payload := NewTablePayload(tableID, duration)
 	token, err := maker.paseto.Encrypt(maker.symmetricKey, payload, nil)
 	return token, payload, err
 }
@@ -33,5 +45,10 @@ func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
 		return nil, ErrInvalidToken
 	}
 
+	err = payload.Valid()
+	if err != nil {
+		return nil, err
+	}
+
 	return payload, nil
 }
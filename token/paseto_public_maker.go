@@ -0,0 +1,184 @@
+package token
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	pasetoV4PublicHeader = "v4.public."
+	maxFooterSize        = 512
+)
+
+var ErrSigningKeyMissing = errors.New("token maker has no signing key")
+
+type pasetoFooter struct {
+	KeyID string `json:"kid"`
+}
+
+// PublicPasetoMaker signs v4.public tokens with Ed25519. The footer names the
+// signing key, so tokens stay verifiable while keys are rotated: new tokens
+// are signed with the current key and older public keys remain accepted
+// until the tokens they signed have expired.
+type PublicPasetoMaker struct {
+	privateKey       ed25519.PrivateKey
+	keyID            string
+	verificationKeys map[string]ed25519.PublicKey
+}
+
+// NewPublicPasetoMaker creates a maker that signs with privateKey and accepts
+// tokens signed by privateKey or any of verificationKeys. A nil privateKey
+// gives a verify-only maker for services that must not issue tokens.
+func NewPublicPasetoMaker(privateKey ed25519.PrivateKey, verificationKeys ...ed25519.PublicKey) (Maker, error) {
+	maker := &PublicPasetoMaker{
+		privateKey:       privateKey,
+		verificationKeys: make(map[string]ed25519.PublicKey),
+	}
+
+	if privateKey != nil {
+		if len(privateKey) != ed25519.PrivateKeySize {
+			return nil, fmt.Errorf("invalid key size: must be exactly %d bytes", ed25519.PrivateKeySize)
+		}
+		publicKey := privateKey.Public().(ed25519.PublicKey)
+		maker.keyID = PaserkID(publicKey)
+		maker.verificationKeys[maker.keyID] = publicKey
+	}
+
+	for _, key := range verificationKeys {
+		if len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid verification key size: must be exactly %d bytes", ed25519.PublicKeySize)
+		}
+		maker.verificationKeys[PaserkID(key)] = key
+	}
+
+	if len(maker.verificationKeys) == 0 {
+		return nil, errors.New("at least one signing or verification key is required")
+	}
+	return maker, nil
+}
+
+func (maker *PublicPasetoMaker) CreateToken(username string, role string, version int64, duration time.Duration) (string, *Payload, error) {
+	payload := NewPayload(username, role, version, duration)
+	token, err := maker.sign(payload)
+	return token, payload, err
+}
+
+func (maker *PublicPasetoMaker) CreateTableToken(tableID int64, duration time.Duration) (string, *Payload, error) {
+	payload := NewTablePayload(tableID, duration)
+	token, err := maker.sign(payload)
+	return token, payload, err
+}
+
+func (maker *PublicPasetoMaker) VerifyToken(token string) (*Payload, error) {
+	message, err := maker.verify(token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{}
+	if err := json.Unmarshal(message, payload); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	err = payload.Valid()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// PublicKeys lists every key that tokens from this maker may be signed with.
+func (maker *PublicPasetoMaker) PublicKeys() []PublicKey {
+	keys := make([]PublicKey, 0, len(maker.verificationKeys))
+	for keyID, key := range maker.verificationKeys {
+		keys = append(keys, PublicKey{KeyID: keyID, Algorithm: "EdDSA", Key: key})
+	}
+	sortPublicKeys(keys)
+	return keys
+}
+
+func (maker *PublicPasetoMaker) sign(payload *Payload) (string, error) {
+	if maker.privateKey == nil {
+		return "", ErrSigningKeyMissing
+	}
+
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	footer, err := json.Marshal(pasetoFooter{KeyID: maker.keyID})
+	if err != nil {
+		return "", err
+	}
+
+	signature := ed25519.Sign(maker.privateKey, pae([]byte(pasetoV4PublicHeader), message, footer, nil))
+
+	var token strings.Builder
+	token.WriteString(pasetoV4PublicHeader)
+	token.WriteString(base64.RawURLEncoding.EncodeToString(append(message, signature...)))
+	token.WriteString(".")
+	token.WriteString(base64.RawURLEncoding.EncodeToString(footer))
+	return token.String(), nil
+}
+
+// verify checks the signature with the key named in the footer and returns
+// the signed message.
+func (maker *PublicPasetoMaker) verify(token string) ([]byte, error) {
+	if !strings.HasPrefix(token, pasetoV4PublicHeader) {
+		return nil, errors.New("unsupported token version or purpose")
+	}
+	parts := strings.Split(strings.TrimPrefix(token, pasetoV4PublicHeader), ".")
+	if len(parts) != 2 {
+		return nil, errors.New("token has no footer")
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || len(body) < ed25519.SignatureSize {
+		return nil, errors.New("malformed token body")
+	}
+	rawFooter, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || len(rawFooter) > maxFooterSize {
+		return nil, errors.New("malformed token footer")
+	}
+	var footer pasetoFooter
+	if err := json.Unmarshal(rawFooter, &footer); err != nil {
+		return nil, errors.New("malformed token footer")
+	}
+
+	key, ok := maker.verificationKeys[footer.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", footer.KeyID)
+	}
+
+	message := body[:len(body)-ed25519.SignatureSize]
+	signature := body[len(body)-ed25519.SignatureSize:]
+	if !ed25519.Verify(key, pae([]byte(pasetoV4PublicHeader), message, rawFooter, nil), signature) {
+		return nil, errors.New("invalid signature")
+	}
+	return message, nil
+}
+
+// pae is the pre-authentication encoding from the PASETO specification.
+func pae(pieces ...[]byte) []byte {
+	var buf bytes.Buffer
+	writeLE64(&buf, len(pieces))
+	for _, piece := range pieces {
+		writeLE64(&buf, len(piece))
+		buf.Write(piece)
+	}
+	return buf.Bytes()
+}
+
+func writeLE64(buf *bytes.Buffer, n int) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(n)&(1<<63-1))
+	buf.Write(b[:])
+}
@@ -0,0 +1,149 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func newEd25519Key(t *testing.T) ed25519.PrivateKey {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return privateKey
+}
+
+func TestPublicPasetoMaker(t *testing.T) {
+	maker, err := NewPublicPasetoMaker(newEd25519Key(t))
+	require.NoError(t, err)
+
+	token, payload, err := maker.CreateToken(utils.RandomString(6), utils.RandomRole(), 3, time.Minute)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(token, "v4.public."))
+
+	verified, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, payload.ID, verified.ID)
+	require.Equal(t, payload.Username, verified.Username)
+	require.Equal(t, payload.Role, verified.Role)
+	require.Equal(t, int64(3), verified.Version)
+	require.WithinDuration(t, payload.ExpiredAt, verified.ExpiredAt, time.Second)
+
+	token, _, err = maker.CreateToken(utils.RandomString(6), utils.RandomRole(), 1, -time.Minute)
+	require.NoError(t, err)
+	_, err = maker.VerifyToken(token)
+	require.ErrorIs(t, err, ErrExpiredToken)
+}
+
+func TestPublicPasetoMakerRejectsTamperedToken(t *testing.T) {
+	maker, err := NewPublicPasetoMaker(newEd25519Key(t))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateTableToken(7, time.Minute)
+	require.NoError(t, err)
+
+	body := strings.TrimPrefix(token, "v4.public.")
+	tampered := "v4.public." + string(body[0]^1) + body[1:]
+	_, err = maker.VerifyToken(tampered)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = maker.VerifyToken(strings.Replace(token, "v4.public.", "v2.public.", 1))
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	otherMaker, err := NewPublicPasetoMaker(newEd25519Key(t))
+	require.NoError(t, err)
+	_, err = otherMaker.VerifyToken(token)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestPublicPasetoMakerKeyRotation(t *testing.T) {
+	oldKey := newEd25519Key(t)
+	oldMaker, err := NewPublicPasetoMaker(oldKey)
+	require.NoError(t, err)
+
+	oldToken, _, err := oldMaker.CreateToken(utils.RandomString(6), utils.RandomRole(), 1, time.Minute)
+	require.NoError(t, err)
+
+	newKey := newEd25519Key(t)
+	newMaker, err := NewPublicPasetoMaker(newKey, oldKey.Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+
+	_, err = newMaker.VerifyToken(oldToken)
+	require.NoError(t, err, "tokens signed with the previous key stay valid")
+
+	newToken, _, err := newMaker.CreateToken(utils.RandomString(6), utils.RandomRole(), 1, time.Minute)
+	require.NoError(t, err)
+	_, err = oldMaker.VerifyToken(newToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	keys := newMaker.(KeySet).PublicKeys()
+	require.Len(t, keys, 2)
+	for _, key := range keys {
+		jwk := key.JWK()
+		require.Equal(t, "OKP", jwk.KeyType)
+		require.Equal(t, "Ed25519", jwk.Curve)
+		require.Equal(t, "EdDSA", jwk.Algorithm)
+		require.True(t, strings.HasPrefix(jwk.KeyID, "k4.pid."))
+	}
+}
+
+func TestPublicPasetoVerifyOnlyMaker(t *testing.T) {
+	signingKey := newEd25519Key(t)
+	signer, err := NewPublicPasetoMaker(signingKey)
+	require.NoError(t, err)
+
+	verifier, err := NewPublicPasetoMaker(nil, signingKey.Public().(ed25519.PublicKey))
+	require.NoError(t, err)
+
+	token, _, err := signer.CreateToken(utils.RandomString(6), utils.RandomRole(), 1, time.Minute)
+	require.NoError(t, err)
+	_, err = verifier.VerifyToken(token)
+	require.NoError(t, err)
+
+	_, _, err = verifier.CreateToken(utils.RandomString(6), utils.RandomRole(), 1, time.Minute)
+	require.ErrorIs(t, err, ErrSigningKeyMissing)
+
+	_, err = NewPublicPasetoMaker(nil)
+	require.Error(t, err)
+}
+
+// TestPublicPasetoSpecVector checks the signature scheme against test vector
+// 4-S-2 of the PASETO specification.
+func TestPublicPasetoSpecVector(t *testing.T) {
+	publicKey, err := hex.DecodeString("1eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2")
+	require.NoError(t, err)
+
+	maker := &PublicPasetoMaker{verificationKeys: map[string]ed25519.PublicKey{
+		"zVhMiPBP9fRf2snEcT7gFTioeA9COcNy9DfgL1W60haN": publicKey,
+	}}
+
+	token := "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9v3Jt8mx_TdM2ceTGoqwrh4yDFn0XsHvvV_D0DtwQxVrJEBMl0F2caAdgnpKlt4p7xBnx1HcO-SPo8FPp214HDw.eyJraWQiOiJ6VmhNaVBCUDlmUmYyc25FY1Q3Z0ZUaW9lQTlDT2NOeTlEZmdMMVc2MGhhTiJ9"
+	message, err := maker.verify(token)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":"this is a signed message","exp":"2022-01-01T00:00:00+00:00"}`, string(message))
+}
+
+func TestPaserkKeys(t *testing.T) {
+	privateKey := newEd25519Key(t)
+
+	parsedSecret, err := ParsePaserkSecret(PaserkSecret(privateKey))
+	require.NoError(t, err)
+	require.True(t, privateKey.Equal(parsedSecret))
+
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	parsedPublic, err := ParsePaserkPublic(PaserkPublic(publicKey))
+	require.NoError(t, err)
+	require.True(t, publicKey.Equal(parsedPublic))
+
+	_, err = ParsePaserkPublic(PaserkSecret(privateKey))
+	require.Error(t, err)
+	_, err = ParsePaserkSecret("k4.secret.AAAA")
+	require.Error(t, err)
+
+	require.Len(t, PaserkID(publicKey), len("k4.pid.")+44)
+}
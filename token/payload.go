@@ -2,6 +2,7 @@ package token
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,25 +13,37 @@ var (
 	ErrInvalidToken = errors.New("token is invalid")
 )
 
+const GuestRole = "guest"
+
 type Payload struct {
 	ID        uuid.UUID `json:"user_id"`
 	Username  string    `json:"username"`
 	Role      string    `json:"role"`
+	Version   int64     `json:"token_version,omitempty"`
+	TableID   int64     `json:"table_id,omitempty"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
 }
 
-func NewPayload(username string, role string, duration time.Duration) *Payload {
+func NewPayload(username string, role string, version int64, duration time.Duration) *Payload {
 	tokenID, _ := uuid.NewRandom()
 	payload := &Payload{
 		ID:        tokenID,
 		Username:  username,
 		Role:      role,
+		Version:   version,
 		IssuedAt:  time.Now(),
 		ExpiredAt: time.Now().Add(duration),
 	}
 	return payload
 }
+// NewTablePayload creates the payload of a guest session bound to a single table.
+func NewTablePayload(tableID int64, duration time.Duration) *Payload {
+	payload := NewPayload(fmt.Sprintf("table-%d", tableID), GuestRole, 0, duration)
+	payload.TableID = tableID
+	return payload
+}
+
 func (payload *Payload) Valid() error {
 	if time.Now().After(payload.ExpiredAt) {
 		return ErrExpiredToken
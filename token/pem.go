@@ -0,0 +1,56 @@
+package token
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// parsePrivateKey reads a k4.secret PASERK or a PEM encoded PKCS #8 or
+// PKCS #1 private key.
+func parsePrivateKey(value string) (crypto.Signer, error) {
+	if strings.HasPrefix(value, paserkSecretPrefix) {
+		return ParsePaserkSecret(value)
+	}
+
+	block, _ := pem.Decode([]byte(value))
+	if block == nil {
+		return nil, errors.New("private key must be a PASERK or PEM encoded key")
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// parsePublicKey reads a k4.public PASERK or a PEM encoded PKIX or PKCS #1
+// public key.
+func parsePublicKey(value string) (crypto.PublicKey, error) {
+	if strings.HasPrefix(value, paserkPublicPrefix) {
+		return ParsePaserkPublic(value)
+	}
+
+	block, _ := pem.Decode([]byte(value))
+	if block == nil {
+		return nil, errors.New("public key must be a PASERK or PEM encoded key")
+	}
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return key, nil
+}
@@ -3,6 +3,8 @@ package utils
 import (
 	"math/rand"
 	"strings"
+
+	"github.com/datmaithanh/orderfood/rbac"
 )
 
 const alphabet = "abcdefghijklmnopqrstuvwxyz"
@@ -19,7 +21,6 @@ func RandomString(n int) string {
 }
 
 func RandomRole() string {
-	roles := []string{"admin", "staff"}
-	n := len(roles)
-	return roles[rand.Intn(n)]
+	n := len(rbac.Roles)
+	return rbac.Roles[rand.Intn(n)]
 }
\ No newline at end of file
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// SecretCodeLength is the length of codes returned by GenerateSecretCode.
+const SecretCodeLength = 43
+
+// GenerateSecretCode returns a URL-safe code with 256 bits of entropy for
+// links sent by email.
+func GenerateSecretCode() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("cannot generate secret code: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashSecretCode returns the SHA-256 digest of code in hex. Codes carry enough
+// entropy that a fast hash is sufficient for storing them at rest.
+func HashSecretCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
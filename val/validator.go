@@ -4,11 +4,17 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+	"strings"
+
+	"github.com/datmaithanh/orderfood/money"
+	"github.com/datmaithanh/orderfood/rbac"
+	"github.com/google/uuid"
 )
 
 var (
 	isValidUsername = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
 	isValidFullname = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidPhone    = regexp.MustCompile(`^\+?[0-9 ]{6,20}$`).MatchString
 )
 
 func ValidateString(value string, minLen int, maxLen int) error {
@@ -56,15 +62,11 @@ func ValidateEmail(email string) error {
 }
 
 func ValidateRole(role string) error {
-	allowedRoles := map[string]bool{
-		"admin":    true,
-		"staff":     true,
-	}
 	if role == "" {
 		return nil
 	}
-	if !allowedRoles[role] {
-		return fmt.Errorf("role must be one of the following: admin, staff")
+	if !rbac.IsValidRole(role) {
+		return fmt.Errorf("role must be one of the following: %s", strings.Join(rbac.Roles, ", "))
 	}
 	return nil
 }
@@ -75,3 +77,58 @@ func ValidateId(id int64) error {
 	}
 	return nil
 }
+
+func ValidatePageID(pageID int32) error {
+	if pageID < 1 {
+		return fmt.Errorf("page id must be at least 1")
+	}
+	return nil
+}
+
+func ValidatePageSize(pageSize int32) error {
+	if pageSize < 5 || pageSize > 10 {
+		return fmt.Errorf("page size must be between 5 and 10")
+	}
+	return nil
+}
+
+func ValidatePrice(price money.Amount) error {
+	if price.IsNegative() || price > money.MaxColumnAmount {
+		return fmt.Errorf("price must be between 0 and %s", money.MaxColumnAmount)
+	}
+	return nil
+}
+
+func ValidateQuantity(quantity int32) error {
+	if quantity <= 0 {
+		return fmt.Errorf("quantity must be a positive integer")
+	}
+	return nil
+}
+
+func ValidatePhoneNumber(phoneNumber string) error {
+	if !isValidPhone(phoneNumber) {
+		return fmt.Errorf("phone number can only contain digits, spaces and a leading +")
+	}
+	return nil
+}
+
+func ValidateOneOf(value string, allowed ...string) error {
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of the following: %s", strings.Join(allowed, ", "))
+}
+
+func ValidateSecretCode(value string) error {
+	return ValidateString(value, 32, 128)
+}
+
+func ValidateUUID(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid UUID")
+	}
+	return nil
+}
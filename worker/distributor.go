@@ -8,6 +8,7 @@ import (
 
 type TaskDistributor interface {
 	DistributeTaskSendVerifyEmail(ctx context.Context, payload *PayloadSendVerifyEmail, opts ...asynq.Option) error
+	DistributeTaskSendResetPassword(ctx context.Context, payload *PayloadSendResetPassword, opts ...asynq.Option) error
 }
 
 type RedisTaskDistributor struct {
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/mailer"
+	"github.com/datmaithanh/orderfood/utils"
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
 )
@@ -15,18 +17,24 @@ const (
 
 type TaskProcessor interface {
 	Start() error
+	Shutdown()
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendResetPassword(ctx context.Context, task *asynq.Task) error
+	ProcessTaskPurgeExpiredSessions(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
 	server *asynq.Server
 	store  db.Store
+	config utils.Config
+	mailer mailer.Sender
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) TaskProcessor {
+func NewRedisTaskProcessor(config utils.Config, redisOpt asynq.RedisClientOpt, store db.Store, mailer mailer.Sender) TaskProcessor {
 	server := asynq.NewServer(
 		redisOpt,
 		asynq.Config{
+			ShutdownTimeout: config.ShutdownTimeout,
 			Queues: map[string]int{
 				QueueCritical: 10,
 				QueueDefault:  5,
@@ -40,6 +48,8 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store) TaskPr
 	return &RedisTaskProcessor{
 		server: server,
 		store:  store,
+		config: config,
+		mailer: mailer,
 	}
 }
 
@@ -47,6 +57,12 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 
 	mux.HandleFunc(TaskTypeSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskTypeSendResetPassword, processor.ProcessTaskSendResetPassword)
+	mux.HandleFunc(TaskTypePurgeExpiredSessions, processor.ProcessTaskPurgeExpiredSessions)
 
 	return processor.server.Start(mux)
 }
+
+func (processor *RedisTaskProcessor) Shutdown() {
+	processor.server.Shutdown()
+}
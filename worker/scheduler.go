@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/hibiken/asynq"
+)
+
+type TaskScheduler interface {
+	Start() error
+	Shutdown()
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+// NewRedisTaskScheduler registers the periodic maintenance tasks. The tasks
+// are unique for one interval so that several replicas do not pile them up.
+func NewRedisTaskScheduler(config utils.Config, redisOpt asynq.RedisClientOpt) (TaskScheduler, error) {
+	scheduler := asynq.NewScheduler(redisOpt, nil)
+
+	_, err := scheduler.Register(
+		fmt.Sprintf("@every %s", config.SessionPurgeInterval),
+		asynq.NewTask(TaskTypePurgeExpiredSessions, nil),
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(1),
+		asynq.Unique(config.SessionPurgeInterval),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register %s: %w", TaskTypePurgeExpiredSessions, err)
+	}
+
+	return &RedisTaskScheduler{scheduler: scheduler}, nil
+}
+
+func (scheduler *RedisTaskScheduler) Start() error {
+	return scheduler.scheduler.Start()
+}
+
+func (scheduler *RedisTaskScheduler) Shutdown() {
+	scheduler.scheduler.Shutdown()
+}
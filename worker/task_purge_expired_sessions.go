@@ -0,0 +1,24 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskTypePurgeExpiredSessions = "task:purge_expired_sessions"
+
+// ProcessTaskPurgeExpiredSessions deletes sessions whose refresh token has
+// expired. It is enqueued periodically by the task scheduler.
+func (process *RedisTaskProcessor) ProcessTaskPurgeExpiredSessions(ctx context.Context, task *asynq.Task) error {
+	purged, err := process.store.DeleteExpiredSessions(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Int64("purged", purged).Msg("processed task")
+	return nil
+}
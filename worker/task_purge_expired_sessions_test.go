@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+type purgeStore struct {
+	db.Store
+	expiredBefore []time.Time
+}
+
+func (store *purgeStore) DeleteExpiredSessions(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	store.expiredBefore = append(store.expiredBefore, expiredBefore)
+	return 2, nil
+}
+
+func TestProcessTaskPurgeExpiredSessions(t *testing.T) {
+	store := &purgeStore{}
+	processor := &RedisTaskProcessor{store: store}
+
+	err := processor.ProcessTaskPurgeExpiredSessions(context.Background(), asynq.NewTask(TaskTypePurgeExpiredSessions, nil))
+	require.NoError(t, err)
+
+	require.Len(t, store.expiredBefore, 1)
+	require.WithinDuration(t, time.Now(), store.expiredBefore[0], time.Second)
+}
@@ -0,0 +1,97 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/mailer"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/goccy/go-json"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskTypeSendResetPassword = "task:send_reset_password"
+
+type PayloadSendResetPassword struct {
+	Email    string `json:"email"`
+	ClientIP string `json:"client_ip"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendResetPassword(ctx context.Context, payload *PayloadSendResetPassword, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskTypeSendResetPassword, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+	log.Info().Str("type", task.Type()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskSendResetPassword emails a single-use reset link. Unknown emails
+// are ignored so that the caller cannot learn which addresses have accounts.
+func (process *RedisTaskProcessor) ProcessTaskSendResetPassword(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendResetPassword
+
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal task payload: %w", asynq.SkipRetry)
+	}
+
+	user, err := process.store.GetUserByEmail(ctx, payload.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Info().Str("type", task.Type()).Msg("skipped password reset for unknown email")
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	token, err := utils.GenerateSecretCode()
+	if err != nil {
+		return err
+	}
+
+	_, err = process.store.CreatePasswordReset(ctx, db.CreatePasswordResetParams{
+		UserID:    user.ID,
+		TokenHash: utils.HashSecretCode(token),
+		ClientIp:  payload.ClientIP,
+		ExpiredAt: time.Now().Add(process.config.PasswordResetDuration),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create password reset: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("token", token)
+	resetURL := fmt.Sprintf("%s/reset-password?%s", process.config.WebsiteURL, query.Encode())
+
+	msg, err := mailer.Render(mailer.TemplateResetPassword, user.Email,
+		fmt.Sprintf("Reset your %s password", process.config.RestaurantName),
+		mailer.ResetPasswordData{
+			RestaurantName: process.config.RestaurantName,
+			FullName:       user.FullName,
+			ResetURL:       resetURL,
+			ExpiresIn:      process.config.PasswordResetDuration.String(),
+		})
+	if err != nil {
+		return fmt.Errorf("failed to render reset password email: %w", err)
+	}
+
+	err = process.mailer.Send(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("failed to send reset password email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("email", user.Email).Msg("processed task")
+	return nil
+}
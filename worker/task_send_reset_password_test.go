@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strings"
+	"testing"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/mailer"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/goccy/go-json"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+func (store *stubStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	for _, user := range store.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return db.User{}, sql.ErrNoRows
+}
+
+func (store *stubStore) CreatePasswordReset(ctx context.Context, arg db.CreatePasswordResetParams) (db.PasswordReset, error) {
+	store.passwordResets = append(store.passwordResets, arg)
+	return db.PasswordReset{
+		ID:        int64(len(store.passwordResets)),
+		UserID:    arg.UserID,
+		TokenHash: arg.TokenHash,
+		ClientIp:  arg.ClientIp,
+		ExpiredAt: arg.ExpiredAt,
+	}, nil
+}
+
+func newResetPasswordTask(t *testing.T, email string) *asynq.Task {
+	payload, err := json.Marshal(PayloadSendResetPassword{Email: email, ClientIP: "10.0.0.1"})
+	require.NoError(t, err)
+	return asynq.NewTask(TaskTypeSendResetPassword, payload)
+}
+
+func TestProcessTaskSendResetPassword(t *testing.T) {
+	user := db.User{
+		ID:       7,
+		Username: utils.RandomString(6),
+		FullName: "Alice Nguyen",
+		Email:    utils.RandomString(6) + "@example.com",
+	}
+	store := &stubStore{users: map[string]db.User{user.Username: user}}
+	sender := mailer.NewFakeSender()
+	config := utils.DefaultConfig()
+	config.WebsiteURL = "https://orderfood.example"
+
+	processor := &RedisTaskProcessor{store: store, config: config, mailer: sender}
+	err := processor.ProcessTaskSendResetPassword(context.Background(), newResetPasswordTask(t, user.Email))
+	require.NoError(t, err)
+
+	require.Len(t, store.passwordResets, 1)
+	reset := store.passwordResets[0]
+	require.Equal(t, user.ID, reset.UserID)
+	require.Equal(t, "10.0.0.1", reset.ClientIp)
+
+	sent := sender.Sent()
+	require.Len(t, sent, 1)
+	link := strings.Fields(sent[0].TextBody[strings.Index(sent[0].TextBody, config.WebsiteURL):])[0]
+	resetURL, err := url.Parse(link)
+	require.NoError(t, err)
+
+	token := resetURL.Query().Get("token")
+	require.NotEqual(t, token, reset.TokenHash)
+	require.Equal(t, utils.HashSecretCode(token), reset.TokenHash)
+}
+
+func TestProcessTaskSendResetPasswordUnknownEmail(t *testing.T) {
+	store := &stubStore{}
+	sender := mailer.NewFakeSender()
+
+	processor := &RedisTaskProcessor{store: store, config: utils.DefaultConfig(), mailer: sender}
+	err := processor.ProcessTaskSendResetPassword(context.Background(), newResetPasswordTask(t, "nobody@example.com"))
+	require.NoError(t, err)
+	require.Empty(t, store.passwordResets)
+	require.Empty(t, sender.Sent())
+}
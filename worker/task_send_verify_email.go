@@ -4,7 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
+	"strconv"
+	"time"
 
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/mailer"
+	"github.com/datmaithanh/orderfood/utils"
 	"github.com/goccy/go-json"
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
@@ -46,9 +52,49 @@ func (process *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Contex
 		}
 		return fmt.Errorf("faild to get user: %w", err)
 	}
+	if user.IsEmailVerified {
+		return nil
+	}
+
+	secretCode, err := utils.GenerateSecretCode()
+	if err != nil {
+		return err
+	}
+
+	verifyEmail, err := process.store.CreateVerifyEmail(ctx, db.CreateVerifyEmailParams{
+		Username:       user.Username,
+		Email:          user.Email,
+		SecretCodeHash: utils.HashSecretCode(secretCode),
+		ExpiredAt:      time.Now().Add(process.config.VerifyEmailDuration),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create verify email: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("email_id", strconv.FormatInt(verifyEmail.ID, 10))
+	query.Set("secret_code", secretCode)
+	verifyURL := fmt.Sprintf("%s/verify-email?%s", process.config.WebsiteURL, query.Encode())
+
+	msg, err := mailer.Render(mailer.TemplateVerifyEmail, user.Email,
+		fmt.Sprintf("Verify your %s account", process.config.RestaurantName),
+		mailer.VerifyEmailData{
+			RestaurantName: process.config.RestaurantName,
+			FullName:       user.FullName,
+			VerifyURL:      verifyURL,
+			ExpiresIn:      process.config.VerifyEmailDuration.String(),
+		})
+	if err != nil {
+		return fmt.Errorf("failed to render verify email: %w", err)
+	}
+
+	err = process.mailer.Send(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("failed to send verify email: %w", err)
+	}
+
 	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
 		Str("email", user.Email).Msg("processed task")
 
 	return nil
-
 }
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strings"
+	"testing"
+
+	db "github.com/datmaithanh/orderfood/db/sqlc"
+	"github.com/datmaithanh/orderfood/mailer"
+	"github.com/datmaithanh/orderfood/utils"
+	"github.com/goccy/go-json"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+type stubStore struct {
+	db.Store
+	users          map[string]db.User
+	verifyEmails   []db.CreateVerifyEmailParams
+	passwordResets []db.CreatePasswordResetParams
+}
+
+func (store *stubStore) GetUser(ctx context.Context, username string) (db.User, error) {
+	user, ok := store.users[username]
+	if !ok {
+		return db.User{}, sql.ErrNoRows
+	}
+	return user, nil
+}
+
+func (store *stubStore) CreateVerifyEmail(ctx context.Context, arg db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+	store.verifyEmails = append(store.verifyEmails, arg)
+	return db.VerifyEmail{
+		ID:             int64(len(store.verifyEmails)),
+		Username:       arg.Username,
+		Email:          arg.Email,
+		SecretCodeHash: arg.SecretCodeHash,
+		ExpiredAt:      arg.ExpiredAt,
+	}, nil
+}
+
+func newVerifyEmailTask(t *testing.T, username string) *asynq.Task {
+	payload, err := json.Marshal(PayloadSendVerifyEmail{Username: username})
+	require.NoError(t, err)
+	return asynq.NewTask(TaskTypeSendVerifyEmail, payload)
+}
+
+func TestProcessTaskSendVerifyEmail(t *testing.T) {
+	user := db.User{
+		Username: utils.RandomString(6),
+		FullName: "Alice Nguyen",
+		Email:    utils.RandomString(6) + "@example.com",
+	}
+	store := &stubStore{users: map[string]db.User{user.Username: user}}
+	sender := mailer.NewFakeSender()
+	config := utils.DefaultConfig()
+	config.WebsiteURL = "https://orderfood.example"
+
+	processor := &RedisTaskProcessor{store: store, config: config, mailer: sender}
+	err := processor.ProcessTaskSendVerifyEmail(context.Background(), newVerifyEmailTask(t, user.Username))
+	require.NoError(t, err)
+
+	require.Len(t, store.verifyEmails, 1)
+	secretCodeHash := store.verifyEmails[0].SecretCodeHash
+
+	sent := sender.Sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, []string{user.Email}, sent[0].To)
+
+	link := sent[0].TextBody[strings.Index(sent[0].TextBody, config.WebsiteURL):]
+	link = strings.Fields(link)[0]
+	verifyURL, err := url.Parse(link)
+	require.NoError(t, err)
+	require.Equal(t, "/verify-email", verifyURL.Path)
+	require.Equal(t, "1", verifyURL.Query().Get("email_id"))
+	secretCode := verifyURL.Query().Get("secret_code")
+	require.Len(t, secretCode, utils.SecretCodeLength)
+	require.Equal(t, utils.HashSecretCode(secretCode), secretCodeHash)
+}
+
+func TestProcessTaskSendVerifyEmailSkipsVerifiedUser(t *testing.T) {
+	user := db.User{Username: utils.RandomString(6), IsEmailVerified: true}
+	store := &stubStore{users: map[string]db.User{user.Username: user}}
+	sender := mailer.NewFakeSender()
+
+	processor := &RedisTaskProcessor{store: store, config: utils.DefaultConfig(), mailer: sender}
+	err := processor.ProcessTaskSendVerifyEmail(context.Background(), newVerifyEmailTask(t, user.Username))
+	require.NoError(t, err)
+	require.Empty(t, store.verifyEmails)
+	require.Empty(t, sender.Sent())
+}
+
+func TestProcessTaskSendVerifyEmailUnknownUser(t *testing.T) {
+	processor := &RedisTaskProcessor{store: &stubStore{}, config: utils.DefaultConfig(), mailer: mailer.NewFakeSender()}
+	err := processor.ProcessTaskSendVerifyEmail(context.Background(), newVerifyEmailTask(t, "nobody"))
+	require.ErrorIs(t, err, asynq.SkipRetry)
+}